@@ -5,5 +5,13 @@ package acctest
 
 // Exports for use in tests only.
 var (
-	CloseVCRRecorder = closeVCRRecorder
+	AccountIDRedactorDiscover = (*accountIDRedactor).discover
+	AccountIDRedactorRedact   = (*accountIDRedactor).redact
+	CloseVCRRecorder          = closeVCRRecorder
+	NewAccountIDRedactor      = newAccountIDRedactor
+	VCRRequestBodiesMatch     = vcrRequestBodiesMatch
 )
+
+func NewInteractionNotFoundError(err error) error {
+	return &interactionNotFoundError{err: err}
+}
@@ -9,17 +9,23 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"math/rand"
+	"mime"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -29,6 +35,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/polling"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 	"gopkg.in/dnaeon/go-vcr.v3/recorder"
@@ -121,6 +128,8 @@ func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input
 				return nil, err
 			}
 
+			// The Plugin Framework provider obtains its state from the primary provider's meta,
+			// so Plugin Framework resources and data sources also use the VCR-enabled HTTP client.
 			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, t.Name())
 
 			return providerServerFactory(), nil
@@ -183,82 +192,21 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return nil
 		}, recorder.AfterCaptureHook)
 
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
-			}
-
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+		// Replace AWS account IDs, and so ARNs, with stable placeholders.
+		redactor := newAccountIDRedactor()
+		r.AddHook(redactor.discover, recorder.AfterCaptureHook)
+		r.AddHook(redactor.redact, recorder.BeforeSaveHook)
 
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
+		// Defines how VCR will match requests to responses.
+		r.SetMatcher(vcrRequestMatcher(ctx))
 
-			return false
-		})
+		// Don't wait between polls when replaying, the recorded interactions are returned immediately.
+		polling.SetDelaysDisabled(vcrMode == recorder.ModeReplayOnly)
 
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
 		// we must do this setup before calling the ConfigureContextFunc.
-		httpClient.Transport = r
+		httpClient.Transport = &vcrTransport{recorder: r}
 		if v, ok := provider.Meta().(*conns.AWSClient); ok {
 			meta = v
 		} else {
@@ -273,23 +221,324 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			meta = v.(*conns.AWSClient)
 		}
 
-		// Don't retry requests if a recorded interaction isn't found.
-		// TODO Need to loop through all API clients to do this.
-		// TODO Use []*client.Client?
-		// TODO AWS SDK for Go v2 API clients.
-		// meta.LogsConn(ctx).Handlers.AfterRetry.PushFront(func(r *request.Request) {
-		// 	// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
-		// 	if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
-		// 		r.Retryable = aws.Bool(false)
-		// 	}
-		// })
-
 		providerMetas[testName] = meta
 
 		return meta, diags
 	}
 }
 
+// vcrTransport is an http.RoundTripper that sends requests via a VCR recorder.
+type vcrTransport struct {
+	recorder *recorder.Recorder
+}
+
+func (t *vcrTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	resp, err := t.recorder.RoundTrip(r)
+
+	if errors.Is(err, cassette.ErrInteractionNotFound) {
+		return nil, &interactionNotFoundError{err: err}
+	}
+
+	return resp, err
+}
+
+// interactionNotFoundError is returned when a request has no recorded interaction.
+// Retrying the request can't succeed, so the error is marked as non-retryable
+// for both the AWS SDK for Go v1 (not Temporary) and v2 (CanceledError) retryers.
+type interactionNotFoundError struct {
+	err error
+}
+
+func (e *interactionNotFoundError) Error() string {
+	return e.err.Error()
+}
+
+func (e *interactionNotFoundError) Unwrap() error {
+	return e.err
+}
+
+func (e *interactionNotFoundError) Temporary() bool {
+	return false
+}
+
+func (e *interactionNotFoundError) CanceledError() bool {
+	return true
+}
+
+// vcrIgnoredRequestParameters are the top-level request parameters that are ignored when matching request bodies.
+// Their values are generated anew for each request, for example idempotency tokens filled in by the AWS SDKs.
+var vcrIgnoredRequestParameters = []string{
+	"ClientRequestToken",
+	"ClientToken",
+	"IdempotencyToken",
+}
+
+// vcrRequestMatcher returns the function VCR uses to match requests to recorded interactions.
+func vcrRequestMatcher(ctx context.Context) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		// Default matcher compares method and URL only.
+		if !cassette.DefaultMatcher(r, i) {
+			return false
+		}
+
+		if r.Body == nil {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := b.String()
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		return vcrRequestBodiesMatch(ctx, r.Header.Get("Content-Type"), body, i.Body)
+	}
+}
+
+// vcrRequestBodiesMatch returns whether a request body matches a recorded request body, taking the request's protocol into account.
+func vcrRequestBodiesMatch(ctx context.Context, contentType, body, cassetteBody string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse request Content-Type", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		// JSON might be the same, but reordered. Try parsing and comparing.
+		var requestJson, cassetteJson interface{}
+
+		if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		if err := json.Unmarshal([]byte(cassetteBody), &cassetteJson); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		for _, v := range []interface{}{requestJson, cassetteJson} {
+			if m, ok := v.(map[string]interface{}); ok {
+				for _, k := range vcrIgnoredRequestParameters {
+					delete(m, k)
+				}
+			}
+		}
+
+		return reflect.DeepEqual(requestJson, cassetteJson)
+
+	case "application/xml", "text/xml":
+		// XML might be the same, but reordered. Try parsing and comparing.
+		var requestXml, cassetteXml interface{}
+
+		if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		if err := xml.Unmarshal([]byte(cassetteBody), &cassetteXml); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		return reflect.DeepEqual(requestXml, cassetteXml)
+
+	case "application/x-www-form-urlencoded":
+		// AWS query protocol (and EC2 query protocol) parameters might be the same, but reordered.
+		// List members are numbered in the order they are serialized, which may also differ.
+		requestQuery, err := url.ParseQuery(body)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to parse request query", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		cassetteQuery, err := url.ParseQuery(cassetteBody)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to parse cassette query", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		for _, v := range []url.Values{requestQuery, cassetteQuery} {
+			for _, k := range vcrIgnoredRequestParameters {
+				v.Del(k)
+			}
+		}
+
+		return canonicalQuery(requestQuery) == canonicalQuery(cassetteQuery)
+	}
+
+	return false
+}
+
+// canonicalQuery returns a canonical representation of AWS query protocol parameters.
+// Dotted parameter names ("Filter.1.Name") are expanded into nested structures
+// and the order of numbered list members is ignored.
+func canonicalQuery(values url.Values) string {
+	root := make(map[string]interface{})
+
+	for k, v := range values {
+		m := root
+		parts := strings.Split(k, ".")
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := m[part].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				if leaf, ok := m[part]; ok {
+					child[""] = leaf
+				}
+				m[part] = child
+			}
+			m = child
+		}
+
+		if child, ok := m[parts[len(parts)-1]].(map[string]interface{}); ok {
+			child[""] = v
+		} else {
+			m[parts[len(parts)-1]] = v
+		}
+	}
+
+	b, _ := json.Marshal(canonicalQueryValue(root))
+
+	return string(b)
+}
+
+func canonicalQueryValue(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+
+	isList := len(m) > 0
+	for k, v := range m {
+		m[k] = canonicalQueryValue(v)
+		if _, err := strconv.Atoi(k); err != nil {
+			isList = false
+		}
+	}
+
+	if !isList {
+		return m
+	}
+
+	// Numbered list members, sorted by value.
+	members := make([]string, 0, len(m))
+	for _, v := range m {
+		b, _ := json.Marshal(v)
+		members = append(members, string(b))
+	}
+	sort.Strings(members)
+
+	list := make([]interface{}, len(members))
+	for i, v := range members {
+		list[i] = json.RawMessage(v)
+	}
+
+	return list
+}
+
+var (
+	// stsGetCallerIdentityAccountRegexp matches the account ID in an STS GetCallerIdentity response.
+	stsGetCallerIdentityAccountRegexp = regexache.MustCompile(`<Account>(\d{12})</Account>`)
+	// digitsRegexp matches a run of decimal digits.
+	digitsRegexp = regexache.MustCompile(`\d+`)
+)
+
+// accountIDRedactor replaces the AWS account IDs of the identities used while recording with placeholder values.
+// Each account ID is always replaced with the same placeholder so that ARNs and other references remain consistent.
+type accountIDRedactor struct {
+	lock         sync.Mutex
+	placeholders map[string]string
+}
+
+func newAccountIDRedactor() *accountIDRedactor {
+	return &accountIDRedactor{
+		placeholders: make(map[string]string),
+	}
+}
+
+// discover is a VCR hook that finds the account IDs returned by STS GetCallerIdentity.
+func (r *accountIDRedactor) discover(i *cassette.Interaction) error {
+	if !strings.Contains(i.Request.Body, "Action=GetCallerIdentity") {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, match := range stsGetCallerIdentityAccountRegexp.FindAllStringSubmatch(i.Response.Body, -1) {
+		if accountID := match[1]; r.placeholders[accountID] == "" {
+			r.placeholders[accountID] = fmt.Sprintf("%012d", len(r.placeholders)+1)
+		}
+	}
+
+	return nil
+}
+
+// redact is a VCR hook that replaces discovered account IDs in an interaction.
+func (r *accountIDRedactor) redact(i *cassette.Interaction) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if len(r.placeholders) == 0 {
+		return nil
+	}
+
+	i.Request.Body = r.redactString(i.Request.Body)
+	i.Request.Host = r.redactString(i.Request.Host)
+	i.Request.RequestURI = r.redactString(i.Request.RequestURI)
+	i.Request.URL = r.redactString(i.Request.URL)
+	r.redactValues(i.Request.Form)
+	r.redactValues(i.Request.Headers)
+	i.Response.Body = r.redactString(i.Response.Body)
+	r.redactValues(i.Response.Headers)
+
+	return nil
+}
+
+func (r *accountIDRedactor) redactString(s string) string {
+	// Only replace complete runs of digits, account IDs are 12 digits long.
+	return digitsRegexp.ReplaceAllStringFunc(s, func(s string) string {
+		if v, ok := r.placeholders[s]; ok {
+			return v
+		}
+		return s
+	})
+}
+
+func (r *accountIDRedactor) redactValues(values map[string][]string) {
+	for _, v := range values {
+		for i := range v {
+			v[i] = r.redactString(v[i])
+		}
+	}
+}
+
 // vcrRandomnessSource returns a rand.Source for VCR testing.
 // In RECORDING mode, generates a new seed and saves it to a file, using the seed for the source.
 // In REPLAYING mode, reads a seed from a file and creates a source from it.
@@ -398,9 +647,9 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 
 	if ok {
 		if !t.Failed() {
-			if v, ok := meta.HTTPClient(ctx).Transport.(*recorder.Recorder); ok {
+			if v, ok := meta.HTTPClient(ctx).Transport.(*vcrTransport); ok {
 				t.Log("stopping VCR recorder")
-				if err := v.Stop(); err != nil {
+				if err := v.recorder.Stop(); err != nil {
 					t.Error(err)
 				}
 			}
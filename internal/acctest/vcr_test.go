@@ -4,9 +4,17 @@
 package acctest_test
 
 import (
+	"errors"
+	"net/http"
+	"net/url"
 	"testing"
 
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 )
 
 func TestRandInt(t *testing.T) {
@@ -52,3 +60,167 @@ func TestRandomWithPrefix(t *testing.T) {
 		t.Errorf("REPLAYING: %s, RECORDING: %s", rep2, rec2)
 	}
 }
+
+func TestVCRRequestBodiesMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		contentType  string
+		body         string
+		cassetteBody string
+		expected     bool
+	}{
+		"JSON reordered": {
+			contentType:  "application/x-amz-json-1.1",
+			body:         `{"logGroupName":"test","tags":{"Key":"Value"}}`,
+			cassetteBody: `{"tags":{"Key":"Value"},"logGroupName":"test"}`,
+			expected:     true,
+		},
+		"JSON different": {
+			contentType:  "application/x-amz-json-1.1",
+			body:         `{"logGroupName":"test1"}`,
+			cassetteBody: `{"logGroupName":"test2"}`,
+		},
+		"JSON idempotency token": {
+			contentType:  "application/x-amz-json-1.0",
+			body:         `{"Name":"test","ClientToken":"8a4f9e5e-1d4e-4a5f-9d2b-0f6c1b8e7a31"}`,
+			cassetteBody: `{"ClientToken":"c2b7d3a1-5e6f-4b8a-9c0d-1e2f3a4b5c6d","Name":"test"}`,
+			expected:     true,
+		},
+		"query reordered": {
+			contentType:  "application/x-www-form-urlencoded; charset=utf-8",
+			body:         "Action=CreateRole&RoleName=test&Version=2010-05-08",
+			cassetteBody: "Action=CreateRole&Version=2010-05-08&RoleName=test",
+			expected:     true,
+		},
+		"query list members reordered": {
+			contentType:  "application/x-www-form-urlencoded; charset=utf-8",
+			body:         "Action=CreateTags&ResourceId.1=vpc-12345678&Tag.1.Key=k1&Tag.1.Value=v1&Tag.2.Key=k2&Tag.2.Value=v2&Version=2016-11-15",
+			cassetteBody: "Action=CreateTags&ResourceId.1=vpc-12345678&Tag.1.Key=k2&Tag.1.Value=v2&Tag.2.Key=k1&Tag.2.Value=v1&Version=2016-11-15",
+			expected:     true,
+		},
+		"query list members different": {
+			contentType:  "application/x-www-form-urlencoded; charset=utf-8",
+			body:         "Action=CreateTags&Tag.1.Key=k1&Tag.1.Value=v1&Tag.2.Key=k2&Tag.2.Value=v2&Version=2016-11-15",
+			cassetteBody: "Action=CreateTags&Tag.1.Key=k1&Tag.1.Value=v2&Tag.2.Key=k2&Tag.2.Value=v1&Version=2016-11-15",
+		},
+		"query idempotency token": {
+			contentType:  "application/x-www-form-urlencoded",
+			body:         "Action=RunInstances&ClientToken=token1&ImageId=ami-12345678&Version=2016-11-15",
+			cassetteBody: "Action=RunInstances&ImageId=ami-12345678&Version=2016-11-15&ClientToken=token2",
+			expected:     true,
+		},
+		"query different": {
+			contentType:  "application/x-www-form-urlencoded",
+			body:         "Action=GetRole&RoleName=test1&Version=2010-05-08",
+			cassetteBody: "Action=GetRole&RoleName=test2&Version=2010-05-08",
+		},
+		"unknown content type": {
+			contentType:  "application/octet-stream",
+			body:         "abc",
+			cassetteBody: "def",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := acctest.Context(t)
+
+			if got, want := acctest.VCRRequestBodiesMatch(ctx, testCase.contentType, testCase.body, testCase.cassetteBody), testCase.expected; got != want {
+				t.Errorf("match = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestAccountIDRedactor(t *testing.T) {
+	t.Parallel()
+
+	redactor := acctest.NewAccountIDRedactor()
+
+	interactions := []*cassette.Interaction{
+		{
+			Request: cassette.Request{
+				Body: "Action=GetCallerIdentity&Version=2011-06-15",
+				URL:  "https://sts.us-west-2.amazonaws.com/",
+			},
+			Response: cassette.Response{
+				Body: "<GetCallerIdentityResult><Arn>arn:aws:iam::111122223333:user/test</Arn><UserId>AIDACKCEVSQ6C2EXAMPLE</UserId><Account>111122223333</Account></GetCallerIdentityResult>",
+			},
+		},
+		{
+			Request: cassette.Request{
+				Body: "Action=GetRole&RoleName=test&Version=2010-05-08",
+				URL:  "https://iam.amazonaws.com/",
+			},
+			Response: cassette.Response{
+				Body: "<GetRoleResult><Role><Arn>arn:aws:iam::111122223333:role/test</Arn><RoleId>AROA1111222233334444</RoleId></Role></GetRoleResult>",
+			},
+		},
+		{
+			Request: cassette.Request{
+				Body: "Action=DescribeImages&Owner.1=1111222233334&Version=2016-11-15",
+				Form: url.Values{"PolicyArn": []string{"arn:aws:iam::111122223333:policy/test"}},
+				URL:  "https://111122223333.s3-control.us-west-2.amazonaws.com/v20180820/configuration/publicAccessBlock",
+			},
+			Response: cassette.Response{
+				Headers: http.Header{"X-Amz-Account-Id": []string{"111122223333"}},
+			},
+		},
+	}
+
+	for _, i := range interactions {
+		if err := acctest.AccountIDRedactorDiscover(redactor, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, i := range interactions {
+		if err := acctest.AccountIDRedactorRedact(redactor, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got, want := interactions[0].Response.Body, "<GetCallerIdentityResult><Arn>arn:aws:iam::000000000001:user/test</Arn><UserId>AIDACKCEVSQ6C2EXAMPLE</UserId><Account>000000000001</Account></GetCallerIdentityResult>"; got != want {
+		t.Errorf("GetCallerIdentity response = %q, want %q", got, want)
+	}
+	if got, want := interactions[1].Response.Body, "<GetRoleResult><Role><Arn>arn:aws:iam::000000000001:role/test</Arn><RoleId>AROA1111222233334444</RoleId></Role></GetRoleResult>"; got != want {
+		t.Errorf("GetRole response = %q, want %q", got, want)
+	}
+	if got, want := interactions[2].Request.Body, "Action=DescribeImages&Owner.1=1111222233334&Version=2016-11-15"; got != want {
+		t.Errorf("DescribeImages request = %q, want %q", got, want)
+	}
+	if got, want := interactions[2].Request.Form.Get("PolicyArn"), "arn:aws:iam::000000000001:policy/test"; got != want {
+		t.Errorf("form = %q, want %q", got, want)
+	}
+	if got, want := interactions[2].Request.URL, "https://000000000001.s3-control.us-west-2.amazonaws.com/v20180820/configuration/publicAccessBlock"; got != want {
+		t.Errorf("URL = %q, want %q", got, want)
+	}
+	if got, want := interactions[2].Response.Headers.Get("X-Amz-Account-Id"), "000000000001"; got != want {
+		t.Errorf("header = %q, want %q", got, want)
+	}
+}
+
+func TestInteractionNotFoundErrorNotRetryable(t *testing.T) {
+	t.Parallel()
+
+	err := &url.Error{
+		Op:  "Post",
+		URL: "https://logs.us-west-2.amazonaws.com/",
+		Err: acctest.NewInteractionNotFoundError(cassette.ErrInteractionNotFound),
+	}
+
+	if !errors.Is(err, cassette.ErrInteractionNotFound) {
+		t.Errorf("errors.Is(%v, ErrInteractionNotFound) = false", err)
+	}
+
+	if retry_sdkv2.NewStandard().IsErrorRetryable(&smithyhttp.RequestSendError{Err: err}) {
+		t.Error("AWS SDK for Go v2: retryable")
+	}
+
+	if request_sdkv1.IsErrorRetryable(awserr.New(request_sdkv1.ErrCodeRequestError, "send request failed", err)) {
+		t.Error("AWS SDK for Go v1: retryable")
+	}
+}
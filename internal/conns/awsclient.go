@@ -36,6 +36,7 @@ type AWSClient struct {
 	Partition         string
 	Region            string
 	ServicePackages   map[string]ServicePackage
+	TagPolicyConfig   *tftags.PolicyConfig
 
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
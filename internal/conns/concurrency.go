@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	concurrencyLimiterMiddlewareID = "TerraformAWSProviderConcurrencyLimiter"
+)
+
+// concurrencyLimiter limits the number of concurrent mutating AWS API calls per service.
+// A single limiter is shared by all resources configured by a provider instance.
+type concurrencyLimiter struct {
+	semaphores *tfsync.Semaphores // Keyed by normalized AWS SDK service ID.
+}
+
+// newConcurrencyLimiter returns a concurrency limiter for the specified limits,
+// keyed by service package name (e.g. "route53").
+func newConcurrencyLimiter(limits map[string]int) (*concurrencyLimiter, error) {
+	sizes := make(map[string]int64, len(limits))
+
+	for servicePackageName, limit := range limits {
+		sdkID := names.SdkId(servicePackageName)
+		if sdkID == "" {
+			return nil, fmt.Errorf("concurrency limit: unsupported service: %s", servicePackageName)
+		}
+
+		if limit < 1 {
+			return nil, fmt.Errorf("concurrency limit (%s): max must be at least 1, got %d", servicePackageName, limit)
+		}
+
+		sizes[normalizeServiceID(sdkID)] = int64(limit)
+	}
+
+	return &concurrencyLimiter{
+		semaphores: tfsync.NewSemaphores(sizes),
+	}, nil
+}
+
+// acquire waits until the specified AWS API call may proceed.
+// The returned function must be called once the call has completed.
+func (l *concurrencyLimiter) acquire(ctx context.Context, serviceID, operation string) (func(), error) {
+	semaphore := l.semaphores.Get(normalizeServiceID(serviceID))
+
+	if semaphore == nil || !isMutatingOperation(operation) {
+		return func() {}, nil
+	}
+
+	tflog.Debug(ctx, "Waiting for concurrency limit", map[string]any{
+		"aws.service":   serviceID,
+		"aws.operation": operation,
+		"max":           semaphore.Size(),
+	})
+
+	if err := semaphore.Acquire(ctx, 1); err != nil {
+		return nil, fmt.Errorf("waiting for %s concurrency limit: %w", serviceID, err)
+	}
+
+	return func() { semaphore.Release(1) }, nil
+}
+
+// apiOptionsSDKv2 returns an AWS SDK for Go v2 API option that adds the limiter to an operation's middleware stack.
+func (l *concurrencyLimiter) apiOptionsSDKv2() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		// Run after the operation's service metadata middleware, which sets the service ID and operation name,
+		// and before the retry middleware so that the limit applies across retries.
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(concurrencyLimiterMiddlewareID, func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			release, err := l.acquire(ctx, awsmiddleware_sdkv2.GetServiceID(ctx), awsmiddleware_sdkv2.GetOperationName(ctx))
+			if err != nil {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, err
+			}
+			defer release()
+
+			return next.HandleInitialize(ctx, in)
+		}), middleware.After)
+	}
+}
+
+type concurrencyLimiterReleaseKey struct{}
+
+// addHandlersSDKv1 adds the limiter to AWS SDK for Go v1 request handlers.
+func (l *concurrencyLimiter) addHandlersSDKv1(handlers *request_sdkv1.Handlers) {
+	// Validate handlers run once, when the request is first built.
+	handlers.Validate.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: concurrencyLimiterMiddlewareID,
+		Fn: func(r *request_sdkv1.Request) {
+			// Presigned requests are never sent, so Complete handlers are not run.
+			if r.ExpireTime > 0 {
+				return
+			}
+
+			ctx := r.Context()
+
+			release, err := l.acquire(ctx, r.ClientInfo.ServiceID, r.Operation.Name)
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			r.SetContext(context.WithValue(ctx, concurrencyLimiterReleaseKey{}, release))
+		},
+	})
+	// Complete handlers run regardless of the success or failure of the request.
+	handlers.Complete.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: concurrencyLimiterMiddlewareID,
+		Fn: func(r *request_sdkv1.Request) {
+			if release, ok := r.Context().Value(concurrencyLimiterReleaseKey{}).(func()); ok {
+				release()
+			}
+		},
+	})
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestNewConcurrencyLimiter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		limits    map[string]int
+		expectErr bool
+	}{
+		{
+			name:   "valid",
+			limits: map[string]int{names.Route53: 2, names.Lambda: 5},
+		},
+		{
+			name:      "unsupported service",
+			limits:    map[string]int{"notaservice": 2},
+			expectErr: true,
+		},
+		{
+			name:      "invalid max",
+			limits:    map[string]int{names.Route53: 0},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := newConcurrencyLimiter(testCase.limits)
+
+			if got, want := err != nil, testCase.expectErr; got != want {
+				t.Errorf("newConcurrencyLimiter err %t (%v), want %t", got, err, want)
+			}
+		})
+	}
+}
+
+func TestConcurrencyLimiterAcquire(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	limiter, err := newConcurrencyLimiter(map[string]int{names.Route53: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	release, err := limiter.acquire(ctx, "Route 53", "ChangeResourceRecordSets")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Read-only operations and other services are not limited.
+	if _, err := limiter.acquire(ctx, "Route 53", "ListResourceRecordSets"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if _, err := limiter.acquire(ctx, "Lambda", "CreateFunction"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := limiter.acquire(ctxTimeout, "Route 53", "ChangeResourceRecordSets"); err == nil {
+		t.Error("expected error waiting for concurrency limit")
+	}
+
+	release()
+
+	release, err = limiter.acquire(ctx, "Route 53", "ChangeResourceRecordSets")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	release()
+}
+
+func TestConcurrencyLimiterSDKv2(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	limiter, err := newConcurrencyLimiter(map[string]int{names.SSM: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	started, unblock := make(chan struct{}), make(chan struct{})
+	client := ssm.New(ssm.Options{
+		APIOptions:  []func(*middleware.Stack) error{limiter.apiOptionsSDKv2()},
+		Credentials: aws.AnonymousCredentials{},
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			if r.Header.Get("X-Amz-Target") == "AmazonSSM.PutParameter" {
+				select {
+				case started <- struct{}{}:
+					<-unblock
+				default:
+				}
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/x-amz-json-1.1"}},
+				Body:       io.NopCloser(strings.NewReader("{}")),
+				Request:    r,
+			}, nil
+		}),
+		Region:           "us-west-2",
+		RetryMaxAttempts: 1,
+	})
+	input := &ssm.PutParameterInput{Name: aws.String("example"), Value: aws.String("value")}
+
+	done := make(chan error)
+	go func() {
+		_, err := client.PutParameter(ctx, input)
+		done <- err
+	}()
+	<-started
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+
+	// Read-only operations are not limited.
+	if _, err := client.GetParameter(ctxTimeout, &ssm.GetParameterInput{Name: aws.String("example")}); err != nil {
+		t.Errorf("GetParameter: unexpected error: %s", err)
+	}
+
+	if _, err := client.PutParameter(ctxTimeout, input); err == nil || !strings.Contains(err.Error(), "concurrency limit") {
+		t.Errorf("PutParameter: expected error waiting for concurrency limit, got %v", err)
+	}
+
+	close(unblock)
+	if err := <-done; err != nil {
+		t.Fatalf("PutParameter: unexpected error: %s", err)
+	}
+
+	if _, err := client.PutParameter(ctx, input); err != nil {
+		t.Errorf("PutParameter: unexpected error: %s", err)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -31,8 +32,11 @@ type Config struct {
 	AllowedAccountIds              []string
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	ConcurrencyLimits              map[string]int
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DryRun                         bool
+	DryRunFile                     string
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
@@ -45,6 +49,7 @@ type Config struct {
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	RateLimits                     map[string]RateLimit
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
@@ -57,9 +62,11 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
+	Tracing                        *tracing.Config
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
 }
@@ -179,6 +186,56 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if len(c.ConcurrencyLimits) > 0 {
+		limiter, err := newConcurrencyLimiter(c.ConcurrencyLimits)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		cfg.APIOptions = append(cfg.APIOptions, limiter.apiOptionsSDKv2())
+		limiter.addHandlersSDKv1(&session.Handlers)
+	}
+
+	if len(c.RateLimits) > 0 {
+		limiter, err := newRateLimiter(c.RateLimits)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		cfg.APIOptions = append(cfg.APIOptions, limiter.apiOptionsSDKv2())
+		limiter.addHandlersSDKv1(&session.Handlers)
+	}
+
+	if c.Tracing != nil {
+		if err := tracing.Configure(ctx, c.Tracing); err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		// With AWS SDK for Go v2, spans start in the Initialize step after the concurrency limiter, so they don't
+		// include time spent waiting for a concurrency limit but do include time spent waiting on the rate limiter,
+		// which runs on each attempt in the Finalize step.
+		// With AWS SDK for Go v1, the handlers are pushed to the front of the Validate list, so spans include both.
+		cfg.APIOptions = append(cfg.APIOptions, tracingAPIOptionsSDKv2())
+		addTracingHandlersSDKv1(&session.Handlers)
+	}
+
+	if c.DryRun {
+		recorder, err := newDryRunRecorder(c.DryRunFile)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		// With AWS SDK for Go v2, mutating calls are intercepted in the Initialize step after the concurrency limiter
+		// and tracing middleware, and before they are serialized, signed, rate limited, retried or sent.
+		// With AWS SDK for Go v1, the handler is pushed to the front of the Validate list, so it runs first.
+		cfg.APIOptions = append(cfg.APIOptions, recorder.apiOptionsSDKv2())
+		recorder.addHandlersSDKv1(&session.Handlers)
+
+		diags = append(diags, errs.NewWarningDiagnostic(
+			"Dry run mode enabled",
+			fmt.Sprintf("Mutating AWS API calls will be recorded to %q and not executed.", recorder.path)))
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -211,6 +268,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
+	client.TagPolicyConfig = c.TagPolicyConfig
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
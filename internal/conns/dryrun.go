@@ -0,0 +1,349 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	dryRunMiddlewareID = "TerraformAWSProviderDryRun"
+
+	// DefaultDryRunFile is the default file to which dry run API calls are recorded.
+	DefaultDryRunFile = "terraform-provider-aws-dry-run.jsonl"
+)
+
+// dryRunExemptServiceIDs are the normalized AWS SDK service IDs of services
+// whose operations are always executed in dry run mode.
+// STS operations are used to obtain credentials and do not modify resources.
+var dryRunExemptServiceIDs = []string{
+	"sts",
+}
+
+// dryRunSensitiveFieldNames are the names of request parameters whose values
+// are not recorded. AWS SDK for Go v2 doesn't mark sensitive members, so
+// parameters holding credentials are redacted by exact name in addition to the
+// AWS SDK for Go v1 `sensitive` struct tag.
+// Names are matched exactly so that identifiers such as `SecretId` and
+// `ClientToken` are recorded.
+var dryRunSensitiveFieldNames = []string{
+	"AccessToken",
+	"AuthKey",
+	"AuthToken",
+	"AuthenticationCode1",
+	"AuthenticationCode2",
+	"AuthenticationToken",
+	"ClientSecret",
+	"Credentials",
+	"MasterUserPassword",
+	"NewPassword",
+	"OldPassword",
+	"Passphrase",
+	"Password",
+	"PreSharedKey",
+	"PrivateKey",
+	"SSECustomerKey",
+	"SecretAccessKey",
+	"SecretBinary",
+	"SecretString",
+	"SessionToken",
+	"SharedSecret",
+}
+
+const dryRunRedacted = "<sensitive>"
+
+// DryRunError is returned for a mutating AWS API call that was recorded but
+// not executed in dry run mode.
+type DryRunError struct {
+	ServiceID string
+	Operation string
+}
+
+func (e *DryRunError) Error() string {
+	return fmt.Sprintf("dry run: %s %s call recorded, not executed", e.ServiceID, e.Operation)
+}
+
+// dryRunCall is a single record in a dry run file.
+type dryRunCall struct {
+	Time         time.Time       `json:"time"`
+	Service      string          `json:"service"`
+	Operation    string          `json:"operation"`
+	Region       string          `json:"region,omitempty"`
+	ResourceType string          `json:"resource_type,omitempty"`
+	Parameters   json.RawMessage `json:"parameters"`
+}
+
+// dryRunRecorder records mutating AWS API calls, one JSON object per line,
+// instead of executing them.
+type dryRunRecorder struct {
+	lock sync.Mutex
+	path string
+	w    io.Writer
+	now  func() time.Time
+}
+
+// dryRunRecorders are the recorders opened by this provider process, keyed by file path.
+// Provider instances that record to the same file share a recorder.
+var dryRunRecorders = struct {
+	lock  sync.Mutex
+	store map[string]*dryRunRecorder
+}{
+	store: make(map[string]*dryRunRecorder),
+}
+
+func newDryRunRecorder(path string) (*dryRunRecorder, error) {
+	if path == "" {
+		path = DefaultDryRunFile
+	}
+
+	dryRunRecorders.lock.Lock()
+	defer dryRunRecorders.lock.Unlock()
+
+	if r, ok := dryRunRecorders.store[path]; ok {
+		return r, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening dry run file: %w", err)
+	}
+
+	r := &dryRunRecorder{
+		path: path,
+		w:    f,
+		now:  time.Now,
+	}
+	dryRunRecorders.store[path] = r
+
+	return r, nil
+}
+
+// CloseDryRunFiles flushes and closes all dry run files.
+// It should be called before the provider process exits.
+func CloseDryRunFiles() error {
+	dryRunRecorders.lock.Lock()
+	defer dryRunRecorders.lock.Unlock()
+
+	var errs []error
+	for path, r := range dryRunRecorders.store {
+		if err := r.close(); err != nil {
+			errs = append(errs, fmt.Errorf("closing dry run file (%s): %w", path, err))
+		}
+		delete(dryRunRecorders.store, path)
+	}
+
+	return errors.Join(errs...)
+}
+
+// close flushes and closes the recorder's file. Subsequent calls fail to be recorded.
+func (r *dryRunRecorder) close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	f, ok := r.w.(*os.File)
+	if !ok {
+		return nil
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// intercept records the specified API call and returns a DryRunError if it is mutating.
+// Non-mutating calls are neither recorded nor intercepted.
+func (r *dryRunRecorder) intercept(ctx context.Context, serviceID, operation, region string, params any) error {
+	if !isMutatingOperation(operation) {
+		return nil
+	}
+	for _, v := range dryRunExemptServiceIDs {
+		if normalizeServiceID(serviceID) == v {
+			return nil
+		}
+	}
+
+	call := dryRunCall{
+		Time:      r.now().UTC(),
+		Service:   serviceID,
+		Operation: operation,
+		Region:    region,
+	}
+	if v, ok := FromContext(ctx); ok {
+		call.ResourceType = v.TypeName
+	}
+
+	parameters, err := marshalDryRunJSON(redactDryRunParameters(reflect.ValueOf(params)))
+	if err != nil {
+		// Record only the parameters' type if they can't be represented as JSON, e.g. NaN floating-point values.
+		parameters, err = marshalDryRunJSON(fmt.Sprintf("%T", params))
+		if err != nil {
+			return err
+		}
+	}
+	call.Parameters = parameters
+
+	b, err := marshalDryRunJSON(call)
+	if err != nil {
+		return err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, err := r.w.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("recording dry run call: %w", err)
+	}
+
+	tflog.Info(ctx, "Dry run: recorded AWS API call", map[string]any{
+		"aws.service":   serviceID,
+		"aws.operation": operation,
+	})
+
+	return &DryRunError{
+		ServiceID: serviceID,
+		Operation: operation,
+	}
+}
+
+// apiOptionsSDKv2 returns an AWS SDK for Go v2 API option that adds the recorder to an operation's middleware stack.
+func (r *dryRunRecorder) apiOptionsSDKv2() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		// Run after the operation's service metadata middleware, which sets the service ID and operation name,
+		// but before the Serialize, Build and Finalize steps so that intercepted calls are not serialized, signed or retried.
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(dryRunMiddlewareID, func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			if err := r.intercept(ctx, awsmiddleware_sdkv2.GetServiceID(ctx), awsmiddleware_sdkv2.GetOperationName(ctx), awsmiddleware_sdkv2.GetRegion(ctx), in.Parameters); err != nil {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, err
+			}
+
+			return next.HandleInitialize(ctx, in)
+		}), middleware.After)
+	}
+}
+
+// addHandlersSDKv1 adds the recorder to AWS SDK for Go v1 request handlers.
+func (r *dryRunRecorder) addHandlersSDKv1(handlers *request_sdkv1.Handlers) {
+	// Validate handlers run once, when the request is first built.
+	// An error prevents the request from being built, signed or sent.
+	handlers.Validate.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: dryRunMiddlewareID,
+		Fn: func(req *request_sdkv1.Request) {
+			// Presigned requests are never sent by the provider.
+			if req.ExpireTime > 0 {
+				return
+			}
+
+			if err := r.intercept(req.Context(), req.ClientInfo.ServiceID, req.Operation.Name, aws_sdkv1.StringValue(req.Config.Region), req.Params); err != nil {
+				req.Error = err
+			}
+		},
+	})
+}
+
+// redactDryRunParameters returns a representation of the specified request parameters that can be marshaled to JSON,
+// with the values of sensitive parameters replaced. Unset parameters are omitted and binary data and streams are
+// summarized.
+func redactDryRunParameters(v reflect.Value) any {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Type().Implements(reflect.TypeOf((*io.Reader)(nil)).Elem()) {
+			return "<stream>"
+		}
+		return redactDryRunParameters(v.Elem())
+	case reflect.Struct:
+		if t, ok := v.Interface().(time.Time); ok {
+			return t
+		}
+
+		m := make(map[string]any)
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			value := v.Field(i)
+			// Unset parameters, including enumeration values, are zero.
+			if value.IsZero() {
+				continue
+			}
+
+			if isDryRunSensitiveField(field) {
+				m[field.Name] = dryRunRedacted
+				continue
+			}
+
+			m[field.Name] = redactDryRunParameters(value)
+		}
+		return m
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return fmt.Sprintf("<binary> len %d", v.Len())
+		}
+
+		s := make([]any, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			s = append(s, redactDryRunParameters(v.Index(i)))
+		}
+		return s
+	case reflect.Map:
+		m := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			m[fmt.Sprint(iter.Key().Interface())] = redactDryRunParameters(iter.Value())
+		}
+		return m
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64, reflect.String:
+		return v.Interface()
+	default:
+		// Go syntax for values that can't be represented as JSON.
+		return fmt.Sprintf("%+v", v.Interface())
+	}
+}
+
+// marshalDryRunJSON returns the JSON encoding of v without escaping HTML characters,
+// so that placeholders such as "<sensitive>" are recorded as is.
+func marshalDryRunJSON(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func isDryRunSensitiveField(field reflect.StructField) bool {
+	if field.Tag.Get("sensitive") == "true" {
+		return true
+	}
+
+	return slices.Contains(dryRunSensitiveFieldNames, field.Name)
+}
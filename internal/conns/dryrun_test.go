@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestDryRunRecorderIntercept(t *testing.T) {
+	t.Parallel()
+
+	type params struct {
+		Bucket *string
+		Policy *string
+	}
+	bucket, policy := "example", `{"Version":"2012-10-17"}`
+
+	testCases := []struct {
+		name           string
+		serviceID      string
+		operation      string
+		params         any
+		expectedRecord string
+		expectErr      bool
+	}{
+		{
+			name:      "read-only",
+			serviceID: "S3",
+			operation: "GetBucketPolicy",
+			params:    &params{Bucket: &bucket},
+		},
+		{
+			name:      "exempt service",
+			serviceID: "STS",
+			operation: "AssumeRole",
+		},
+		{
+			name:           "mutating",
+			serviceID:      "S3",
+			operation:      "PutBucketPolicy",
+			params:         &params{Bucket: &bucket, Policy: &policy},
+			expectedRecord: `{"time":"2024-04-01T12:00:00Z","service":"S3","operation":"PutBucketPolicy","region":"us-west-2","resource_type":"aws_s3_bucket_policy","parameters":{"Bucket":"example","Policy":"{\"Version\":\"2012-10-17\"}"}}` + "\n",
+			expectErr:      true,
+		},
+		{
+			name:           "unmarshalable parameters",
+			serviceID:      "EC2",
+			operation:      "ModifyInstanceAttribute",
+			params:         func() {},
+			expectedRecord: `{"time":"2024-04-01T12:00:00Z","service":"EC2","operation":"ModifyInstanceAttribute","region":"us-west-2","resource_type":"aws_s3_bucket_policy","parameters":"0x`,
+			expectErr:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			recorder := &dryRunRecorder{
+				w: &buf,
+				now: func() time.Time {
+					return time.Date(2024, time.April, 1, 12, 0, 0, 0, time.UTC)
+				},
+			}
+			ctx := NewResourceContext(context.Background(), "s3", "Bucket Policy", "aws_s3_bucket_policy")
+
+			err := recorder.intercept(ctx, testCase.serviceID, testCase.operation, "us-west-2", testCase.params)
+
+			var dryRunErr *DryRunError
+			if got, want := errors.As(err, &dryRunErr), testCase.expectErr; got != want {
+				t.Fatalf("dry run error = %t, want %t (%v)", got, want, err)
+			}
+
+			if got, want := buf.String(), testCase.expectedRecord; !bytes.HasPrefix([]byte(got), []byte(want)) || (want == "" && got != "") {
+				t.Errorf("record = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDryRunRecorderSDKv2(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	recorder := &dryRunRecorder{
+		w: &buf,
+		now: func() time.Time {
+			return time.Date(2024, time.April, 1, 12, 0, 0, 0, time.UTC)
+		},
+	}
+
+	var sent []string
+	client := ssm.New(ssm.Options{
+		APIOptions:  []func(*middleware.Stack) error{recorder.apiOptionsSDKv2()},
+		Credentials: aws.AnonymousCredentials{},
+		HTTPClient:  newTestHTTPClient(&sent),
+		Region:      "us-west-2",
+	})
+	ctx := NewResourceContext(context.Background(), "ssm", "Parameter", "aws_ssm_parameter")
+
+	if _, err := client.GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String("example")}); err != nil {
+		t.Fatalf("GetParameter: unexpected error: %s", err)
+	}
+
+	_, err := client.PutParameter(ctx, &ssm.PutParameterInput{Name: aws.String("example"), Value: aws.String("value")})
+
+	var dryRunErr *DryRunError
+	if !errors.As(err, &dryRunErr) {
+		t.Fatalf("PutParameter: expected dry run error, got %v", err)
+	}
+	if got, want := dryRunErr.ServiceID, "SSM"; got != want {
+		t.Errorf("service ID = %q, want %q", got, want)
+	}
+	if got, want := dryRunErr.Operation, "PutParameter"; got != want {
+		t.Errorf("operation = %q, want %q", got, want)
+	}
+
+	if got, want := strings.Join(sent, ","), "AmazonSSM.GetParameter"; got != want {
+		t.Errorf("sent requests = %q, want %q", got, want)
+	}
+
+	if got, want := buf.String(), `{"time":"2024-04-01T12:00:00Z","service":"SSM","operation":"PutParameter","region":"us-west-2","resource_type":"aws_ssm_parameter","parameters":{"Name":"example","Value":"value"}}`+"\n"; got != want {
+		t.Errorf("record = %q, want %q", got, want)
+	}
+}
+
+func TestRedactDryRunParameters(t *testing.T) {
+	t.Parallel()
+
+	type tags struct {
+		Key   *string
+		Value *string
+	}
+	type params struct {
+		Name               *string
+		MasterUserPassword *string
+		SecretId           *string
+		SecretString       *string
+		ClientToken        *string
+		Value              *string `sensitive:"true"`
+		UserData           []byte
+		Body               io.Reader
+		Tags               []tags
+		Unset              *string
+		Type               string
+		unexported         string
+	}
+
+	got, err := marshalDryRunJSON(redactDryRunParameters(reflect.ValueOf(&params{
+		Name:               aws.String("example"),
+		MasterUserPassword: aws.String("password"),
+		SecretId:           aws.String("example"),
+		SecretString:       aws.String("secret"),
+		ClientToken:        aws.String("token"),
+		Value:              aws.String("value"),
+		UserData:           []byte("#!/bin/bash"),
+		Body:               strings.NewReader("body"),
+		Tags:               []tags{{Key: aws.String("Name"), Value: aws.String("example")}},
+		unexported:         "unexported",
+	})))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := `{"Body":"<stream>","ClientToken":"token","MasterUserPassword":"<sensitive>","Name":"example","SecretId":"example","SecretString":"<sensitive>","Tags":[{"Key":"Name","Value":"example"}],"UserData":"<binary> len 11","Value":"<sensitive>"}`; string(got) != want {
+		t.Errorf("parameters = %s, want %s", got, want)
+	}
+}
+
+func TestCloseDryRunFiles(t *testing.T) { //nolint:paralleltest // Modifies package-level state.
+	path := filepath.Join(t.TempDir(), "dry-run.jsonl")
+
+	recorder, err := newDryRunRecorder(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if other, err := newDryRunRecorder(path); err != nil || other != recorder {
+		t.Errorf("newDryRunRecorder = %p, %v, want shared recorder %p", other, err, recorder)
+	}
+
+	ctx := context.Background()
+	var dryRunErr *DryRunError
+	if err := recorder.intercept(ctx, "S3", "DeleteBucket", "us-west-2", nil); !errors.As(err, &dryRunErr) {
+		t.Fatalf("expected dry run error, got %v", err)
+	}
+
+	if err := CloseDryRunFiles(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := string(b), `"operation":"DeleteBucket"`; !strings.Contains(got, want) {
+		t.Errorf("file = %q, want to contain %q", got, want)
+	}
+
+	if err := recorder.intercept(ctx, "S3", "DeleteBucket", "us-west-2", nil); errors.As(err, &dryRunErr) || err == nil {
+		t.Errorf("expected error recording to a closed file, got %v", err)
+	}
+}
+
+// newTestHTTPClient returns an AWS SDK for Go v2 HTTP client that records the target of each JSON protocol request
+// and returns an empty response.
+func newTestHTTPClient(sent *[]string) smithyhttp.ClientDoFunc {
+	return func(r *http.Request) (*http.Response, error) {
+		*sent = append(*sent, r.Header.Get("X-Amz-Target"))
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/x-amz-json-1.1"}},
+			Body:       io.NopCloser(strings.NewReader("{}")),
+			Request:    r,
+		}, nil
+	}
+}
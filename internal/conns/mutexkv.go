@@ -4,9 +4,18 @@
 package conns
 
 import (
+	"context"
+	"slices"
 	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// mutexKVWaitWarningInterval is how often a warning is logged while waiting
+// for a lock that is held by another collaborator.
+const mutexKVWaitWarningInterval = 1 * time.Minute
+
 // GlobalMutexKV is a global MutexKV for use within this plugin.
 var GlobalMutexKV = newMutexKV()
 
@@ -15,27 +24,148 @@ var GlobalMutexKV = newMutexKV()
 // keys they must serialize on.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*keyMutex
+}
+
+// keyMutex is a mutex whose acquisition can be abandoned when a context is
+// done. The channel holds a single token while the mutex is locked.
+type keyMutex struct {
+	ch chan struct{}
+
+	lock       sync.Mutex
+	lockedAt   time.Time
+	lockedWith []string
+}
+
+func newKeyMutex() *keyMutex {
+	return &keyMutex{
+		ch: make(chan struct{}, 1),
+	}
+}
+
+// holder returns when the mutex was last locked and the keys locked along
+// with it.
+func (km *keyMutex) holder() (time.Time, []string) {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+
+	return km.lockedAt, km.lockedWith
+}
+
+func (km *keyMutex) setHolder(lockedAt time.Time, lockedWith []string) {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+
+	km.lockedAt, km.lockedWith = lockedAt, lockedWith
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
-	m.get(key).Lock()
+	_ = m.LockContext(context.Background(), key)
+}
+
+// LockContext locks the mutex for the given key, waiting until the lock is
+// acquired or the context is done. Caller is responsible for calling Unlock
+// for the same key if and only if no error is returned.
+func (m *mutexKV) LockContext(ctx context.Context, key string) error {
+	return m.lockKey(ctx, key, []string{key})
+}
+
+// LockMany locks the mutexes for all of the given keys. Keys are de-duplicated
+// and acquired in sorted order so that collaborators locking overlapping sets
+// of keys cannot deadlock. If the context is done before all of the locks are
+// acquired, any locks already held are released and the context's error is
+// returned. On success the returned function releases all of the locks.
+func (m *mutexKV) LockMany(ctx context.Context, keys ...string) (func(), error) {
+	keys = slices.Clone(keys)
+	slices.Sort(keys)
+	keys = slices.Compact(keys)
+
+	for i, key := range keys {
+		if err := m.lockKey(ctx, key, keys); err != nil {
+			m.unlockAll(keys[:i])
+
+			return nil, err
+		}
+	}
+
+	return func() {
+		m.unlockAll(keys)
+	}, nil
+}
+
+// unlockAll unlocks the mutexes for the given keys in reverse order.
+func (m *mutexKV) unlockAll(keys []string) {
+	for i := len(keys) - 1; i >= 0; i-- {
+		m.Unlock(keys[i])
+	}
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
-	m.get(key).Unlock()
+	km := m.get(key)
+
+	select {
+	case <-km.ch:
+	default:
+		panic("conns: unlock of unlocked mutex " + key)
+	}
+}
+
+func (m *mutexKV) lockKey(ctx context.Context, key string, lockedWith []string) error {
+	km := m.get(key)
+
+	// Fast path: the lock is not contended.
+	select {
+	case km.ch <- struct{}{}:
+		km.setHolder(time.Now(), lockedWith)
+		return nil
+	default:
+	}
+
+	start := time.Now()
+	ticker := time.NewTicker(mutexKVWaitWarningInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case km.ch <- struct{}{}:
+			km.setHolder(time.Now(), lockedWith)
+			tflog.Debug(ctx, "Acquired lock", map[string]any{
+				"lock_key":  key,
+				"wait_time": time.Since(start).String(),
+			})
+			return nil
+		case <-ticker.C:
+			lockedAt, heldWith := km.holder()
+			tflog.Warn(ctx, "Waiting for lock", map[string]any{
+				"lock_key":         key,
+				"wait_time":        time.Since(start).String(),
+				"lock_held_for":    time.Since(lockedAt).String(),
+				"lock_held_with":   heldWith,
+				"lock_acquire_set": lockedWith,
+			})
+		case <-ctx.Done():
+			lockedAt, heldWith := km.holder()
+			tflog.Debug(ctx, "Abandoned waiting for lock", map[string]any{
+				"lock_key":       key,
+				"wait_time":      time.Since(start).String(),
+				"lock_held_for":  time.Since(lockedAt).String(),
+				"lock_held_with": heldWith,
+			})
+			return ctx.Err()
+		}
+	}
 }
 
 // Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+func (m *mutexKV) get(key string) *keyMutex {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
+		mutex = newKeyMutex()
 		m.store[key] = mutex
 	}
 	return mutex
@@ -44,6 +174,6 @@ func (m *mutexKV) get(key string) *sync.Mutex {
 // Returns a properly initialized MutexKV
 func newMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*keyMutex),
 	}
 }
@@ -4,6 +4,9 @@
 package conns
 
 import (
+	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 )
@@ -74,3 +77,130 @@ func TestMutexKVDifferentKeys(t *testing.T) {
 		t.Fatal("Second lock on a different key blocked. This shouldn't happen.")
 	}
 }
+
+func TestMutexKVLockContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	mkv.Lock("foo")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.LockContext(ctx, "foo"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected %s, got %v", context.DeadlineExceeded, err)
+	}
+
+	mkv.Unlock("foo")
+
+	if err := mkv.LockContext(context.Background(), "foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestMutexKVLockMany(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	unlock, err := mkv.LockMany(context.Background(), "foo", "bar", "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, key := range []string{"foo", "bar"} {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		if err := mkv.LockContext(ctx, key); err == nil {
+			t.Fatalf("lock on %q was able to be taken. This shouldn't happen.", key)
+		}
+		cancel()
+	}
+
+	unlock()
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		mkv.Lock("foo")
+		mkv.Lock("bar")
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Lock blocked after unlock. This shouldn't happen.")
+	}
+}
+
+func TestMutexKVLockManyOrdered(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			unlock, err := mkv.LockMany(context.Background(), "foo", "bar", "baz")
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			unlock()
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			unlock, err := mkv.LockMany(context.Background(), "baz", "bar", "foo")
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			unlock()
+		}()
+	}
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		wg.Wait()
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(5 * time.Second):
+		t.Fatal("LockMany deadlocked. This shouldn't happen.")
+	}
+}
+
+func TestMutexKVLockManyCanceledReleases(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	mkv.Lock("foo")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := mkv.LockMany(ctx, "bar", "foo"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected %s, got %v", context.DeadlineExceeded, err)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.LockContext(ctx, "bar"); err != nil {
+		t.Fatalf("lock on %q was not released: %s", "bar", err)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"strings"
+)
+
+// readOnlyOperationPrefixes are the AWS API operation name prefixes of
+// operations that do not modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Check",
+	"Describe",
+	"Estimate",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Preview",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+	"Simulate",
+	"Test",
+	"Validate",
+	"View",
+}
+
+// isMutatingOperation returns whether or not the specified AWS API operation
+// (e.g. "ChangeResourceRecordSets") can modify resources.
+// Operations are assumed to be mutating unless their name has a well-known read-only prefix.
+func isMutatingOperation(operation string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// normalizeServiceID returns the normalized form of an AWS SDK service ID
+// (e.g. "Route 53"), suitable for use as a map key.
+func normalizeServiceID(serviceID string) string {
+	return strings.ToLower(strings.ReplaceAll(serviceID, " ", ""))
+}
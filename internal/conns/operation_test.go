@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestIsMutatingOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		operation string
+		expected  bool
+	}{
+		{"ChangeResourceRecordSets", true},
+		{"CreateBucket", true},
+		{"DeleteStack", true},
+		{"ModifyInstanceAttribute", true},
+		{"PutBucketPolicy", true},
+		{"TagResource", true},
+		{"DescribeInstances", false},
+		{"GetBucketPolicy", false},
+		{"ListResourceRecordSets", false},
+		{"HeadObject", false},
+		{"BatchGetItem", false},
+		{"SimulatePrincipalPolicy", false},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := isMutatingOperation(testCase.operation), testCase.expected; got != want {
+			t.Errorf("isMutatingOperation(%q) = %v, want %v", testCase.operation, got, want)
+		}
+	}
+}
+
+func TestNormalizeServiceID(t *testing.T) {
+	t.Parallel()
+
+	if got, want := normalizeServiceID("Route 53"), "route53"; got != want {
+		t.Errorf("normalizeServiceID = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/time/rate"
+)
+
+const (
+	rateLimiterMiddlewareID = "TerraformAWSProviderRateLimiter"
+)
+
+// RateLimit is the maximum request rate for an AWS service.
+type RateLimit struct {
+	RequestsPerSecond float64 // Defaults to the service's default rate limit.
+	Burst             int     // Defaults to RequestsPerSecond, rounded up.
+}
+
+// defaultRateLimits are the default request rates of services whose rate limit is configured without a request rate,
+// keyed by service package name.
+var defaultRateLimits = map[string]RateLimit{
+	// IAM and AWS Organizations don't document their request quotas, so these are conservative estimates.
+	names.IAM:           {RequestsPerSecond: 10},
+	names.Organizations: {RequestsPerSecond: 2},
+	// https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html#limits-api-requests.
+	names.Route53: {RequestsPerSecond: 5},
+}
+
+// rateLimiter is a client-side, per-service request rate limiter.
+// A single limiter is shared by all resources configured by a provider instance.
+type rateLimiter struct {
+	limiters map[string]*rate.Limiter // Keyed by normalized AWS SDK service ID.
+}
+
+// newRateLimiter returns a rate limiter for the specified limits, keyed by service package name (e.g. "route53").
+// A limit without a request rate uses the service's default rate limit.
+func newRateLimiter(limits map[string]RateLimit) (*rateLimiter, error) {
+	limiters := make(map[string]*rate.Limiter, len(limits))
+
+	for servicePackageName, limit := range limits {
+		sdkID := names.SdkId(servicePackageName)
+		if sdkID == "" {
+			return nil, fmt.Errorf("rate limit: unsupported service: %s", servicePackageName)
+		}
+
+		if limit.RequestsPerSecond < 0 {
+			return nil, fmt.Errorf("rate limit (%s): requests_per_second must not be negative, got %f", servicePackageName, limit.RequestsPerSecond)
+		}
+
+		if limit.RequestsPerSecond == 0 {
+			v, ok := defaultRateLimits[servicePackageName]
+			if !ok {
+				return nil, fmt.Errorf("rate limit (%s): requests_per_second is required, the service has no default rate limit", servicePackageName)
+			}
+			limit.RequestsPerSecond = v.RequestsPerSecond
+		}
+
+		burst := limit.Burst
+		if burst == 0 {
+			burst = int(math.Ceil(limit.RequestsPerSecond))
+		}
+
+		limiters[normalizeServiceID(sdkID)] = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst)
+	}
+
+	return &rateLimiter{
+		limiters: limiters,
+	}, nil
+}
+
+// wait blocks until a request to the specified AWS API operation is allowed.
+func (l *rateLimiter) wait(ctx context.Context, serviceID, operation string) error {
+	limiter, ok := l.limiters[normalizeServiceID(serviceID)]
+	if !ok {
+		return nil
+	}
+
+	r := limiter.Reserve()
+	if !r.OK() {
+		return fmt.Errorf("waiting for %s rate limit: burst of %d exceeded", serviceID, limiter.Burst())
+	}
+
+	delay := r.Delay()
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		r.Cancel()
+		return fmt.Errorf("waiting for %s rate limit: %w", serviceID, ctx.Err())
+	}
+
+	tflog.Debug(ctx, "Request delayed by rate limit", map[string]any{
+		"aws.service":         serviceID,
+		"aws.operation":       operation,
+		"requests_per_second": float64(limiter.Limit()),
+		"delay":               delay.String(),
+	})
+
+	return nil
+}
+
+// apiOptionsSDKv2 returns an AWS SDK for Go v2 API option that adds the limiter to an operation's middleware stack.
+func (l *rateLimiter) apiOptionsSDKv2() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		mw := middleware.FinalizeMiddlewareFunc(rateLimiterMiddlewareID, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			if err := l.wait(ctx, awsmiddleware_sdkv2.GetServiceID(ctx), awsmiddleware_sdkv2.GetOperationName(ctx)); err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+
+			return next.HandleFinalize(ctx, in)
+		})
+
+		// Run after the retry middleware so that every attempt is rate limited.
+		if _, ok := stack.Finalize.Get("Retry"); ok {
+			return stack.Finalize.Insert(mw, "Retry", middleware.After)
+		}
+
+		return stack.Finalize.Add(mw, middleware.Before)
+	}
+}
+
+// addHandlersSDKv1 adds the limiter to AWS SDK for Go v1 request handlers.
+func (l *rateLimiter) addHandlersSDKv1(handlers *request_sdkv1.Handlers) {
+	// Sign handlers run before every attempt.
+	handlers.Sign.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: rateLimiterMiddlewareID,
+		Fn: func(r *request_sdkv1.Request) {
+			if err := l.wait(r.Context(), r.ClientInfo.ServiceID, r.Operation.Name); err != nil {
+				r.Error = err
+			}
+		},
+	})
+}
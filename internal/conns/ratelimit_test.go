@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestNewRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		limits           map[string]RateLimit
+		expectedLimiters map[string]float64
+		expectErr        bool
+	}{
+		{
+			name:             "none",
+			expectedLimiters: map[string]float64{},
+		},
+		{
+			name: "configured",
+			limits: map[string]RateLimit{
+				names.IAM:    {RequestsPerSecond: 20, Burst: 40},
+				names.Lambda: {RequestsPerSecond: 0.5},
+			},
+			expectedLimiters: map[string]float64{"iam": 20, "lambda": 0.5},
+		},
+		{
+			name: "defaults",
+			limits: map[string]RateLimit{
+				names.IAM:           {},
+				names.Organizations: {},
+				names.Route53:       {Burst: 1},
+			},
+			expectedLimiters: map[string]float64{"iam": 10, "organizations": 2, "route53": 5},
+		},
+		{
+			name: "no default",
+			limits: map[string]RateLimit{
+				names.Lambda: {},
+			},
+			expectErr: true,
+		},
+		{
+			name: "unsupported service",
+			limits: map[string]RateLimit{
+				"notaservice": {RequestsPerSecond: 1},
+			},
+			expectErr: true,
+		},
+		{
+			name: "negative rate",
+			limits: map[string]RateLimit{
+				names.IAM: {RequestsPerSecond: -1},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter, err := newRateLimiter(testCase.limits)
+
+			if got, want := err != nil, testCase.expectErr; got != want {
+				t.Fatalf("newRateLimiter err %t (%v), want %t", got, err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := len(limiter.limiters), len(testCase.expectedLimiters); got != want {
+				t.Errorf("len(limiters) = %d, want %d", got, want)
+			}
+			for k, want := range testCase.expectedLimiters {
+				v, ok := limiter.limiters[k]
+				if !ok {
+					t.Errorf("limiter %s not found", k)
+					continue
+				}
+				if got := float64(v.Limit()); got != want {
+					t.Errorf("limiter %s rate = %f, want %f", k, got, want)
+				}
+			}
+		})
+	}
+}
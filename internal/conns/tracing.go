@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracingMiddlewareID = "TerraformAWSProviderTracing"
+)
+
+// apiCallTrace accumulates per-attempt information for an AWS API call's span.
+type apiCallTrace struct {
+	span      trace.Span
+	attempts  int
+	throttles int
+}
+
+type apiCallTraceKey struct{}
+
+// StartSpan starts a span for the specified Terraform operation, e.g. "Create".
+// The span is annotated with the resource or data source information in Context.
+func StartSpan(ctx context.Context, operation string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		tracing.AttrTerraformOperation.String(operation),
+	}
+	attrs = append(attrs, resourceSpanAttributes(ctx)...)
+
+	name := operation
+	if v, ok := FromContext(ctx); ok && v.TypeName != "" {
+		name = v.TypeName + "." + operation
+	}
+
+	return tracing.Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+func resourceSpanAttributes(ctx context.Context) []attribute.KeyValue {
+	v, ok := FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	return []attribute.KeyValue{
+		tracing.AttrTerraformDataSource.Bool(v.IsDataSource),
+		tracing.AttrTerraformResource.String(v.TypeName),
+		tracing.AttrTerraformService.String(v.ServicePackageName),
+	}
+}
+
+func startAPICallSpan(ctx context.Context, serviceID, operation, region string) (context.Context, *apiCallTrace) {
+	attrs := []attribute.KeyValue{
+		tracing.AttrAWSService.String(serviceID),
+		tracing.AttrAWSOperation.String(operation),
+		tracing.AttrAWSRegion.String(region),
+	}
+	attrs = append(attrs, resourceSpanAttributes(ctx)...)
+
+	ctx, span := tracing.Tracer().Start(ctx, serviceID+"."+operation, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+
+	return ctx, &apiCallTrace{span: span}
+}
+
+func (t *apiCallTrace) attempt(throttled bool) {
+	t.attempts++
+	if throttled {
+		t.throttles++
+	}
+}
+
+func (t *apiCallTrace) end(requestID string, err error) {
+	t.span.SetAttributes(
+		tracing.AttrAWSRetryCount.Int(max(t.attempts-1, 0)),
+		tracing.AttrAWSThrottled.Bool(t.throttles > 0),
+		tracing.AttrAWSThrottleCount.Int(t.throttles),
+	)
+	if requestID != "" {
+		t.span.SetAttributes(tracing.AttrAWSRequestID.String(requestID))
+	}
+	tracing.EndSpan(t.span, err)
+}
+
+// tracingAPIOptionsSDKv2 returns an AWS SDK for Go v2 API option that emits a span for each operation.
+func tracingAPIOptionsSDKv2() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		// Run after the operation's service metadata middleware, which sets the service ID, operation name and Region.
+		// Middleware added to the Initialize step by earlier API options, such as the concurrency limiter, runs first.
+		err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc(tracingMiddlewareID, func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			ctx, t := startAPICallSpan(ctx, awsmiddleware_sdkv2.GetServiceID(ctx), awsmiddleware_sdkv2.GetOperationName(ctx), awsmiddleware_sdkv2.GetRegion(ctx))
+			ctx = middleware.WithStackValue(ctx, apiCallTraceKey{}, t)
+
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			requestID, _ := awsmiddleware_sdkv2.GetRequestIDMetadata(metadata)
+			t.end(requestID, err)
+
+			return out, metadata, err
+		}), middleware.After)
+		if err != nil {
+			return err
+		}
+
+		mw := middleware.FinalizeMiddlewareFunc(tracingMiddlewareID, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			out, metadata, err := next.HandleFinalize(ctx, in)
+
+			if t, ok := middleware.GetStackValue(ctx, apiCallTraceKey{}).(*apiCallTrace); ok {
+				t.attempt(err != nil && retry_sdkv2.IsErrorThrottles(retry_sdkv2.DefaultThrottles).IsErrorThrottle(err) == aws_sdkv2.TrueTernary)
+			}
+
+			return out, metadata, err
+		})
+
+		// Run after the retry middleware so that every attempt is counted.
+		if _, ok := stack.Finalize.Get("Retry"); ok {
+			return stack.Finalize.Insert(mw, "Retry", middleware.After)
+		}
+
+		return stack.Finalize.Add(mw, middleware.Before)
+	}
+}
+
+// addTracingHandlersSDKv1 adds handlers that emit a span for each operation to AWS SDK for Go v1 request handlers.
+func addTracingHandlersSDKv1(handlers *request_sdkv1.Handlers) {
+	// Validate handlers run once, when the request is first built.
+	handlers.Validate.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: tracingMiddlewareID,
+		Fn: func(r *request_sdkv1.Request) {
+			// Presigned requests are never sent, so Complete handlers are not run.
+			if r.ExpireTime > 0 {
+				return
+			}
+
+			ctx, t := startAPICallSpan(r.Context(), r.ClientInfo.ServiceID, r.Operation.Name, aws_sdkv1.StringValue(r.Config.Region))
+			r.SetContext(context.WithValue(ctx, apiCallTraceKey{}, t))
+		},
+	})
+	// AfterRetry handlers run after every attempt that returned an error.
+	handlers.AfterRetry.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: tracingMiddlewareID,
+		Fn: func(r *request_sdkv1.Request) {
+			if t, ok := r.Context().Value(apiCallTraceKey{}).(*apiCallTrace); ok {
+				t.attempt(request_sdkv1.IsErrorThrottle(r.Error))
+			}
+		},
+	})
+	// Complete handlers run regardless of the success or failure of the request.
+	handlers.Complete.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: tracingMiddlewareID,
+		Fn: func(r *request_sdkv1.Request) {
+			if t, ok := r.Context().Value(apiCallTraceKey{}).(*apiCallTrace); ok {
+				// The final, successful attempt is not seen by AfterRetry handlers.
+				if r.Error == nil {
+					t.attempt(false)
+				}
+				t.end(r.RequestID, r.Error)
+			}
+		},
+	})
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracingSDKv2(t *testing.T) { //nolint:paralleltest // Modifies the global tracer provider.
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(provider)
+
+	var sent []string
+	client := ssm.New(ssm.Options{
+		APIOptions:  []func(*middleware.Stack) error{tracingAPIOptionsSDKv2()},
+		Credentials: aws.AnonymousCredentials{},
+		HTTPClient:  newTestHTTPClient(&sent),
+		Region:      "us-west-2",
+	})
+	ctx := NewResourceContext(context.Background(), "ssm", "Parameter", "aws_ssm_parameter")
+
+	if _, err := client.GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String("example")}); err != nil {
+		t.Fatalf("GetParameter: unexpected error: %s", err)
+	}
+
+	spans := exporter.GetSpans()
+	if got, want := len(spans), 1; got != want {
+		t.Fatalf("spans = %d, want %d", got, want)
+	}
+
+	if got, want := spans[0].Name, "SSM.GetParameter"; got != want {
+		t.Errorf("name = %q, want %q", got, want)
+	}
+
+	attrs := make(map[string]string)
+	for _, v := range spans[0].Attributes {
+		attrs[string(v.Key)] = v.Value.Emit()
+	}
+
+	for k, want := range map[string]string{
+		string(tracing.AttrAWSService):        "SSM",
+		string(tracing.AttrAWSOperation):      "GetParameter",
+		string(tracing.AttrAWSRegion):         "us-west-2",
+		string(tracing.AttrAWSRetryCount):     "0",
+		string(tracing.AttrTerraformResource): "aws_ssm_parameter",
+	} {
+		if got := attrs[k]; got != want {
+			t.Errorf("%s = %q, want %q", k, got, want)
+		}
+	}
+}
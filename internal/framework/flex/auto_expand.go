@@ -5,8 +5,10 @@ package flex
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -26,7 +28,9 @@ import (
 // target data type) are copied.
 func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOptionsFunc) diag.Diagnostics {
 	var diags diag.Diagnostics
-	expander := &autoExpander{}
+	expander := &autoExpander{
+		options: newAutoFlexOptions(),
+	}
 
 	for _, optFn := range optFns {
 		optFn(expander)
@@ -41,7 +45,13 @@ func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOpti
 	return diags
 }
 
-type autoExpander struct{}
+type autoExpander struct {
+	options *autoFlexOptions
+}
+
+func (expander autoExpander) getOptions() *autoFlexOptions {
+	return expander.options
+}
 
 // convert converts a single Plugin Framework value to its AWS API equivalent.
 func (expander autoExpander) convert(ctx context.Context, valFrom, vTo reflect.Value) diag.Diagnostics {
@@ -262,6 +272,20 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 			return diags
 		}
 
+		//
+		// types.String --> time.Time
+		//
+		if tTo == reflect.TypeOf(time.Time{}) {
+			t, err := time.Parse(time.RFC3339, v.ValueString())
+			if err != nil {
+				diags.AddError("AutoFlEx", fmt.Sprintf("parsing timestamp: %s", err))
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(t))
+			return diags
+		}
+
 	case reflect.Ptr:
 		switch tElem := tTo.Elem(); tElem.Kind() {
 		case reflect.String:
@@ -285,6 +309,20 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 				vTo.Set(reflect.ValueOf(&v))
 				return diags
 			}
+
+			//
+			// types.String --> *time.Time
+			//
+			if tElem == reflect.TypeOf(time.Time{}) {
+				t, err := time.Parse(time.RFC3339, v.ValueString())
+				if err != nil {
+					diags.AddError("AutoFlEx", fmt.Sprintf("parsing timestamp: %s", err))
+					return diags
+				}
+
+				vTo.Set(reflect.ValueOf(&t))
+				return diags
+			}
 		}
 	}
 
@@ -327,6 +365,15 @@ func (expander autoExpander) object(ctx context.Context, vFrom basetypes.ObjectV
 				return diags
 			}
 		}
+
+	case reflect.Interface:
+		//
+		// types.Object --> union interface
+		//
+		if vFrom, ok := vFrom.(fwtypes.NestedObjectValue); ok && expander.options.hasUnionMembers(tTo) {
+			diags.Append(expander.nestedObjectToUnion(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
@@ -633,7 +680,12 @@ func (expander autoExpander) nestedObjectCollection(ctx context.Context, vFrom f
 			//
 			// types.List(OfObject) -> []interface.
 			//
-			// Smithy union type handling not yet implemented. Silently skip.
+			if expander.options.hasUnionMembers(tElem) {
+				diags.Append(expander.nestedObjectToUnionSlice(ctx, vFrom, tTo, vTo)...)
+				return diags
+			}
+
+			// Union members not registered. Silently skip.
 			return diags
 		}
 
@@ -641,7 +693,12 @@ func (expander autoExpander) nestedObjectCollection(ctx context.Context, vFrom f
 		//
 		// types.List(OfObject) -> interface.
 		//
-		// Smithy union type handling not yet implemented. Silently skip.
+		if expander.options.hasUnionMembers(tTo) {
+			diags.Append(expander.nestedObjectToUnion(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
+
+		// Union members not registered. Silently skip.
 		return diags
 	}
 
@@ -649,6 +706,192 @@ func (expander autoExpander) nestedObjectCollection(ctx context.Context, vFrom f
 	return diags
 }
 
+// empty leaves the AWS API value unset for an empty Plugin Framework value.
+func (expander autoExpander) empty(context.Context, reflect.Value, reflect.Value) diag.Diagnostics {
+	return nil
+}
+
+// json copies a Plugin Framework String(ish) value containing a JSON document to a compatible AWS API value.
+func (expander autoExpander) json(ctx context.Context, valFrom, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	vFrom, ok := valFrom.Interface().(basetypes.StringValuable)
+	if !ok {
+		diags.AddError("AutoFlEx", fmt.Sprintf("json: does not implement StringValuable: %s", valFrom.Type()))
+		return diags
+	}
+
+	if vFrom.IsNull() || vFrom.IsUnknown() {
+		return diags
+	}
+
+	// JSON strings are copied as-is.
+	if tTo := vTo.Type(); tTo.Kind() == reflect.String || (tTo.Kind() == reflect.Ptr && tTo.Elem().Kind() == reflect.String) {
+		diags.Append(expander.string(ctx, vFrom, vTo)...)
+		return diags
+	}
+
+	v, d := vFrom.ToStringValue(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	//
+	// types.String --> map, slice, struct or interface{}.
+	//
+	to := reflect.New(vTo.Type())
+	if err := json.Unmarshal([]byte(v.ValueString()), to.Interface()); err != nil {
+		diags.AddError("AutoFlEx", fmt.Sprintf("decoding JSON: %s", err))
+		return diags
+	}
+
+	vTo.Set(to.Elem())
+
+	return diags
+}
+
+// union copies a Plugin Framework NestedObjectValue to a compatible AWS API union interface (or slice of union interfaces) value.
+func (expander autoExpander) union(ctx context.Context, valFrom, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	vFrom, ok := valFrom.Interface().(fwtypes.NestedObjectValue)
+	if !ok {
+		diags.AddError("AutoFlEx", fmt.Sprintf("union: does not implement NestedObjectValue: %s", valFrom.Type()))
+		return diags
+	}
+
+	if vFrom.IsNull() || vFrom.IsUnknown() {
+		return diags
+	}
+
+	switch tTo := vTo.Type(); tTo.Kind() {
+	case reflect.Interface:
+		diags.Append(expander.nestedObjectToUnion(ctx, vFrom, tTo, vTo)...)
+		return diags
+
+	case reflect.Slice:
+		if vFrom, ok := vFrom.(fwtypes.NestedObjectCollectionValue); ok && tTo.Elem().Kind() == reflect.Interface {
+			diags.Append(expander.nestedObjectToUnionSlice(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
+	}
+
+	diags.AddError("AutoFlEx", fmt.Sprintf("union: %s cannot be expanded to %s", vFrom.Type(ctx), vTo.Type()))
+	return diags
+}
+
+// nestedObjectToUnion copies a Plugin Framework NestedObjectValue to a compatible AWS API union interface value.
+func (expander autoExpander) nestedObjectToUnion(ctx context.Context, vFrom fwtypes.NestedObjectValue, tUnion reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Object as a pointer.
+	from, d := vFrom.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	member, d := expander.unionMember(ctx, reflect.ValueOf(from), tUnion)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if member.IsValid() {
+		vTo.Set(member)
+	}
+
+	return diags
+}
+
+// nestedObjectToUnionSlice copies a Plugin Framework NestedObjectCollectionValue to a compatible AWS API []interface value.
+func (expander autoExpander) nestedObjectToUnionSlice(ctx context.Context, vFrom fwtypes.NestedObjectCollectionValue, tSlice reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Objects as a slice.
+	from, d := vFrom.ToObjectSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	f := reflect.ValueOf(from)
+	n := f.Len()
+	t := reflect.MakeSlice(tSlice, 0, n)
+	for i := 0; i < n; i++ {
+		member, d := expander.unionMember(ctx, f.Index(i), tSlice.Elem())
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if member.IsValid() {
+			t = reflect.Append(t, member)
+		}
+	}
+
+	vTo.Set(t)
+
+	return diags
+}
+
+// unionMember returns the AWS API union member (e.g. *types.FilterMemberName) corresponding to the single
+// set field of the Plugin Framework union structure pointed to by `valFrom`.
+// An invalid value is returned if no field is set.
+func (expander autoExpander) unionMember(ctx context.Context, valFrom reflect.Value, tUnion reflect.Type) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var member reflect.Value
+
+	if valFrom.IsNil() {
+		return member, diags
+	}
+
+	valFrom = valFrom.Elem()
+	for i, typFrom := 0, valFrom.Type(); i < typFrom.NumField(); i++ {
+		field := typFrom.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		opts := autoFlexTags(field)
+		if opts.skip {
+			continue
+		}
+		if v, ok := valFrom.Field(i).Interface().(attr.Value); !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		if member.IsValid() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("union %s: more than one member set", tUnion))
+			return member, diags
+		}
+
+		name := field.Name
+		if opts.name != "" {
+			name = opts.name
+		}
+		tMember, ok := expander.options.unionMember(tUnion, name)
+		if !ok {
+			diags.AddError("AutoFlEx", fmt.Sprintf("union %s: member %s not registered", tUnion, name))
+			return member, diags
+		}
+
+		member = reflect.New(tMember.Elem())
+		vValue := member.Elem().FieldByName("Value")
+		if !vValue.IsValid() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("union %s: member %s has no Value field", tUnion, tMember))
+			return member, diags
+		}
+
+		diags.Append(autoFlexConvertField(ctx, valFrom.Field(i), vValue, opts, expander)...)
+		if diags.HasError() {
+			return member, diags
+		}
+	}
+
+	return member, diags
+}
+
 // nestedObjectToStruct copies a Plugin Framework NestedObjectValue to a compatible AWS API (*)struct value.
 func (expander autoExpander) nestedObjectToStruct(ctx context.Context, vFrom fwtypes.NestedObjectValue, tStruct reflect.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -12,6 +12,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -865,9 +866,186 @@ func TestExpandComplexNestedBlockWithStringEnum(t *testing.T) {
 	runAutoExpandTestCases(ctx, t, testCases)
 }
 
+func TestExpandTagOptions(t *testing.T) {
+	t.Parallel()
+
+	type tf01 struct {
+		Field1 types.String `tfsdk:"field1" autoflex:"name=Field2"`
+		Field2 types.String `tfsdk:"field2" autoflex:"-"`
+		Field3 types.Int64  `tfsdk:"field3" autoflex:"omitempty"`
+		Field4 types.Int64  `tfsdk:"field4"`
+	}
+	type aws01 struct {
+		Field2 string
+		Field3 *int64
+		Field4 *int64
+	}
+
+	type tf02 struct {
+		Field1 types.String `tfsdk:"field1" autoflex:"json"`
+		Field2 types.String `tfsdk:"field2" autoflex:"json"`
+		Field3 types.String `tfsdk:"field3" autoflex:"json"`
+	}
+	type aws02 struct {
+		Field1 map[string]any
+		Field2 any
+		Field3 *string
+	}
+
+	type tf03 struct {
+		Field1 types.String `tfsdk:"field1"`
+		Field2 types.String `tfsdk:"field2"`
+	}
+	type aws03 struct {
+		Field1 time.Time
+		Field2 *time.Time
+	}
+
+	ctx := context.Background()
+
+	testTimeStr := "2013-09-25T09:34:01Z"
+	testTimeTime := errs.Must(time.Parse(time.RFC3339, testTimeStr))
+
+	testCases := autoFlexTestCases{
+		{
+			TestName:   "name, skip and omitempty",
+			Source:     &tf01{Field1: types.StringValue("a"), Field2: types.StringValue("b"), Field3: types.Int64Value(0), Field4: types.Int64Value(0)},
+			Target:     &aws01{},
+			WantTarget: &aws01{Field2: "a", Field4: aws.Int64(0)},
+		},
+		{
+			TestName:   "json",
+			Source:     &tf02{Field1: types.StringValue(`{"a":1}`), Field2: types.StringValue(`["b"]`), Field3: types.StringValue(`{"c":true}`)},
+			Target:     &aws02{},
+			WantTarget: &aws02{Field1: map[string]any{"a": float64(1)}, Field2: []any{"b"}, Field3: aws.String(`{"c":true}`)},
+		},
+		{
+			TestName: "invalid json",
+			Source:   &tf02{Field1: types.StringValue(`{`)},
+			Target:   &aws02{},
+			WantErr:  true,
+		},
+		{
+			TestName:   "string timestamps",
+			Source:     &tf03{Field1: types.StringValue(testTimeStr), Field2: types.StringValue(testTimeStr)},
+			Target:     &aws03{},
+			WantTarget: &aws03{Field1: testTimeTime, Field2: &testTimeTime},
+		},
+		{
+			TestName: "invalid string timestamp",
+			Source:   &tf03{Field1: types.StringValue("yesterday")},
+			Target:   &aws03{},
+			WantErr:  true,
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	type tf01 struct {
+		Field1 fwtypes.ObjectValueOf[TestFlexUnionTF01]           `tfsdk:"field1" autoflex:"union"`
+		Field2 fwtypes.ListNestedObjectValueOf[TestFlexUnionTF01] `tfsdk:"field2"`
+	}
+	type aws01 struct {
+		Field1 TestFlexUnion
+		Field2 []TestFlexUnion
+	}
+
+	ctx := context.Background()
+	options := []AutoFlexOptionsFunc{WithUnionMembers(&TestFlexUnionMemberName{}, &TestFlexUnionMemberCount{})}
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "members",
+			Options:  options,
+			Source: &tf01{
+				Field1: fwtypes.NewObjectValueOfMust(ctx, &TestFlexUnionTF01{Name: types.StringValue("a"), Count: types.Int64Null()}),
+				Field2: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*TestFlexUnionTF01{
+					{Name: types.StringNull(), Count: types.Int64Value(1)},
+					{Name: types.StringValue("b"), Count: types.Int64Null()},
+				}),
+			},
+			Target: &aws01{},
+			WantTarget: &aws01{
+				Field1: &TestFlexUnionMemberName{Value: "a"},
+				Field2: []TestFlexUnion{&TestFlexUnionMemberCount{Value: 1}, &TestFlexUnionMemberName{Value: "b"}},
+			},
+		},
+		{
+			TestName:   "null",
+			Options:    options,
+			Source:     &tf01{Field1: fwtypes.NewObjectValueOfNull[TestFlexUnionTF01](ctx), Field2: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionTF01](ctx)},
+			Target:     &aws01{},
+			WantTarget: &aws01{},
+		},
+		{
+			TestName: "more than one member",
+			Options:  options,
+			Source:   &tf01{Field1: fwtypes.NewObjectValueOfMust(ctx, &TestFlexUnionTF01{Name: types.StringValue("a"), Count: types.Int64Value(1)})},
+			Target:   &aws01{},
+			WantErr:  true,
+		},
+		{
+			TestName: "members not registered",
+			Source:   &tf01{Field1: fwtypes.NewObjectValueOfMust(ctx, &TestFlexUnionTF01{Name: types.StringValue("a"), Count: types.Int64Null()})},
+			Target:   &aws01{},
+			WantErr:  true,
+		},
+		{
+			TestName:   "untagged members not registered",
+			Source:     &tf01{Field2: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*TestFlexUnionTF01{{Name: types.StringValue("a"), Count: types.Int64Null()}})},
+			Target:     &aws01{},
+			WantTarget: &aws01{},
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
+func TestExpandConverter(t *testing.T) {
+	t.Parallel()
+
+	type tf01 struct {
+		Field1 types.String `tfsdk:"field1"`
+		Field2 types.String `tfsdk:"field2"`
+	}
+	type aws01 struct {
+		Field1 TestFlexDocument
+		Field2 string
+	}
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "custom converter",
+			Options: []AutoFlexOptionsFunc{WithConverter(func(ctx context.Context, v types.String) (TestFlexDocument, diag.Diagnostics) {
+				return &TestFlexDocumentValue{Content: v.ValueString()}, nil
+			})},
+			Source:     &tf01{Field1: types.StringValue("a"), Field2: types.StringValue("b")},
+			Target:     &aws01{},
+			WantTarget: &aws01{Field1: &TestFlexDocumentValue{Content: "a"}, Field2: "b"},
+		},
+		{
+			TestName: "custom converter error",
+			Options: []AutoFlexOptionsFunc{WithConverter(func(ctx context.Context, v types.String) (TestFlexDocument, diag.Diagnostics) {
+				var diags diag.Diagnostics
+				diags.AddError("test", "failed")
+				return nil, diags
+			})},
+			Source:  &tf01{Field1: types.StringValue("a")},
+			Target:  &aws01{},
+			WantErr: true,
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
 type autoFlexTestCase struct {
 	Context    context.Context //nolint:containedctx // testing context use
 	TestName   string
+	Options    []AutoFlexOptionsFunc
 	Source     any
 	Target     any
 	WantErr    bool
@@ -889,7 +1067,7 @@ func runAutoExpandTestCases(ctx context.Context, t *testing.T, testCases autoFle
 				testCtx = testCase.Context
 			}
 
-			err := Expand(testCtx, testCase.Source, testCase.Target)
+			err := Expand(testCtx, testCase.Source, testCase.Target, testCase.Options...)
 			gotErr := err != nil
 
 			if gotErr != testCase.WantErr {
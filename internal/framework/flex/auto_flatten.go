@@ -5,6 +5,7 @@ package flex
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -29,7 +30,9 @@ import (
 // suitable target data type) are copied.
 func Flatten(ctx context.Context, apiObject, tfObject any, optFns ...AutoFlexOptionsFunc) diag.Diagnostics {
 	var diags diag.Diagnostics
-	flattener := &autoFlattener{}
+	flattener := &autoFlattener{
+		options: newAutoFlexOptions(),
+	}
 
 	for _, optFn := range optFns {
 		optFn(flattener)
@@ -44,7 +47,13 @@ func Flatten(ctx context.Context, apiObject, tfObject any, optFns ...AutoFlexOpt
 	return diags
 }
 
-type autoFlattener struct{}
+type autoFlattener struct {
+	options *autoFlexOptions
+}
+
+func (flattener autoFlattener) getOptions() *autoFlexOptions {
+	return flattener.options
+}
 
 // convert converts a single AWS API value to its Plugin Framework equivalent.
 func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics {
@@ -91,7 +100,15 @@ func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.V
 		return diags
 
 	case reflect.Interface:
-		// Smithy union type handling not yet implemented. Silently skip.
+		if tTo, ok := tTo.(fwtypes.NestedObjectType); ok && flattener.options.hasUnionMembers(vFrom.Type()) {
+			//
+			// union interface -> types.List(OfObject) or types.Object.
+			//
+			diags.Append(flattener.unionToNestedObject(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
+
+		// Union members not registered. Silently skip.
 		return diags
 	}
 
@@ -290,6 +307,14 @@ func (flattener autoFlattener) ptr(ctx context.Context, vFrom reflect.Value, tTo
 		return diags
 
 	case reflect.Struct:
+		if tTo, ok := tTo.(basetypes.StringTypable); ok && vFrom.Type().Elem() == reflect.TypeOf(time.Time{}) && !isTimeType(tTo) {
+			//
+			// *time.Time -> types.String.
+			//
+			diags.Append(flattener.timeString(ctx, vElem, isNilFrom, tTo, vTo)...)
+			return diags
+		}
+
 		diags.Append(flattener.struct_(ctx, vElem, isNilFrom, tTo, vTo)...)
 		return diags
 	}
@@ -326,6 +351,32 @@ func (flattener autoFlattener) struct_(ctx context.Context, vFrom reflect.Value,
 		return diags
 	}
 
+	if tTo, ok := tTo.(basetypes.StringTypable); ok && !isNilFrom && vFrom.Type() == reflect.TypeOf(time.Time{}) {
+		diags.Append(flattener.timeString(ctx, vFrom, false, tTo, vTo)...)
+		return diags
+	}
+
+	return diags
+}
+
+// timeString copies an AWS API time value to a compatible Plugin Framework String value, formatted per RFC 3339.
+func (flattener autoFlattener) timeString(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo basetypes.StringTypable, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	stringValue := types.StringNull()
+	if !isNullFrom {
+		//
+		// time.Time -> types.String.
+		//
+		stringValue = types.StringValue(vFrom.Interface().(time.Time).Format(time.RFC3339))
+	}
+	v, d := tTo.ValueFromString(ctx, stringValue)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(v))
 	return diags
 }
 
@@ -500,7 +551,15 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 		}
 
 	case reflect.Interface:
-		// Smithy union type handling not yet implemented. Silently skip.
+		if tTo, ok := tTo.(fwtypes.NestedObjectCollectionType); ok && flattener.options.hasUnionMembers(tSliceElem) {
+			//
+			// []interface -> types.List(OfObject).
+			//
+			diags.Append(flattener.sliceOfUnionNestedObjectCollection(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
+
+		// Union members not registered. Silently skip.
 		return diags
 	}
 
@@ -703,6 +762,226 @@ func (flattener autoFlattener) structMapToObjectList(ctx context.Context, vFrom
 	return diags
 }
 
+// empty sets the Plugin Framework value to null for an empty AWS API value.
+func (flattener autoFlattener) empty(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Flatten a nil value of the source type.
+	typFrom := vFrom.Type()
+	switch typFrom.Kind() {
+	case reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+	default:
+		typFrom = reflect.PtrTo(typFrom)
+	}
+
+	diags.Append(flattener.convert(ctx, reflect.Zero(typFrom), vTo)...)
+	return diags
+}
+
+// json copies an AWS API value to a compatible Plugin Framework String(ish) value containing a JSON document.
+func (flattener autoFlattener) json(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	valTo, ok := vTo.Interface().(attr.Value)
+	if !ok {
+		diags.AddError("AutoFlEx", fmt.Sprintf("does not implement attr.Value: %s", vTo.Kind()))
+		return diags
+	}
+
+	tTo, ok := valTo.Type(ctx).(basetypes.StringTypable)
+	if !ok {
+		diags.AddError("AutoFlEx", fmt.Sprintf("json: %s is not a String type", valTo.Type(ctx)))
+		return diags
+	}
+
+	// JSON strings are copied as-is.
+	switch typFrom := vFrom.Type(); {
+	case typFrom.Kind() == reflect.String, typFrom.Kind() == reflect.Ptr && typFrom.Elem().Kind() == reflect.String:
+		diags.Append(flattener.convert(ctx, vFrom, vTo)...)
+		return diags
+	}
+
+	//
+	// map, slice, struct or interface{} -> types.String.
+	//
+	stringValue := types.StringNull()
+	switch vFrom.Kind() {
+	case reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		if vFrom.IsNil() {
+			break
+		}
+		fallthrough
+	default:
+		b, err := json.Marshal(vFrom.Interface())
+		if err != nil {
+			diags.AddError("AutoFlEx", fmt.Sprintf("encoding JSON: %s", err))
+			return diags
+		}
+		stringValue = types.StringValue(string(b))
+	}
+
+	v, d := tTo.ValueFromString(ctx, stringValue)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(v))
+	return diags
+}
+
+// union copies an AWS API union interface (or slice of union interfaces) value to a compatible Plugin Framework NestedObjectValue value.
+func (flattener autoFlattener) union(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	valTo, ok := vTo.Interface().(attr.Value)
+	if !ok {
+		diags.AddError("AutoFlEx", fmt.Sprintf("does not implement attr.Value: %s", vTo.Kind()))
+		return diags
+	}
+
+	switch tTo := valTo.Type(ctx); vFrom.Kind() {
+	case reflect.Interface:
+		if tTo, ok := tTo.(fwtypes.NestedObjectType); ok {
+			diags.Append(flattener.unionToNestedObject(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
+
+	case reflect.Slice:
+		if tTo, ok := tTo.(fwtypes.NestedObjectCollectionType); ok && vFrom.Type().Elem().Kind() == reflect.Interface {
+			diags.Append(flattener.sliceOfUnionNestedObjectCollection(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
+	}
+
+	diags.AddError("AutoFlEx", fmt.Sprintf("union: %s cannot be flattened to %s", vFrom.Type(), valTo.Type(ctx)))
+	return diags
+}
+
+// unionToNestedObject copies an AWS API union interface value to a compatible Plugin Framework NestedObjectValue value.
+func (flattener autoFlattener) unionToNestedObject(ctx context.Context, vFrom reflect.Value, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.IsNil() {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	to, d := flattener.unionMember(ctx, vFrom, tTo)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Set the target structure as a mapped Object.
+	val, d := tTo.ValueFromObjectPtr(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
+// sliceOfUnionNestedObjectCollection copies an AWS API []interface value to a compatible Plugin Framework NestedObjectCollectionValue value.
+func (flattener autoFlattener) sliceOfUnionNestedObjectCollection(ctx context.Context, vFrom reflect.Value, tTo fwtypes.NestedObjectCollectionType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.IsNil() {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	// Create a new target slice and flatten each element.
+	n := vFrom.Len()
+	to, d := tTo.NewObjectSlice(ctx, n, n)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	t := reflect.ValueOf(to)
+	for i := 0; i < n; i++ {
+		target, d := flattener.unionMember(ctx, vFrom.Index(i), tTo)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		t.Index(i).Set(reflect.ValueOf(target))
+	}
+
+	// Set the target structure as a nested Object.
+	val, d := tTo.ValueFromObjectSlice(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
+// unionMember returns a new Plugin Framework union structure whose field corresponding to the
+// AWS API union member (e.g. *types.FilterMemberName) is set to the member's value.
+func (flattener autoFlattener) unionMember(ctx context.Context, vFrom reflect.Value, tTo fwtypes.NestedObjectType) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	to, d := tTo.NewObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if vFrom.IsNil() {
+		return to, diags
+	}
+
+	vMember := vFrom.Elem()
+	name := unionMemberName(vMember.Type())
+	if vMember.Kind() == reflect.Ptr {
+		vMember = vMember.Elem()
+	}
+	vValue := vMember.FieldByName("Value")
+	if name == "" || !vValue.IsValid() {
+		diags.AddError("AutoFlEx", fmt.Sprintf("union %s: unsupported member %s", vFrom.Type(), vFrom.Elem().Type()))
+		return nil, diags
+	}
+
+	valTo := reflect.ValueOf(to).Elem()
+	toFieldVal, opts := findField(ctx, name, autoFlexTagOptions{}, valTo, vMember)
+	if !toFieldVal.IsValid() || !toFieldVal.CanSet() || opts.skip {
+		// Corresponding field not found in to.
+		return to, diags
+	}
+
+	diags.Append(autoFlexConvertField(ctx, vValue, toFieldVal, opts, flattener)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return to, diags
+}
+
+// isTimeType returns whether the specified type is the timetypes.RFC3339 type, which is flattened from time values natively.
+func isTimeType(t attr.Type) bool {
+	_, ok := t.(timetypes.RFC3339Type)
+	return ok
+}
+
 // structToNestedObject copies an AWS API struct value to a compatible Plugin Framework NestedObjectValue value.
 func (flattener autoFlattener) structToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
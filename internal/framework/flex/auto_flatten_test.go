@@ -14,6 +14,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -891,6 +892,178 @@ func TestFlattenComplexSingleNestedBlock(t *testing.T) {
 	runAutoFlattenTestCases(ctx, t, testCases)
 }
 
+func TestFlattenTagOptions(t *testing.T) {
+	t.Parallel()
+
+	type aws01 struct {
+		Field1 string
+		Field2 string
+		Field3 *int64
+		Field4 *int64
+		Field5 string
+	}
+	type tf01 struct {
+		Field1 types.String `tfsdk:"field1" autoflex:"name=Field2"`
+		Field2 types.String `tfsdk:"field2"`
+		Field3 types.Int64  `tfsdk:"field3" autoflex:"omitempty"`
+		Field4 types.Int64  `tfsdk:"field4"`
+		Field5 types.String `tfsdk:"field5" autoflex:"-"`
+	}
+
+	type aws02 struct {
+		Field1 map[string]any
+		Field2 any
+		Field3 *string
+		Field4 []string
+	}
+	type tf02 struct {
+		Field1 types.String `tfsdk:"field1" autoflex:"json"`
+		Field2 types.String `tfsdk:"field2" autoflex:"json"`
+		Field3 types.String `tfsdk:"field3" autoflex:"json"`
+		Field4 types.String `tfsdk:"field4" autoflex:"json"`
+	}
+
+	type aws03 struct {
+		Field1 time.Time
+		Field2 *time.Time
+		Field3 *time.Time
+	}
+	type tf03 struct {
+		Field1 types.String `tfsdk:"field1"`
+		Field2 types.String `tfsdk:"field2"`
+		Field3 types.String `tfsdk:"field3"`
+	}
+
+	ctx := context.Background()
+
+	testTimeStr := "2013-09-25T09:34:01Z"
+	testTimeTime := errs.Must(time.Parse(time.RFC3339, testTimeStr))
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "name, skip and omitempty",
+			Source:   &aws01{Field1: "a", Field2: "b", Field3: aws.Int64(0), Field4: aws.Int64(0), Field5: "c"},
+			Target:   &tf01{},
+			WantTarget: &tf01{
+				Field1: types.StringValue("b"),
+				Field3: types.Int64Null(),
+				Field4: types.Int64Value(0),
+			},
+		},
+		{
+			TestName: "json",
+			Source:   &aws02{Field1: map[string]any{"a": 1}, Field2: []any{"b"}, Field3: aws.String(`{"c":true}`)},
+			Target:   &tf02{},
+			WantTarget: &tf02{
+				Field1: types.StringValue(`{"a":1}`),
+				Field2: types.StringValue(`["b"]`),
+				Field3: types.StringValue(`{"c":true}`),
+				Field4: types.StringNull(),
+			},
+		},
+		{
+			TestName:   "string timestamps",
+			Source:     &aws03{Field1: testTimeTime, Field2: &testTimeTime},
+			Target:     &tf03{},
+			WantTarget: &tf03{Field1: types.StringValue(testTimeStr), Field2: types.StringValue(testTimeStr), Field3: types.StringNull()},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	type aws01 struct {
+		Field1 TestFlexUnion
+		Field2 []TestFlexUnion
+	}
+	type tf01 struct {
+		Field1 fwtypes.ObjectValueOf[TestFlexUnionTF01]           `tfsdk:"field1" autoflex:"union"`
+		Field2 fwtypes.ListNestedObjectValueOf[TestFlexUnionTF01] `tfsdk:"field2"`
+	}
+
+	ctx := context.Background()
+	options := []AutoFlexOptionsFunc{WithUnionMembers(&TestFlexUnionMemberName{}, &TestFlexUnionMemberCount{})}
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "members",
+			Options:  options,
+			Source: &aws01{
+				Field1: &TestFlexUnionMemberName{Value: "a"},
+				Field2: []TestFlexUnion{&TestFlexUnionMemberCount{Value: 1}},
+			},
+			Target: &tf01{},
+			WantTarget: &tf01{
+				Field1: fwtypes.NewObjectValueOfMust(ctx, &TestFlexUnionTF01{Name: types.StringValue("a")}),
+				Field2: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*TestFlexUnionTF01{{Count: types.Int64Value(1)}}),
+			},
+		},
+		{
+			TestName: "null",
+			Options:  options,
+			Source:   &aws01{},
+			Target:   &tf01{},
+			WantTarget: &tf01{
+				Field1: fwtypes.NewObjectValueOfNull[TestFlexUnionTF01](ctx),
+				Field2: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionTF01](ctx),
+			},
+		},
+		{
+			TestName: "tagged members not registered",
+			Source:   &aws01{Field1: &TestFlexUnionMemberName{Value: "a"}},
+			Target:   &tf01{Field2: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionTF01](ctx)},
+			WantTarget: &tf01{
+				Field1: fwtypes.NewObjectValueOfMust(ctx, &TestFlexUnionTF01{Name: types.StringValue("a")}),
+				Field2: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionTF01](ctx),
+			},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
+func TestFlattenConverter(t *testing.T) {
+	t.Parallel()
+
+	type aws01 struct {
+		Field1 TestFlexDocument
+	}
+	type tf01 struct {
+		Field1 types.String `tfsdk:"field1"`
+	}
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "custom converter",
+			Options: []AutoFlexOptionsFunc{WithConverter(func(ctx context.Context, v TestFlexDocument) (types.String, diag.Diagnostics) {
+				if v, ok := v.(*TestFlexDocumentValue); ok {
+					return types.StringValue(v.Content), nil
+				}
+				return types.StringNull(), nil
+			})},
+			Source:     &aws01{Field1: &TestFlexDocumentValue{Content: "a"}},
+			Target:     &tf01{},
+			WantTarget: &tf01{Field1: types.StringValue("a")},
+		},
+		{
+			TestName: "custom converter nil",
+			Options: []AutoFlexOptionsFunc{WithConverter(func(ctx context.Context, v TestFlexDocument) (types.String, diag.Diagnostics) {
+				if v == nil {
+					return types.StringNull(), nil
+				}
+				return types.StringValue("unexpected"), nil
+			})},
+			Source:     &aws01{},
+			Target:     &tf01{},
+			WantTarget: &tf01{Field1: types.StringNull()},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
 func runAutoFlattenTestCases(ctx context.Context, t *testing.T, testCases autoFlexTestCases) {
 	t.Helper()
 
@@ -904,7 +1077,7 @@ func runAutoFlattenTestCases(ctx context.Context, t *testing.T, testCases autoFl
 				testCtx = testCase.Context
 			}
 
-			err := Flatten(testCtx, testCase.Source, testCase.Target)
+			err := Flatten(testCtx, testCase.Source, testCase.Target, testCase.Options...)
 			gotErr := err != nil
 
 			if gotErr != testCase.WantErr {
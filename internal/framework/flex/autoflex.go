@@ -10,7 +10,9 @@ import (
 	"strings"
 
 	pluralize "github.com/gertd/go-pluralize"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 type ResourcePrefixCtxKey string
@@ -27,11 +29,181 @@ const (
 // autoFlexer is the interface implemented by an auto-flattener or expander.
 type autoFlexer interface {
 	convert(context.Context, reflect.Value, reflect.Value) diag.Diagnostics
+	// empty handles a source value that is omitted because of the `omitempty` tag option.
+	empty(context.Context, reflect.Value, reflect.Value) diag.Diagnostics
+	// json handles a field with the `json` tag option.
+	json(context.Context, reflect.Value, reflect.Value) diag.Diagnostics
+	// union handles a field with the `union` tag option.
+	union(context.Context, reflect.Value, reflect.Value) diag.Diagnostics
+	getOptions() *autoFlexOptions
 }
 
 // AutoFlexOptionsFunc is a type alias for an autoFlexer functional option.
 type AutoFlexOptionsFunc func(autoFlexer)
 
+// autoFlexOptions holds the options configured on an autoFlexer.
+type autoFlexOptions struct {
+	converters   map[autoFlexConverterKey]autoFlexConverterFunc
+	unionMembers []reflect.Type
+}
+
+type autoFlexConverterKey struct {
+	from, to reflect.Type
+}
+
+type autoFlexConverterFunc func(context.Context, reflect.Value) (reflect.Value, diag.Diagnostics)
+
+func newAutoFlexOptions() *autoFlexOptions {
+	return &autoFlexOptions{
+		converters: make(map[autoFlexConverterKey]autoFlexConverterFunc),
+	}
+}
+
+// WithConverter registers a custom conversion from values of type F to values of type T.
+// A custom conversion takes precedence over the built-in conversions wherever a source value of
+// type F is converted to a target value of type T, e.g. types.String to document.Interface on Expand
+// and document.Interface to types.String on Flatten.
+func WithConverter[F, T any](f func(context.Context, F) (T, diag.Diagnostics)) AutoFlexOptionsFunc {
+	return func(flexer autoFlexer) {
+		key := autoFlexConverterKey{
+			from: reflect.TypeOf((*F)(nil)).Elem(),
+			to:   reflect.TypeOf((*T)(nil)).Elem(),
+		}
+		flexer.getOptions().converters[key] = func(ctx context.Context, vFrom reflect.Value) (reflect.Value, diag.Diagnostics) {
+			from, _ := vFrom.Interface().(F) // A nil interface value yields F's zero value.
+			to, diags := f(ctx, from)
+
+			// Go via a pointer so that nil interface values are valid.
+			return reflect.ValueOf(&to).Elem(), diags
+		}
+	}
+}
+
+// WithUnionMembers registers the member types of AWS SDK for Go v2 union interfaces, e.g. &types.FilterMemberName{}.
+// Go reflection can't enumerate the types implementing an interface, so union members must be registered for Expand.
+// A union is represented in Terraform as a nested object with one attribute per member, named for the member type's
+// suffix (e.g. `Name` for `FilterMemberName`), of which at most one is set.
+func WithUnionMembers(members ...any) AutoFlexOptionsFunc {
+	return func(flexer autoFlexer) {
+		options := flexer.getOptions()
+		for _, member := range members {
+			typ := reflect.TypeOf(member)
+			if typ.Kind() != reflect.Ptr {
+				typ = reflect.PtrTo(typ)
+			}
+			options.unionMembers = append(options.unionMembers, typ)
+		}
+	}
+}
+
+// converter returns any custom conversion registered for the specified source and target types.
+func (o *autoFlexOptions) converter(from, to reflect.Type) (autoFlexConverterFunc, bool) {
+	f, ok := o.converters[autoFlexConverterKey{from: from, to: to}]
+	return f, ok
+}
+
+// hasUnionMembers returns whether any union members implementing the specified interface are registered.
+func (o *autoFlexOptions) hasUnionMembers(typ reflect.Type) bool {
+	for _, member := range o.unionMembers {
+		if typ.Kind() == reflect.Interface && member.Implements(typ) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// unionMember returns the registered member type (e.g. *types.FilterMemberName) implementing the specified union interface
+// whose name has the specified suffix (e.g. Name).
+func (o *autoFlexOptions) unionMember(typ reflect.Type, name string) (reflect.Type, bool) {
+	for _, member := range o.unionMembers {
+		if member.Implements(typ) && strings.EqualFold(unionMemberName(member), name) {
+			return member, true
+		}
+	}
+
+	return nil, false
+}
+
+// unionMemberName returns the name of a union member type, e.g. `Name` for `*types.FilterMemberName`.
+func unionMemberName(typ reflect.Type) string {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	name := typ.Name()
+	if i := strings.LastIndex(name, "Member"); i > 0 {
+		return name[i+len("Member"):]
+	}
+
+	return ""
+}
+
+const (
+	autoFlexTagKey = "autoflex"
+)
+
+// autoFlexTagOptions are the options specified in a field's `autoflex` struct tag, e.g.
+//
+//	Document types.String `tfsdk:"document" autoflex:"name=Content,json,omitempty"`
+//
+// Supported options are
+//
+//	name=X    - the field corresponds to field X in the other structure
+//	json      - the field is a JSON string in Terraform and a Go value (map, slice, struct or any) in the AWS API
+//	union     - the field is an AWS SDK for Go v2 union interface (see WithUnionMembers)
+//	omitempty - zero values are treated as null
+//
+// A tag value of `-` excludes the field from conversion.
+type autoFlexTagOptions struct {
+	name      string
+	json      bool
+	omitempty bool
+	union     bool
+	skip      bool
+}
+
+// autoFlexTags returns the parsed `autoflex` struct tag options for the specified field.
+func autoFlexTags(field reflect.StructField) autoFlexTagOptions {
+	var opts autoFlexTagOptions
+
+	tag, ok := field.Tag.Lookup(autoFlexTagKey)
+	if !ok {
+		return opts
+	}
+
+	if tag == "-" {
+		opts.skip = true
+		return opts
+	}
+
+	for _, v := range strings.Split(tag, ",") {
+		switch v = strings.TrimSpace(v); {
+		case strings.HasPrefix(v, "name="):
+			opts.name = strings.TrimPrefix(v, "name=")
+		case v == "json":
+			opts.json = true
+		case v == "omitempty":
+			opts.omitempty = true
+		case v == "union":
+			opts.union = true
+		}
+	}
+
+	return opts
+}
+
+// merge returns the options specified on either a source or target field.
+// Field names are not merged as they refer to the other structure.
+func (opts autoFlexTagOptions) merge(other autoFlexTagOptions) autoFlexTagOptions {
+	return autoFlexTagOptions{
+		json:      opts.json || other.json,
+		omitempty: opts.omitempty || other.omitempty,
+		union:     opts.union || other.union,
+		skip:      opts.skip || other.skip,
+	}
+}
+
 // autoFlexConvert converts `from` to `to` using the specified auto-flexer.
 func autoFlexConvert(ctx context.Context, from, to any, flexer autoFlexer) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -51,10 +223,91 @@ func autoFlexConvert(ctx context.Context, from, to any, flexer autoFlexer) diag.
 	}
 
 	// Anything else.
-	diags.Append(flexer.convert(ctx, valFrom, valTo)...)
+	diags.Append(autoFlexConvertValue(ctx, valFrom, valTo, flexer)...)
 	return diags
 }
 
+// autoFlexConvertValue converts a single value using any custom conversion registered for the source and target types,
+// falling back to `flexer`'s built-in conversions.
+func autoFlexConvertValue(ctx context.Context, vFrom, vTo reflect.Value, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.IsValid() && vTo.IsValid() {
+		if f, ok := flexer.getOptions().converter(vFrom.Type(), vTo.Type()); ok {
+			v, d := f(ctx, vFrom)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			vTo.Set(v)
+			return diags
+		}
+	}
+
+	diags.Append(flexer.convert(ctx, vFrom, vTo)...)
+	return diags
+}
+
+// autoFlexConvertField converts a single struct field value, applying any `autoflex` tag options.
+func autoFlexConvertField(ctx context.Context, vFrom, vTo reflect.Value, opts autoFlexTagOptions, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if opts.omitempty && isEmptyValue(ctx, vFrom) {
+		diags.Append(flexer.empty(ctx, vFrom, vTo)...)
+		return diags
+	}
+
+	switch {
+	case opts.json:
+		diags.Append(flexer.json(ctx, vFrom, vTo)...)
+	case opts.union:
+		diags.Append(flexer.union(ctx, vFrom, vTo)...)
+	default:
+		diags.Append(autoFlexConvertValue(ctx, vFrom, vTo, flexer)...)
+	}
+
+	return diags
+}
+
+// isEmptyValue returns whether the specified Plugin Framework or AWS API value is null or its type's zero value.
+func isEmptyValue(ctx context.Context, v reflect.Value) bool {
+	switch v := v.Interface().(type) {
+	case attr.Value:
+		if v.IsNull() || v.IsUnknown() {
+			return true
+		}
+
+		switch v := v.(type) {
+		case basetypes.BoolValuable:
+			b, _ := v.ToBoolValue(ctx)
+			return !b.ValueBool()
+		case basetypes.Float64Valuable:
+			f, _ := v.ToFloat64Value(ctx)
+			return f.ValueFloat64() == 0
+		case basetypes.Int64Valuable:
+			i, _ := v.ToInt64Value(ctx)
+			return i.ValueInt64() == 0
+		case basetypes.StringValuable:
+			s, _ := v.ToStringValue(ctx)
+			return s.ValueString() == ""
+		case interface{ Elements() []attr.Value }:
+			return len(v.Elements()) == 0
+		}
+
+		return false
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		return v.IsNil() || v.Elem().IsZero()
+	case reflect.Map, reflect.Slice:
+		return v.Len() == 0
+	}
+
+	return v.IsZero()
+}
+
 // autoFlexValues returns the underlying `reflect.Value`s of `from` and `to`.
 func autoFlexValues(_ context.Context, from, to any) (reflect.Value, reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -99,15 +352,23 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 			continue
 		}
 
-		toFieldVal := findFieldFuzzy(ctx, fieldName, valTo, valFrom)
+		opts := autoFlexTags(field)
+		if opts.skip {
+			continue
+		}
+
+		toFieldVal, toOpts := findField(ctx, fieldName, opts, valTo, valFrom)
 		if !toFieldVal.IsValid() {
 			continue // Corresponding field not found in to.
 		}
 		if !toFieldVal.CanSet() {
 			continue // Corresponding field value can't be changed.
 		}
+		if opts = opts.merge(toOpts); opts.skip {
+			continue
+		}
 
-		diags.Append(flexer.convert(ctx, valFrom.Field(i), toFieldVal)...)
+		diags.Append(autoFlexConvertField(ctx, valFrom.Field(i), toFieldVal, opts, flexer)...)
 		if diags.HasError() {
 			diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", fieldName))
 			return diags
@@ -117,9 +378,49 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 	return diags
 }
 
+// findField returns the field in `to` corresponding to field `fieldNameFrom` in `from`, together with its tag options.
+// Names specified in `autoflex` struct tags take precedence over fuzzy matching.
+func findField(ctx context.Context, fieldNameFrom string, opts autoFlexTagOptions, valTo, valFrom reflect.Value) (reflect.Value, autoFlexTagOptions) {
+	typTo := valTo.Type()
+
+	// The source field names its target.
+	if opts.name != "" {
+		if field, ok := typTo.FieldByName(opts.name); ok {
+			return valTo.FieldByIndex(field.Index), autoFlexTags(field)
+		}
+
+		return reflect.Value{}, autoFlexTagOptions{}
+	}
+
+	// A target field names the source field.
+	for i := 0; i < typTo.NumField(); i++ {
+		field := typTo.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		if toOpts := autoFlexTags(field); toOpts.name == fieldNameFrom {
+			return valTo.Field(i), toOpts
+		}
+	}
+
+	v := findFieldFuzzy(ctx, fieldNameFrom, valTo, valFrom)
+	if !v.IsValid() || !v.CanAddr() {
+		return v, autoFlexTagOptions{}
+	}
+
+	// Pointers to distinct fields differ in address or type.
+	for i := 0; i < typTo.NumField(); i++ {
+		if field := typTo.Field(i); field.PkgPath == "" && valTo.Field(i).Addr().Interface() == v.Addr().Interface() {
+			return v, autoFlexTags(field)
+		}
+	}
+
+	return v, autoFlexTagOptions{}
+}
+
 func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom reflect.Value) reflect.Value {
 	// first precedence is exact match (case sensitive)
-	if v := valTo.FieldByName(fieldNameFrom); v.IsValid() {
+	if v := fieldByName(valTo, fieldNameFrom); v.IsValid() {
 		return v
 	}
 
@@ -139,7 +440,7 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 		if fieldNameTo == "Tags" {
 			continue // Resource tags are handled separately.
 		}
-		if v := valTo.FieldByName(fieldNameTo); v.IsValid() && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, valFrom) {
+		if v := fieldByName(valTo, fieldNameTo); v.IsValid() && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, valFrom) {
 			// probably could assume validity here since reflect gave the field name
 			return v
 		}
@@ -147,13 +448,13 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 
 	// third precedence is singular/plural
 	if plural.IsSingular(fieldNameFrom) && !fieldExistsInStruct(plural.Plural(fieldNameFrom), valFrom) {
-		if v := valTo.FieldByName(plural.Plural(fieldNameFrom)); v.IsValid() {
+		if v := fieldByName(valTo, plural.Plural(fieldNameFrom)); v.IsValid() {
 			return v
 		}
 	}
 
 	if plural.IsPlural(fieldNameFrom) && !fieldExistsInStruct(plural.Singular(fieldNameFrom), valFrom) {
-		if v := valTo.FieldByName(plural.Singular(fieldNameFrom)); v.IsValid() {
+		if v := fieldByName(valTo, plural.Singular(fieldNameFrom)); v.IsValid() {
 			return v
 		}
 	}
@@ -172,7 +473,17 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 	}
 
 	// no finds, fuzzy or otherwise - return zero value
-	return valTo.FieldByName(fieldNameFrom)
+	return fieldByName(valTo, fieldNameFrom)
+}
+
+// fieldByName returns the field of struct `v` with the specified name.
+// Fields renamed by their `autoflex` struct tag only match by that name.
+func fieldByName(v reflect.Value, name string) reflect.Value {
+	if field, ok := v.Type().FieldByName(name); ok && autoFlexTags(field).name == "" {
+		return v.FieldByIndex(field.Index)
+	}
+
+	return reflect.Value{}
 }
 
 func fieldExistsInStruct(field string, str reflect.Value) bool {
@@ -311,3 +311,36 @@ type TestFlexMapBlockKeyTF05 struct {
 	Attr1       types.String                 `tfsdk:"attr1"`
 	Attr2       types.String                 `tfsdk:"attr2"`
 }
+
+// TestFlexUnion is an AWS SDK for Go v2-style union interface.
+type TestFlexUnion interface {
+	isTestFlexUnion()
+}
+
+type TestFlexUnionMemberName struct {
+	Value string
+}
+
+func (*TestFlexUnionMemberName) isTestFlexUnion() {}
+
+type TestFlexUnionMemberCount struct {
+	Value int64
+}
+
+func (*TestFlexUnionMemberCount) isTestFlexUnion() {}
+
+type TestFlexUnionTF01 struct {
+	Name  types.String `tfsdk:"name"`
+	Count types.Int64  `tfsdk:"count"`
+}
+
+// TestFlexDocument is a document type requiring a custom converter.
+type TestFlexDocument interface {
+	isTestFlexDocument()
+}
+
+type TestFlexDocumentValue struct {
+	Content string
+}
+
+func (*TestFlexDocumentValue) isTestFlexDocument() {}
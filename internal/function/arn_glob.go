@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+var _ function.Function = arnGlobFunction{}
+
+func NewARNGlobFunction() function.Function {
+	return &arnGlobFunction{}
+}
+
+type arnGlobFunction struct{}
+
+func (f arnGlobFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_glob"
+}
+
+func (f arnGlobFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_glob Function",
+		MarkdownDescription: "Returns the ARNs in a list that match an IAM-style ARN pattern, in their original order. " +
+			"Matching uses the same semantics as the `arn_match` function.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "ARN pattern, optionally containing `*` and `?` wildcards",
+			},
+			function.ListParameter{
+				Name:                "arns",
+				ElementType:         types.StringType,
+				MarkdownDescription: "ARNs (Amazon Resource Names) to test",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f arnGlobFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern string
+	var arns []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &arns))
+	if resp.Error != nil {
+		return
+	}
+
+	result := make([]string, 0)
+	for _, arn := range arns {
+		match, err := iampolicy.ARNLike(arn, pattern)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+			return
+		}
+
+		if match {
+			result = append(result, arn)
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestARNGlobFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNGlobFunctionConfig("arn:aws:iam::*", `["arn:aws:iam::444455556666:role/example", "arn:aws:s3:::example", "arn:aws:iam::111122223333:user/example"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "arn:aws:iam::444455556666:role/example,arn:aws:iam::111122223333:user/example"),
+				),
+			},
+		},
+	})
+}
+
+func TestARNGlobFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNGlobFunctionConfig("arn:aws:iam::*", `["invalid"]`),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*prefix`),
+			},
+		},
+	})
+}
+
+func testARNGlobFunctionConfig(pattern, arns string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::arn_glob(%[1]q, %[2]s))
+}
+`, pattern, arns)
+}
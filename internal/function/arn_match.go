@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+var _ function.Function = arnMatchFunction{}
+
+func NewARNMatchFunction() function.Function {
+	return &arnMatchFunction{}
+}
+
+type arnMatchFunction struct{}
+
+func (f arnMatchFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_match"
+}
+
+func (f arnMatchFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_match Function",
+		MarkdownDescription: "Checks whether an ARN matches an IAM-style ARN pattern. Each of the six " +
+			"colon-delimited sections of the pattern is compared separately and may contain " +
+			"multi-character (`*`) and single-character (`?`) wildcards, as with the `ArnLike` " +
+			"IAM condition operator. A pattern with fewer sections, such as `arn:aws:iam::*`, matches its " +
+			"last section against the remainder of the ARN.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to test",
+			},
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "ARN pattern, optionally containing `*` and `?` wildcards",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f arnMatchFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg, pattern string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg, &pattern))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := iampolicy.ARNLike(arg, pattern)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrAllocateFunction{}
+
+func NewCIDRAllocateFunction() function.Function {
+	return &cidrAllocateFunction{}
+}
+
+type cidrAllocateFunction struct{}
+
+func (f cidrAllocateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_allocate"
+}
+
+func (f cidrAllocateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_allocate Function",
+		MarkdownDescription: "Allocates CIDR blocks with the requested prefix lengths from a parent IPv4 or IPv6 " +
+			"CIDR block, avoiding reserved CIDR blocks. Larger blocks are allocated first, each at the lowest " +
+			"free address aligned to its size. The allocated CIDR blocks are returned in the order of the " +
+			"requested prefix lengths.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "parent",
+				MarkdownDescription: "CIDR block from which to allocate",
+			},
+			function.ListParameter{
+				Name:                "prefix_lengths",
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "Prefix lengths of the CIDR blocks to allocate",
+			},
+			function.ListParameter{
+				Name:                "reserved",
+				ElementType:         types.StringType,
+				MarkdownDescription: "CIDR blocks that must not be allocated",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrAllocateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var parent string
+	var prefixLengths []int64
+	var reserved []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &parent, &prefixLengths, &reserved))
+	if resp.Error != nil {
+		return
+	}
+
+	lengths := make([]int, 0, len(prefixLengths))
+	for _, v := range prefixLengths {
+		lengths = append(lengths, int(v))
+	}
+
+	result, err := itypes.AllocateCIDRBlocks(parent, lengths, reserved)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
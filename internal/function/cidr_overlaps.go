@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrOverlapsFunction{}
+
+func NewCIDROverlapsFunction() function.Function {
+	return &cidrOverlapsFunction{}
+}
+
+type cidrOverlapsFunction struct{}
+
+func (f cidrOverlapsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_overlaps"
+}
+
+func (f cidrOverlapsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_overlaps Function",
+		MarkdownDescription: "Returns each pair of overlapping CIDR blocks in a list of IPv4 and IPv6 CIDR blocks. " +
+			"CIDR blocks of different address families never overlap.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "cidr_blocks",
+				ElementType:         types.StringType,
+				MarkdownDescription: "CIDR blocks to check for overlaps",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ListType{
+				ElemType: types.StringType,
+			},
+		},
+	}
+}
+
+func (f cidrOverlapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlocks []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlocks))
+	if resp.Error != nil {
+		return
+	}
+
+	for _, cidr := range cidrBlocks {
+		if err := itypes.ValidateCIDRBlock(cidr); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+			return
+		}
+	}
+
+	result := make([][]string, 0)
+	for i, cidr1 := range cidrBlocks {
+		for _, cidr2 := range cidrBlocks[i+1:] {
+			overlap, err := itypes.CIDRBlocksOverlap(cidr1, cidr2)
+			if err != nil {
+				resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+				return
+			}
+
+			if overlap {
+				result = append(result, []string{cidr1, cidr2})
+			}
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
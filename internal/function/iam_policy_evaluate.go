@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+var _ function.Function = iamPolicyEvaluateFunction{}
+
+func NewIAMPolicyEvaluateFunction() function.Function {
+	return &iamPolicyEvaluateFunction{}
+}
+
+type iamPolicyEvaluateFunction struct{}
+
+func (f iamPolicyEvaluateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_evaluate"
+}
+
+func (f iamPolicyEvaluateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_evaluate Function",
+		MarkdownDescription: "Evaluates a request against an IAM policy document locally, without calling AWS. " +
+			"Returns the decision: `allowed`, `explicitDeny` or `implicitDeny`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+			function.StringParameter{
+				Name:                "action",
+				MarkdownDescription: "Name of the requested action, for example `s3:DeleteBucket`",
+			},
+			function.StringParameter{
+				Name:                "resource",
+				MarkdownDescription: "ARN of the requested resource, or `*` for all resources",
+			},
+			function.MapParameter{
+				Name:           "context",
+				ElementType:    types.ListType{ElemType: types.StringType},
+				AllowNullValue: true,
+				MarkdownDescription: "Values of the condition context keys of the request, for example `aws:SourceIp`. " +
+					"Use `null` for a request without context.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyEvaluateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policy, action, resource string
+	var contextArg types.Map
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policy, &action, &resource, &contextArg))
+	if resp.Error != nil {
+		return
+	}
+
+	var requestContext map[string][]string
+	if !contextArg.IsNull() {
+		resp.Error = function.ConcatFuncErrors(function.FuncErrorFromDiags(ctx, contextArg.ElementsAs(ctx, &requestContext, false)))
+		if resp.Error != nil {
+			return
+		}
+	}
+
+	evaluator, err := iampolicy.NewEvaluator([]string{policy}, nil)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, err := evaluator.Evaluate(&iampolicy.Request{
+		Action:   action,
+		Resource: resource,
+		Context:  requestContext,
+	})
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result.Decision))
+}
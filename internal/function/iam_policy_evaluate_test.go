@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+const testIAMPolicyEvaluateFunctionPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"},{"Effect":"Deny","Action":"s3:DeleteBucket","Resource":"arn:aws:s3:::protected"},{"Effect":"Allow","Action":"ec2:RunInstances","Resource":"*","Condition":{"StringEquals":{"aws:RequestedRegion":"us-west-2"}}}]}`
+
+func TestIAMPolicyEvaluateFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig(testIAMPolicyEvaluateFunctionPolicy, "s3:GetObject", "arn:aws:s3:::protected/key", "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "allowed"),
+				),
+			},
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig(testIAMPolicyEvaluateFunctionPolicy, "s3:DeleteBucket", "arn:aws:s3:::protected", "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "explicitDeny"),
+				),
+			},
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig(testIAMPolicyEvaluateFunctionPolicy, "iam:CreateUser", "*", "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "implicitDeny"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEvaluateFunction_context(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig(testIAMPolicyEvaluateFunctionPolicy, "ec2:RunInstances", "*", `{ "aws:RequestedRegion" = ["us-west-2"] }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "allowed"),
+				),
+			},
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig(testIAMPolicyEvaluateFunctionPolicy, "ec2:RunInstances", "*", `{ "aws:RequestedRegion" = ["eu-west-1"] }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "implicitDeny"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEvaluateFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyEvaluateFunctionConfig(`{"Version":`, "s3:GetObject", "*", "null"),
+				ExpectError: regexache.MustCompile(`parsing policy`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyEvaluateFunctionConfig(policy, action, resource, context string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_evaluate(%[1]q, %[2]q, %[3]q, %[4]s)
+}
+`, policy, action, resource, context)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = iamPolicyNormalizeFunction{}
+
+func NewIAMPolicyNormalizeFunction() function.Function {
+	return &iamPolicyNormalizeFunction{}
+}
+
+type iamPolicyNormalizeFunction struct{}
+
+func (f iamPolicyNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_normalize"
+}
+
+func (f iamPolicyNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_normalize Function",
+		MarkdownDescription: "Returns the canonical form of an IAM policy document. Equivalent policy " +
+			"documents produce identical output, regardless of element order, whitespace or whether " +
+			"single values are written as arrays.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name: "partition",
+			MarkdownDescription: "Partition in which AWS account ID principals are represented as the account's " +
+				"root user ARN. If omitted, account ID principals are left as is.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+	var partitions []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg, &partitions))
+	if resp.Error != nil {
+		return
+	}
+
+	var partition string
+	switch len(partitions) {
+	case 0:
+	case 1:
+		partition = partitions[0]
+	default:
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "at most one partition may be specified"))
+		return
+	}
+
+	result, err := verify.CanonicalPolicyString(arg, partition)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
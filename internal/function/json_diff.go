@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+var jsonDiffResultAttrTypes = map[string]attr.Type{
+	"path": types.StringType,
+	"kind": types.StringType,
+	"old":  types.StringType,
+	"new":  types.StringType,
+}
+
+var _ function.Function = jsonDiffFunction{}
+
+func NewJSONDiffFunction() function.Function {
+	return &jsonDiffFunction{}
+}
+
+type jsonDiffFunction struct{}
+
+func (f jsonDiffFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "json_diff"
+}
+
+func (f jsonDiffFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "json_diff Function",
+		MarkdownDescription: "Returns the structural differences between two JSON documents as a list of " +
+			"objects with `path`, `kind`, `old` and `new` attributes. Object keys are compared " +
+			"in sorted order and array elements are aligned so that an inserted or removed element is reported once.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "a",
+				MarkdownDescription: "First JSON document",
+			},
+			function.StringParameter{
+				Name:                "b",
+				MarkdownDescription: "Second JSON document",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: jsonDiffResultAttrTypes,
+			},
+		},
+	}
+}
+
+func (f jsonDiffFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &a, &b))
+	if resp.Error != nil {
+		return
+	}
+
+	diffs, err := tfjson.Diff(a, b)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	elements := make([]attr.Value, len(diffs))
+	for i, diff := range diffs {
+		value := map[string]attr.Value{
+			"path": types.StringValue(diff.Path),
+			"kind": types.StringValue(string(diff.Kind)),
+			"old":  types.StringNull(),
+			"new":  types.StringNull(),
+		}
+		if diff.Kind != tfjson.DifferenceKindAdded {
+			value["old"] = types.StringValue(diff.Old)
+		}
+		if diff.Kind != tfjson.DifferenceKindRemoved {
+			value["new"] = types.StringValue(diff.New)
+		}
+
+		element, d := types.ObjectValue(jsonDiffResultAttrTypes, value)
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+
+		elements[i] = element
+	}
+
+	result, d := types.ListValue(types.ObjectType{AttrTypes: jsonDiffResultAttrTypes}, elements)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
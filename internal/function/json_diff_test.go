@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestJSONDiffFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testJSONDiffFunctionConfig(`{"Statement":[{"Effect":"Allow","Sid":"a"}]}`, `{"Statement":[{"Effect":"Deny"}]}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "2"),
+					resource.TestCheckOutput("first", `$.Statement[0].Effect changed "Allow" "Deny"`),
+				),
+			},
+		},
+	})
+}
+
+func TestJSONDiffFunction_equal(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testJSONDiffFunctionConfig(`{"a":1,"b":[2]}`, `{"b":[2],"a":1}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestJSONDiffFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testJSONDiffFunctionConfig(`{`, `{}`),
+				ExpectError: regexache.MustCompile(`decoding first JSON document`),
+			},
+		},
+	})
+}
+
+func testJSONDiffFunctionConfig(a, b string) string {
+	return fmt.Sprintf(`
+locals {
+  diff = provider::aws::json_diff(%[1]q, %[2]q)
+}
+
+output "count" {
+  value = length(local.diff)
+}
+
+output "first" {
+  value = length(local.diff) > 0 ? "${local.diff[0].path} ${local.diff[0].kind} ${local.diff[0].old} ${local.diff[0].new}" : ""
+}
+`, a, b)
+}
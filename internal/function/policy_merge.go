@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// policySizeLimit is the maximum size of a policy document of a given type.
+type policySizeLimit struct {
+	size int
+	// bytes is true if size is a number of bytes, including whitespace,
+	// rather than a number of non-whitespace characters as for IAM and
+	// AWS Organizations policies.
+	bytes bool
+}
+
+// policySizeLimits is the size limit of a policy document of each supported type.
+// Resource policies have a limit per service, so only some services are supported.
+//
+// Quota reference:
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html#reference_iam-quotas-entity-length
+// https://docs.aws.amazon.com/organizations/latest/userguide/orgs_reference_limits.html#min-max-values
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/bucket-policies.html
+// https://docs.aws.amazon.com/kms/latest/developerguide/resource-limits.html
+var policySizeLimits = map[string]policySizeLimit{
+	"managed":      {size: 6144},
+	"inline_user":  {size: 2048},
+	"inline_group": {size: 5120},
+	"inline_role":  {size: 10240},
+	"scp":          {size: 5120},
+	"s3_bucket":    {size: 20480, bytes: true},
+	"kms_key":      {size: 32768, bytes: true},
+}
+
+var _ function.Function = policyMergeFunction{}
+
+func NewPolicyMergeFunction() function.Function {
+	return &policyMergeFunction{}
+}
+
+type policyMergeFunction struct{}
+
+func (f policyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_merge"
+}
+
+func (f policyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "policy_merge Function",
+		MarkdownDescription: "Merges a list of IAM policy documents in order, as with the `override_policy_documents` " +
+			"argument of the `aws_iam_policy_document` data source. Statements with a `Sid` replace any statement " +
+			"with the same `Sid` in an earlier document. Optionally checks the merged document against the size " +
+			"limit for a policy type.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				ElementType:         types.StringType,
+				MarkdownDescription: "IAM policy documents in JSON format",
+			},
+			function.StringParameter{
+				Name:           "policy_type",
+				AllowNullValue: true,
+				MarkdownDescription: "Type of policy whose size limit the merged document must not exceed. " +
+					"Valid values are `managed`, `inline_user`, `inline_group`, `inline_role`, `scp`, `s3_bucket` and `kms_key`. " +
+					"Use `null` to skip the size check.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f policyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies []string
+	var policyType types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policies, &policyType))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := verify.MergePolicyStrings(policies)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	if !policyType.IsNull() {
+		if err := checkPolicySize(result, policyType.ValueString()); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+			return
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// checkPolicySize returns an error if the size of policy exceeds the limit for policyType.
+func checkPolicySize(policy, policyType string) error {
+	limit, ok := policySizeLimits[policyType]
+	if !ok {
+		validValues := tfmaps.Keys(policySizeLimits)
+		slices.Sort(validValues)
+		return fmt.Errorf("policy_type must be one of %s, got %q", strings.Join(validValues, ", "), policyType)
+	}
+
+	if limit.bytes {
+		if size := len(policy); size > limit.size {
+			return fmt.Errorf("merged policy size (%d bytes) exceeds the %s policy limit of %d bytes", size, policyType, limit.size)
+		}
+
+		return nil
+	}
+
+	var size int
+	for _, r := range policy {
+		if !unicode.IsSpace(r) {
+			size++
+		}
+	}
+
+	if size > limit.size {
+		return fmt.Errorf("merged policy size (%d characters) exceeds the %s policy limit of %d characters", size, policyType, limit.size)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckPolicySize(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		policy     string
+		policyType string
+		expectErr  bool
+	}{
+		{
+			name:       "within limit",
+			policy:     `{"Version":"2012-10-17"}`,
+			policyType: "inline_user",
+		},
+		{
+			name:       "whitespace not counted",
+			policy:     strings.Repeat("a", 2048) + strings.Repeat(" ", 100),
+			policyType: "inline_user",
+		},
+		{
+			name:       "exceeds limit",
+			policy:     strings.Repeat("a", 2049),
+			policyType: "inline_user",
+			expectErr:  true,
+		},
+		{
+			name:       "larger limit",
+			policy:     strings.Repeat("a", 2049),
+			policyType: "inline_role",
+		},
+		{
+			name:       "bytes limit",
+			policy:     strings.Repeat("a", 20480),
+			policyType: "s3_bucket",
+		},
+		{
+			name:       "whitespace counted in bytes",
+			policy:     strings.Repeat("a", 20480) + " ",
+			policyType: "s3_bucket",
+			expectErr:  true,
+		},
+		{
+			name:       "invalid type",
+			policy:     `{}`,
+			policyType: "unknown",
+			expectErr:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkPolicySize(testCase.policy, testCase.policyType)
+
+			if got, want := err != nil, testCase.expectErr; got != want {
+				t.Errorf("checkPolicySize(%q) err %t (%v), want %t", testCase.policyType, got, err, want)
+			}
+		})
+	}
+}
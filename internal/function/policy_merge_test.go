@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestPolicyMergeFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testPolicyMergeFunctionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Deny","Action":"s3:GetObject","Resource":"*"},{"Sid":"B","Effect":"Allow","Action":"s3:ListBucket","Resource":"*"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestPolicyMergeFunction_sizeExceeded(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testPolicyMergeFunctionConfig_sizeExceeded(),
+				ExpectError: regexache.MustCompile(`exceeds[\s\n]*the[\s\n]*inline_user[\s\n]*policy[\s\n]*limit`),
+			},
+		},
+	})
+}
+
+func testPolicyMergeFunctionConfig_basic() string {
+	return `
+locals {
+  source = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      { Sid = "A", Effect = "Allow", Action = "s3:GetObject", Resource = "*" },
+      { Sid = "B", Effect = "Allow", Action = "s3:ListBucket", Resource = "*" },
+    ]
+  })
+  override = jsonencode({
+    Statement = [
+      { Sid = "A", Effect = "Deny", Action = "s3:GetObject", Resource = "*" },
+    ]
+  })
+}
+
+output "test" {
+  value = provider::aws::policy_merge([local.source, local.override], null)
+}
+`
+}
+
+func testPolicyMergeFunctionConfig_sizeExceeded() string {
+	return `
+locals {
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      for i in range(50) : { Sid = "S${i}", Effect = "Allow", Action = "s3:GetObject", Resource = "arn:aws:s3:::example-bucket-${i}/*" }
+    ]
+  })
+}
+
+output "test" {
+  value = provider::aws::policy_merge([local.policy], "inline_user")
+}
+`
+}
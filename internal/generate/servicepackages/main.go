@@ -17,6 +17,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names/data"
 )
 
+var (
+	serviceDir = flag.String("servicedir", "../service", "Path of the directory containing the service packages")
+)
+
 func main() {
 	filename := `service_packages_gen.go`
 
@@ -46,7 +50,7 @@ func main() {
 		// See internal/generate/namesconsts/main.go.
 		p := l.ProviderPackage()
 
-		spdFile := fmt.Sprintf("../service/%s/service_package_gen.go", p)
+		spdFile := fmt.Sprintf("%s/%s/service_package_gen.go", *serviceDir, p)
 
 		if _, err := os.Stat(spdFile); err != nil {
 			continue
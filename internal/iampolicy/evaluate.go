@@ -0,0 +1,562 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// Policy evaluation decisions, as returned by the IAM policy simulator.
+const (
+	DecisionAllowed      = "allowed"
+	DecisionExplicitDeny = "explicitDeny"
+	DecisionImplicitDeny = "implicitDeny"
+)
+
+const (
+	sourceIDPrefixPolicy              = "PolicyInputList"
+	sourceIDPrefixPermissionsBoundary = "PermissionsBoundaryPolicyInputList"
+)
+
+// Request is a request to be evaluated against IAM policies.
+type Request struct {
+	// Action is the action being requested, for example "s3:DeleteBucket".
+	Action string
+	// Resource is the ARN of the resource being acted on. Defaults to "*".
+	Resource string
+	// CallerARN is the ARN of the calling principal.
+	// Principal and NotPrincipal policy elements are only evaluated if it is set.
+	CallerARN string
+	// Context contains the values of condition context keys, for example "aws:SourceIp".
+	// Keys are case-insensitive.
+	Context map[string][]string
+}
+
+// Statement identifies a policy statement that contributed to a decision.
+type Statement struct {
+	SourcePolicyID string
+	StatementID    string
+}
+
+// Result is the result of evaluating a request against IAM policies.
+type Result struct {
+	Decision           string
+	MatchedStatements  []Statement
+	MissingContextKeys []string
+}
+
+// Allowed returns whether the request is allowed.
+func (r *Result) Allowed() bool {
+	return r.Decision == DecisionAllowed
+}
+
+type policySource struct {
+	id  string
+	doc *IAMPolicyDoc
+}
+
+// Evaluator evaluates requests against identity-based IAM policies and permissions boundaries locally,
+// without calling AWS. It follows the IAM policy evaluation logic for a single account:
+// an explicit deny in any policy overrides any allow, and a request is denied unless it is
+// allowed by the identity-based policies and, if there are any, the permissions boundaries.
+type Evaluator struct {
+	policies              []policySource
+	permissionsBoundaries []policySource
+}
+
+// NewEvaluator returns a new evaluator for the specified IAM policy documents in JSON format.
+func NewEvaluator(policies, permissionsBoundaries []string) (*Evaluator, error) {
+	e := &Evaluator{}
+
+	for i, v := range policies {
+		doc, err := unmarshalDocument(v)
+		if err != nil {
+			return nil, fmt.Errorf("policy (%d): %w", i+1, err)
+		}
+		e.policies = append(e.policies, policySource{
+			id:  fmt.Sprintf("%s.%d", sourceIDPrefixPolicy, i+1),
+			doc: doc,
+		})
+	}
+
+	for i, v := range permissionsBoundaries {
+		doc, err := unmarshalDocument(v)
+		if err != nil {
+			return nil, fmt.Errorf("permissions boundary policy (%d): %w", i+1, err)
+		}
+		e.permissionsBoundaries = append(e.permissionsBoundaries, policySource{
+			id:  fmt.Sprintf("%s.%d", sourceIDPrefixPermissionsBoundary, i+1),
+			doc: doc,
+		})
+	}
+
+	return e, nil
+}
+
+// Evaluate returns the decision for the specified request.
+// The identity-based policies and the permissions boundaries are always both evaluated, so that an explicit deny in either
+// is reported, with the statements that deny the request.
+func (e *Evaluator) Evaluate(request *Request) (*Result, error) {
+	// Don't modify the caller's request.
+	r := *request
+	if r.Resource == "" {
+		r.Resource = "*"
+	}
+
+	missingContextKeys := make(map[string]struct{})
+
+	result, err := evaluateSources(e.policies, &r, missingContextKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(e.permissionsBoundaries) > 0 {
+		boundaryResult, err := evaluateSources(e.permissionsBoundaries, &r, missingContextKeys)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case boundaryResult.Decision == DecisionExplicitDeny && result.Decision == DecisionExplicitDeny:
+			result.MatchedStatements = append(result.MatchedStatements, boundaryResult.MatchedStatements...)
+		case result.Decision == DecisionExplicitDeny:
+		case boundaryResult.Decision != DecisionAllowed:
+			result = boundaryResult
+		case result.Decision == DecisionAllowed:
+			result.MatchedStatements = append(result.MatchedStatements, boundaryResult.MatchedStatements...)
+		}
+	}
+
+	for k := range missingContextKeys {
+		result.MissingContextKeys = append(result.MissingContextKeys, k)
+	}
+	slices.Sort(result.MissingContextKeys)
+
+	return result, nil
+}
+
+func evaluateSources(sources []policySource, request *Request, missingContextKeys map[string]struct{}) (*Result, error) {
+	var allows, denies []Statement
+
+	for _, source := range sources {
+		for i, statement := range source.doc.Statements {
+			matches, err := statementMatches(statement, request, missingContextKeys)
+			if err != nil {
+				return nil, fmt.Errorf("%s: statement (%d): %w", source.id, i+1, err)
+			}
+
+			if !matches {
+				continue
+			}
+
+			matched := Statement{
+				SourcePolicyID: source.id,
+				StatementID:    statement.Sid,
+			}
+			if matched.StatementID == "" {
+				matched.StatementID = strconv.Itoa(i + 1)
+			}
+
+			switch statement.Effect {
+			case "Allow":
+				allows = append(allows, matched)
+			case "Deny":
+				denies = append(denies, matched)
+			default:
+				return nil, fmt.Errorf("%s: statement (%d): invalid Effect: %q", source.id, i+1, statement.Effect)
+			}
+		}
+	}
+
+	switch {
+	case len(denies) > 0:
+		return &Result{Decision: DecisionExplicitDeny, MatchedStatements: denies}, nil
+	case len(allows) > 0:
+		return &Result{Decision: DecisionAllowed, MatchedStatements: allows}, nil
+	default:
+		return &Result{Decision: DecisionImplicitDeny}, nil
+	}
+}
+
+// unmarshalDocument parses an IAM policy document.
+// Unlike a plain json.Unmarshal into IAMPolicyDoc, a single Statement object is accepted.
+func unmarshalDocument(policy string) (*IAMPolicyDoc, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	if v, ok := raw["Statement"]; ok {
+		if v := bytes.TrimSpace(v); len(v) > 0 && v[0] == '{' {
+			raw["Statement"] = append(append([]byte{'['}, v...), ']')
+		}
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &IAMPolicyDoc{}
+	if err := json.Unmarshal(b, doc); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	return doc, nil
+}
+
+func statementMatches(statement *IAMPolicyStatement, request *Request, missingContextKeys map[string]struct{}) (bool, error) {
+	switch {
+	case statement.Actions != nil:
+		if !slices.ContainsFunc(elementStrings(statement.Actions), func(pattern string) bool {
+			return actionMatches(request.Action, pattern)
+		}) {
+			return false, nil
+		}
+	case statement.NotActions != nil:
+		if slices.ContainsFunc(elementStrings(statement.NotActions), func(pattern string) bool {
+			return actionMatches(request.Action, pattern)
+		}) {
+			return false, nil
+		}
+	default:
+		return false, fmt.Errorf("one of Action or NotAction must be specified")
+	}
+
+	// Statements in policies without a Resource element, such as trust policies, apply to any resource.
+	switch {
+	case statement.Resources != nil:
+		if !slices.ContainsFunc(elementStrings(statement.Resources), func(pattern string) bool {
+			return resourceMatches(request.Resource, pattern, request.Context)
+		}) {
+			return false, nil
+		}
+	case statement.NotResources != nil:
+		if slices.ContainsFunc(elementStrings(statement.NotResources), func(pattern string) bool {
+			return resourceMatches(request.Resource, pattern, request.Context)
+		}) {
+			return false, nil
+		}
+	}
+
+	if request.CallerARN != "" {
+		switch {
+		case len(statement.Principals) > 0:
+			if !principalsMatch(statement.Principals, request.CallerARN) {
+				return false, nil
+			}
+		case len(statement.NotPrincipals) > 0:
+			if principalsMatch(statement.NotPrincipals, request.CallerARN) {
+				return false, nil
+			}
+		}
+	}
+
+	// All conditions must be met.
+	for _, condition := range statement.Conditions {
+		met, err := conditionMet(condition, request.Context, missingContextKeys)
+		if err != nil {
+			return false, err
+		}
+
+		if !met {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// actionMatches returns whether an action matches an action pattern.
+// Actions are case-insensitive.
+func actionMatches(action, pattern string) bool {
+	return WildcardMatch(strings.ToLower(action), strings.ToLower(pattern))
+}
+
+// resourceMatches returns whether a resource ARN matches a resource pattern,
+// after substituting any policy variables in the pattern.
+func resourceMatches(resource, pattern string, context map[string][]string) bool {
+	pattern, ok := substituteVariables(pattern, context)
+	if !ok {
+		return false
+	}
+
+	// A request for "*" only matches a statement that applies to all resources.
+	if resource == "*" {
+		return pattern == "*"
+	}
+
+	return WildcardMatch(resource, pattern)
+}
+
+// principalsMatch returns whether the caller matches any of the principals.
+func principalsMatch(principals IAMPolicyStatementPrincipalSet, caller string) bool {
+	var callerAccountID string
+	if v, err := arn.Parse(caller); err == nil {
+		callerAccountID = v.AccountID
+	}
+
+	for _, principal := range principals {
+		for _, identifier := range elementStrings(principal.Identifiers) {
+			if identifier == "*" || identifier == caller {
+				return true
+			}
+
+			if principal.Type != "AWS" || callerAccountID == "" {
+				continue
+			}
+
+			// An account principal matches all principals in the account.
+			if identifier == callerAccountID {
+				return true
+			}
+			if v, err := arn.Parse(identifier); err == nil && v.Service == "iam" && v.Resource == "root" && v.AccountID == callerAccountID {
+				return true
+			}
+		}
+
+		if principal.Type == "*" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// conditionMet returns whether a condition is met.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html.
+func conditionMet(condition IAMPolicyStatementCondition, context map[string][]string, missingContextKeys map[string]struct{}) (bool, error) {
+	operator := condition.Test
+	conditionValues := elementStrings(condition.Values)
+	requestValues, present := contextValues(context, condition.Variable)
+
+	if operator == "Null" {
+		for _, v := range conditionValues {
+			null, err := strconv.ParseBool(v)
+			if err != nil {
+				return false, fmt.Errorf("invalid %s condition value: %q", operator, v)
+			}
+			if null != present {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	var forAllValues, forAnyValue, ifExists bool
+	if v, ok := strings.CutPrefix(operator, "ForAllValues:"); ok {
+		operator, forAllValues = v, true
+	} else if v, ok := strings.CutPrefix(operator, "ForAnyValue:"); ok {
+		operator, forAnyValue = v, true
+	}
+	if v, ok := strings.CutSuffix(operator, "IfExists"); ok {
+		operator, ifExists = v, true
+	}
+
+	match, negated, err := conditionOperator(operator)
+	if err != nil {
+		return false, err
+	}
+
+	if !present {
+		missingContextKeys[condition.Variable] = struct{}{}
+
+		// A condition on a missing key is met only if the key must not match.
+		return ifExists || forAllValues || negated, nil
+	}
+
+	// A request value satisfies the condition if it matches any of the condition's values,
+	// or for a negated operator, none of them.
+	satisfies := func(requestValue string) bool {
+		matched := slices.ContainsFunc(conditionValues, func(conditionValue string) bool {
+			conditionValue, ok := substituteVariables(conditionValue, context)
+			return ok && match(requestValue, conditionValue)
+		})
+		return matched != negated
+	}
+
+	switch {
+	case forAllValues:
+		return !slices.ContainsFunc(requestValues, func(v string) bool { return !satisfies(v) }), nil
+	case forAnyValue, !negated:
+		return slices.ContainsFunc(requestValues, satisfies), nil
+	default:
+		return !slices.ContainsFunc(requestValues, func(v string) bool { return !satisfies(v) }), nil
+	}
+}
+
+// conditionOperator returns the value matching function for a condition operator
+// and whether the operator is negated.
+func conditionOperator(operator string) (func(string, string) bool, bool, error) {
+	negated := false
+	switch operator {
+	case "NotIpAddress":
+		operator, negated = "IpAddress", true
+	case "StringNotEquals", "StringNotEqualsIgnoreCase", "StringNotLike", "NumericNotEquals", "DateNotEquals", "ArnNotEquals", "ArnNotLike":
+		operator, negated = strings.Replace(operator, "Not", "", 1), true
+	}
+
+	switch operator {
+	case "StringEquals", "BinaryEquals":
+		return func(v, c string) bool { return v == c }, negated, nil
+	case "StringEqualsIgnoreCase":
+		return strings.EqualFold, negated, nil
+	case "StringLike":
+		return WildcardMatch, negated, nil
+	case "NumericEquals", "NumericLessThan", "NumericLessThanEquals", "NumericGreaterThan", "NumericGreaterThanEquals":
+		return compare(strings.TrimPrefix(operator, "Numeric"), func(s string) (float64, error) {
+			return strconv.ParseFloat(s, 64)
+		}), negated, nil
+	case "DateEquals", "DateLessThan", "DateLessThanEquals", "DateGreaterThan", "DateGreaterThanEquals":
+		return compare(strings.TrimPrefix(operator, "Date"), func(s string) (float64, error) {
+			t, err := parseDate(s)
+			return float64(t.Unix()), err
+		}), negated, nil
+	case "Bool":
+		return strings.EqualFold, negated, nil
+	case "IpAddress":
+		return ipAddressMatches, negated, nil
+	case "ArnEquals", "ArnLike":
+		return arnMatches, negated, nil
+	}
+
+	return nil, false, fmt.Errorf("unsupported condition operator: %s", operator)
+}
+
+func compare(comparison string, parse func(string) (float64, error)) func(string, string) bool {
+	return func(v, c string) bool {
+		x, err := parse(v)
+		if err != nil {
+			return false
+		}
+		y, err := parse(c)
+		if err != nil {
+			return false
+		}
+
+		switch comparison {
+		case "Equals":
+			return x == y
+		case "LessThan":
+			return x < y
+		case "LessThanEquals":
+			return x <= y
+		case "GreaterThan":
+			return x > y
+		case "GreaterThanEquals":
+			return x >= y
+		}
+
+		return false
+	}
+}
+
+// parseDate parses a date in ISO 8601 format or in epoch (UNIX) time.
+func parseDate(s string) (time.Time, error) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(v, 0), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05Z0700", time.DateOnly} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date: %q", s)
+}
+
+func ipAddressMatches(v, c string) bool {
+	addr, err := netip.ParseAddr(v)
+	if err != nil {
+		return false
+	}
+
+	if !strings.Contains(c, "/") {
+		c += "/" + strconv.Itoa(addr.BitLen())
+	}
+
+	prefix, err := netip.ParsePrefix(c)
+	if err != nil {
+		return false
+	}
+
+	return prefix.Contains(addr)
+}
+
+// arnMatches returns whether an ARN matches an ARN condition value.
+// Values that are not ARNs never match.
+func arnMatches(v, c string) bool {
+	match, err := ARNLike(v, c)
+	return err == nil && match
+}
+
+// contextValues returns the values of a condition context key.
+// Context keys are case-insensitive.
+func contextValues(context map[string][]string, key string) ([]string, bool) {
+	for k, v := range context {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+var variableRegexp = regexache.MustCompile(`\$\{([^}]+)\}`)
+
+// substituteVariables replaces policy variables, such as ${aws:username}, with their values.
+// Returns false if a variable has no value.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_variables.html.
+func substituteVariables(s string, context map[string][]string) (string, bool) {
+	ok := true
+
+	s = variableRegexp.ReplaceAllStringFunc(s, func(m string) string {
+		name := m[2 : len(m)-1]
+
+		switch name {
+		case "*", "?", "$":
+			return name
+		}
+
+		if v, present := contextValues(context, name); present && len(v) == 1 {
+			return v[0]
+		}
+
+		ok = false
+		return ""
+	})
+
+	return s, ok
+}
+
+// elementStrings returns the string values of a policy element.
+func elementStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		s := make([]string, 0, len(v))
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	}
+
+	return nil
+}
@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+func TestEvaluatorEvaluate(t *testing.T) {
+	t.Parallel()
+
+	const s3Policy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowS3",
+      "Effect": "Allow",
+      "Action": "s3:*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "DenyDeleteBucket",
+      "Effect": "Deny",
+      "Action": ["s3:DeleteBucket", "s3:DeleteBucketPolicy"],
+      "Resource": "arn:aws:s3:::protected-*"
+    }
+  ]
+}`
+
+	testCases := map[string]struct {
+		policies              []string
+		permissionsBoundaries []string
+		request               iampolicy.Request
+		expectedDecision      string
+		expectedStatements    []iampolicy.Statement
+		expectedMissingKeys   []string
+		expectErr             bool
+	}{
+		"allowed": {
+			policies:           []string{s3Policy},
+			request:            iampolicy.Request{Action: "s3:GetObject", Resource: "arn:aws:s3:::protected-bucket/key"},
+			expectedDecision:   iampolicy.DecisionAllowed,
+			expectedStatements: []iampolicy.Statement{{SourcePolicyID: "PolicyInputList.1", StatementID: "AllowS3"}},
+		},
+		"explicit deny": {
+			policies:           []string{s3Policy},
+			request:            iampolicy.Request{Action: "s3:DeleteBucket", Resource: "arn:aws:s3:::protected-bucket"},
+			expectedDecision:   iampolicy.DecisionExplicitDeny,
+			expectedStatements: []iampolicy.Statement{{SourcePolicyID: "PolicyInputList.1", StatementID: "DenyDeleteBucket"}},
+		},
+		"action case-insensitive": {
+			policies:         []string{s3Policy},
+			request:          iampolicy.Request{Action: "S3:deletebucket", Resource: "arn:aws:s3:::protected-bucket"},
+			expectedDecision: iampolicy.DecisionExplicitDeny,
+			expectedStatements: []iampolicy.Statement{
+				{SourcePolicyID: "PolicyInputList.1", StatementID: "DenyDeleteBucket"},
+			},
+		},
+		"implicit deny": {
+			policies:         []string{s3Policy},
+			request:          iampolicy.Request{Action: "ec2:RunInstances"},
+			expectedDecision: iampolicy.DecisionImplicitDeny,
+		},
+		"single statement": {
+			policies:           []string{`{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"ec2:Describe*","Resource":"*"}}`},
+			request:            iampolicy.Request{Action: "ec2:DescribeVpcs"},
+			expectedDecision:   iampolicy.DecisionAllowed,
+			expectedStatements: []iampolicy.Statement{{SourcePolicyID: "PolicyInputList.1", StatementID: "1"}},
+		},
+		"NotAction": {
+			policies:         []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotAction":"iam:*","Resource":"*"}]}`},
+			request:          iampolicy.Request{Action: "iam:CreateUser"},
+			expectedDecision: iampolicy.DecisionImplicitDeny,
+		},
+		"NotResource": {
+			policies: []string{
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+				`{"Version":"2012-10-17","Statement":[{"Sid":"DenyOthers","Effect":"Deny","Action":"s3:*","NotResource":["arn:aws:s3:::mine","arn:aws:s3:::mine/*"]}]}`,
+			},
+			request:            iampolicy.Request{Action: "s3:GetObject", Resource: "arn:aws:s3:::theirs/key"},
+			expectedDecision:   iampolicy.DecisionExplicitDeny,
+			expectedStatements: []iampolicy.Statement{{SourcePolicyID: "PolicyInputList.2", StatementID: "DenyOthers"}},
+		},
+		"policy variable": {
+			policies:           []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::home/${aws:username}/*"}]}`},
+			request:            iampolicy.Request{Action: "s3:PutObject", Resource: "arn:aws:s3:::home/alice/file", Context: map[string][]string{"aws:username": {"alice"}}},
+			expectedDecision:   iampolicy.DecisionAllowed,
+			expectedStatements: []iampolicy.Statement{{SourcePolicyID: "PolicyInputList.1", StatementID: "1"}},
+		},
+		"policy variable missing": {
+			policies:         []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::home/${aws:username}/*"}]}`},
+			request:          iampolicy.Request{Action: "s3:PutObject", Resource: "arn:aws:s3:::home/alice/file"},
+			expectedDecision: iampolicy.DecisionImplicitDeny,
+		},
+		"condition met": {
+			policies:           []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:*","Resource":"*","Condition":{"StringEquals":{"aws:RequestedRegion":["us-east-1","us-west-2"]},"Bool":{"aws:MultiFactorAuthPresent":true}}}]}`},
+			request:            iampolicy.Request{Action: "ec2:RunInstances", Context: map[string][]string{"aws:requestedregion": {"us-west-2"}, "aws:MultiFactorAuthPresent": {"true"}}},
+			expectedDecision:   iampolicy.DecisionAllowed,
+			expectedStatements: []iampolicy.Statement{{SourcePolicyID: "PolicyInputList.1", StatementID: "1"}},
+		},
+		"condition not met": {
+			policies:         []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:*","Resource":"*","Condition":{"StringEquals":{"aws:RequestedRegion":"us-east-1"}}}]}`},
+			request:          iampolicy.Request{Action: "ec2:RunInstances", Context: map[string][]string{"aws:RequestedRegion": {"eu-west-1"}}},
+			expectedDecision: iampolicy.DecisionImplicitDeny,
+		},
+		"condition key missing": {
+			policies:            []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:*","Resource":"*","Condition":{"StringEquals":{"aws:RequestedRegion":"us-east-1"}}}]}`},
+			request:             iampolicy.Request{Action: "ec2:RunInstances"},
+			expectedDecision:    iampolicy.DecisionImplicitDeny,
+			expectedMissingKeys: []string{"aws:RequestedRegion"},
+		},
+		"negated condition key missing": {
+			policies: []string{
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+				`{"Version":"2012-10-17","Statement":[{"Sid":"DenyOutsideNetwork","Effect":"Deny","Action":"*","Resource":"*","Condition":{"NotIpAddress":{"aws:SourceIp":"192.0.2.0/24"}}}]}`,
+			},
+			request:             iampolicy.Request{Action: "s3:ListAllMyBuckets"},
+			expectedDecision:    iampolicy.DecisionExplicitDeny,
+			expectedStatements:  []iampolicy.Statement{{SourcePolicyID: "PolicyInputList.2", StatementID: "DenyOutsideNetwork"}},
+			expectedMissingKeys: []string{"aws:SourceIp"},
+		},
+		"IpAddress": {
+			policies:           []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"IpAddress":{"aws:SourceIp":["192.0.2.0/24","203.0.113.10"]}}}]}`},
+			request:            iampolicy.Request{Action: "s3:ListAllMyBuckets", Context: map[string][]string{"aws:SourceIp": {"203.0.113.10"}}},
+			expectedDecision:   iampolicy.DecisionAllowed,
+			expectedStatements: []iampolicy.Statement{{SourcePolicyID: "PolicyInputList.1", StatementID: "1"}},
+		},
+		"IfExists": {
+			policies:            []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:RunInstances","Resource":"*","Condition":{"StringLikeIfExists":{"ec2:InstanceType":"t3.*"}}}]}`},
+			request:             iampolicy.Request{Action: "ec2:RunInstances"},
+			expectedDecision:    iampolicy.DecisionAllowed,
+			expectedStatements:  []iampolicy.Statement{{SourcePolicyID: "PolicyInputList.1", StatementID: "1"}},
+			expectedMissingKeys: []string{"ec2:InstanceType"},
+		},
+		"Null": {
+			policies:         []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:CreateTags","Resource":"*","Condition":{"Null":{"aws:RequestTag/Owner":"false"}}}]}`},
+			request:          iampolicy.Request{Action: "ec2:CreateTags"},
+			expectedDecision: iampolicy.DecisionImplicitDeny,
+		},
+		"NumericLessThanEquals": {
+			policies:           []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"NumericLessThanEquals":{"s3:max-keys":10}}}]}`},
+			request:            iampolicy.Request{Action: "s3:ListBucket", Context: map[string][]string{"s3:max-keys": {"10"}}},
+			expectedDecision:   iampolicy.DecisionAllowed,
+			expectedStatements: []iampolicy.Statement{{SourcePolicyID: "PolicyInputList.1", StatementID: "1"}},
+		},
+		"DateLessThan": {
+			policies:         []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"DateLessThan":{"aws:CurrentTime":"2024-01-01T00:00:00Z"}}}]}`},
+			request:          iampolicy.Request{Action: "s3:ListAllMyBuckets", Context: map[string][]string{"aws:CurrentTime": {"2024-06-01T00:00:00Z"}}},
+			expectedDecision: iampolicy.DecisionImplicitDeny,
+		},
+		"ArnLike": {
+			policies:           []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"iam:PassRole","Resource":"*","Condition":{"ArnLike":{"aws:SourceArn":"arn:aws:lambda:*:123456789012:function:*"}}}]}`},
+			request:            iampolicy.Request{Action: "iam:PassRole", Context: map[string][]string{"aws:SourceArn": {"arn:aws:lambda:us-west-2:123456789012:function:example"}}},
+			expectedDecision:   iampolicy.DecisionAllowed,
+			expectedStatements: []iampolicy.Statement{{SourcePolicyID: "PolicyInputList.1", StatementID: "1"}},
+		},
+		"ForAllValues": {
+			policies:           []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:CreateTags","Resource":"*","Condition":{"ForAllValues:StringEquals":{"aws:TagKeys":["Name","Owner"]}}}]}`},
+			request:            iampolicy.Request{Action: "ec2:CreateTags", Context: map[string][]string{"aws:TagKeys": {"Owner", "Name"}}},
+			expectedDecision:   iampolicy.DecisionAllowed,
+			expectedStatements: []iampolicy.Statement{{SourcePolicyID: "PolicyInputList.1", StatementID: "1"}},
+		},
+		"ForAllValues not met": {
+			policies:         []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:CreateTags","Resource":"*","Condition":{"ForAllValues:StringEquals":{"aws:TagKeys":["Name","Owner"]}}}]}`},
+			request:          iampolicy.Request{Action: "ec2:CreateTags", Context: map[string][]string{"aws:TagKeys": {"Owner", "CostCenter"}}},
+			expectedDecision: iampolicy.DecisionImplicitDeny,
+		},
+		"ForAnyValue": {
+			policies:           []string{`{"Version":"2012-10-17","Statement":[{"Sid":"DenyEnvironment","Effect":"Deny","Action":"ec2:CreateTags","Resource":"*","Condition":{"ForAnyValue:StringEquals":{"aws:TagKeys":"Environment"}}}]}`},
+			request:            iampolicy.Request{Action: "ec2:CreateTags", Context: map[string][]string{"aws:TagKeys": {"Owner", "Environment"}}},
+			expectedDecision:   iampolicy.DecisionExplicitDeny,
+			expectedStatements: []iampolicy.Statement{{SourcePolicyID: "PolicyInputList.1", StatementID: "DenyEnvironment"}},
+		},
+		"permissions boundary": {
+			policies:              []string{s3Policy},
+			permissionsBoundaries: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:Get*","Resource":"*"}]}`},
+			request:               iampolicy.Request{Action: "s3:PutObject", Resource: "arn:aws:s3:::bucket/key"},
+			expectedDecision:      iampolicy.DecisionImplicitDeny,
+		},
+		"permissions boundary allowed": {
+			policies:              []string{s3Policy},
+			permissionsBoundaries: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:Get*","Resource":"*"}]}`},
+			request:               iampolicy.Request{Action: "s3:GetObject", Resource: "arn:aws:s3:::bucket/key"},
+			expectedDecision:      iampolicy.DecisionAllowed,
+			expectedStatements: []iampolicy.Statement{
+				{SourcePolicyID: "PolicyInputList.1", StatementID: "AllowS3"},
+				{SourcePolicyID: "PermissionsBoundaryPolicyInputList.1", StatementID: "1"},
+			},
+		},
+		"permissions boundary explicit deny": {
+			policies:              []string{s3Policy},
+			permissionsBoundaries: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"},{"Sid":"DenyDelete","Effect":"Deny","Action":"s3:Delete*","Resource":"*"}]}`},
+			request:               iampolicy.Request{Action: "s3:DeleteObject", Resource: "arn:aws:s3:::bucket/key"},
+			expectedDecision:      iampolicy.DecisionExplicitDeny,
+			expectedStatements:    []iampolicy.Statement{{SourcePolicyID: "PermissionsBoundaryPolicyInputList.1", StatementID: "DenyDelete"}},
+		},
+		"permissions boundary explicit deny not allowed by policy": {
+			policies:              []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:*","Resource":"*"}]}`},
+			permissionsBoundaries: []string{`{"Version":"2012-10-17","Statement":[{"Sid":"DenyS3","Effect":"Deny","Action":"s3:*","Resource":"*"}]}`},
+			request:               iampolicy.Request{Action: "s3:GetObject", Resource: "arn:aws:s3:::bucket/key"},
+			expectedDecision:      iampolicy.DecisionExplicitDeny,
+			expectedStatements:    []iampolicy.Statement{{SourcePolicyID: "PermissionsBoundaryPolicyInputList.1", StatementID: "DenyS3"}},
+		},
+		"numeric condition": {
+			policies:           []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"NumericLessThanEquals":{"s3:max-keys":10}}}]}`},
+			request:            iampolicy.Request{Action: "s3:ListBucket", Resource: "arn:aws:s3:::bucket", Context: map[string][]string{"s3:max-keys": {"5"}}},
+			expectedDecision:   iampolicy.DecisionAllowed,
+			expectedStatements: []iampolicy.Statement{{SourcePolicyID: "PolicyInputList.1", StatementID: "1"}},
+		},
+		"principal": {
+			policies:           []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"sts:AssumeRole"}]}`},
+			request:            iampolicy.Request{Action: "sts:AssumeRole", CallerARN: "arn:aws:iam::123456789012:role/example"},
+			expectedDecision:   iampolicy.DecisionAllowed,
+			expectedStatements: []iampolicy.Statement{{SourcePolicyID: "PolicyInputList.1", StatementID: "1"}},
+		},
+		"principal not matched": {
+			policies:         []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["111122223333"]},"Action":"sts:AssumeRole"}]}`},
+			request:          iampolicy.Request{Action: "sts:AssumeRole", CallerARN: "arn:aws:iam::123456789012:role/example"},
+			expectedDecision: iampolicy.DecisionImplicitDeny,
+		},
+		"unsupported condition operator": {
+			policies:  []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringSimilar":{"aws:username":"x"}}}]}`},
+			request:   iampolicy.Request{Action: "s3:ListAllMyBuckets", Context: map[string][]string{"aws:username": {"x"}}},
+			expectErr: true,
+		},
+		"invalid JSON": {
+			policies:  []string{`{"Version":`},
+			expectErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			evaluator, err := iampolicy.NewEvaluator(testCase.policies, testCase.permissionsBoundaries)
+			if err == nil {
+				var result *iampolicy.Result
+				result, err = evaluator.Evaluate(&testCase.request)
+
+				if err == nil {
+					if got, want := result.Decision, testCase.expectedDecision; got != want {
+						t.Errorf("Decision = %q, want %q", got, want)
+					}
+					if got, want := result.MatchedStatements, testCase.expectedStatements; !reflect.DeepEqual(got, want) {
+						t.Errorf("MatchedStatements = %v, want %v", got, want)
+					}
+					if got, want := result.MissingContextKeys, testCase.expectedMissingKeys; !reflect.DeepEqual(got, want) {
+						t.Errorf("MissingContextKeys = %v, want %v", got, want)
+					}
+				}
+			}
+
+			if got, want := err != nil, testCase.expectErr; got != want {
+				t.Errorf("err = %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestEvaluatorEvaluateDoesNotModifyRequest(t *testing.T) {
+	t.Parallel()
+
+	evaluator, err := iampolicy.NewEvaluator([]string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListAllMyBuckets","Resource":"*"}]}`}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	request := &iampolicy.Request{Action: "s3:ListAllMyBuckets"}
+	if _, err := evaluator.Evaluate(request); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := request.Resource, ""; got != want {
+		t.Errorf("Resource = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// arnSections is the number of colon-delimited sections in an ARN,
+	// including the "arn" prefix
+	arnSections = 6
+	// arnPrefix is the expected first section of an ARN
+	arnPrefix = "arn"
+)
+
+// ARNLike reports whether s matches pattern using the semantics of the IAM
+// ArnLike condition operator. Each of the six colon-delimited sections is
+// matched separately. A pattern with fewer sections, such as "*" or
+// "arn:aws:iam::*", matches its last section against the remainder of the ARN.
+func ARNLike(s, pattern string) (bool, error) {
+	if pattern == "*" {
+		return true, nil
+	}
+
+	sections, err := splitARN(s)
+	if err != nil {
+		return false, fmt.Errorf("arn: %w", err)
+	}
+
+	patternSections := strings.SplitN(pattern, ":", arnSections)
+	last := len(patternSections) - 1
+
+	for i := 0; i < last; i++ {
+		if !WildcardMatch(sections[i], patternSections[i]) {
+			return false, nil
+		}
+	}
+
+	return WildcardMatch(strings.Join(sections[last:], ":"), patternSections[last]), nil
+}
+
+// splitARN splits s into its six colon-delimited sections. The resource
+// section may itself contain colons.
+func splitARN(s string) ([]string, error) {
+	sections := strings.SplitN(s, ":", arnSections)
+	if len(sections) != arnSections {
+		return nil, fmt.Errorf("not enough sections")
+	}
+
+	if sections[0] != arnPrefix {
+		return nil, fmt.Errorf("invalid prefix")
+	}
+
+	return sections, nil
+}
+
+// WildcardMatch reports whether s matches pattern, where "*" in the pattern
+// matches any sequence of characters (including the empty sequence) and "?"
+// matches any single character. Matching is case-sensitive.
+func WildcardMatch(s, pattern string) bool {
+	var (
+		sr, pr         = []rune(s), []rune(pattern)
+		si, pi         int
+		starPI, starSI = -1, 0
+	)
+
+	for si < len(sr) {
+		switch {
+		case pi < len(pr) && pr[pi] == '*':
+			starPI, starSI = pi, si
+			pi++
+		case pi < len(pr) && (pr[pi] == '?' || pr[pi] == sr[si]):
+			si++
+			pi++
+		case starPI != -1:
+			// Backtrack: let the last "*" consume one more character.
+			pi = starPI + 1
+			starSI++
+			si = starSI
+		default:
+			return false
+		}
+	}
+
+	for pi < len(pr) && pr[pi] == '*' {
+		pi++
+	}
+
+	return pi == len(pr)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+func TestARNLike(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		arn       string
+		pattern   string
+		expected  bool
+		expectErr bool
+	}{
+		{
+			name:     "exact",
+			arn:      "arn:aws:iam::444455556666:role/example",
+			pattern:  "arn:aws:iam::444455556666:role/example",
+			expected: true,
+		},
+		{
+			name:     "any",
+			arn:      "arn:aws:iam::444455556666:role/example",
+			pattern:  "*",
+			expected: true,
+		},
+		{
+			name:     "partition and account wildcards",
+			arn:      "arn:aws-us-gov:s3:::bucket/key",
+			pattern:  "arn:aws*:s3:::bucket/*",
+			expected: true,
+		},
+		{
+			name:     "region wildcard",
+			arn:      "arn:aws:lambda:us-west-2:444455556666:function:example",
+			pattern:  "arn:aws:lambda:*:444455556666:function:*",
+			expected: true,
+		},
+		{
+			name:     "single character wildcard",
+			arn:      "arn:aws:ec2:us-east-1:444455556666:instance/i-1",
+			pattern:  "arn:aws:ec2:us-east-?:444455556666:instance/i-?",
+			expected: true,
+		},
+		{
+			name:     "wildcard does not cross sections",
+			arn:      "arn:aws:iam::444455556666:role/example",
+			pattern:  "arn:aws:*:*:*:example",
+			expected: false,
+		},
+		{
+			name:     "resource section may contain colons",
+			arn:      "arn:aws:logs:us-east-1:444455556666:log-group:example:*",
+			pattern:  "arn:aws:logs:*:*:log-group:*",
+			expected: true,
+		},
+		{
+			name:     "case sensitive",
+			arn:      "arn:aws:iam::444455556666:role/Example",
+			pattern:  "arn:aws:iam::444455556666:role/example",
+			expected: false,
+		},
+		{
+			name:     "backtracking",
+			arn:      "arn:aws:s3:::a-b-c-d",
+			pattern:  "arn:aws:s3:::a*-d",
+			expected: true,
+		},
+		{
+			name:     "empty section requires empty",
+			arn:      "arn:aws:iam::444455556666:role/example",
+			pattern:  "arn:aws:iam:us-east-1:444455556666:role/example",
+			expected: false,
+		},
+		{
+			name:      "invalid ARN",
+			arn:       "invalid",
+			pattern:   "arn:aws:iam::*:role/*",
+			expectErr: true,
+		},
+		{
+			name:     "short pattern",
+			arn:      "arn:aws:iam::444455556666:role/example",
+			pattern:  "arn:aws:iam::*",
+			expected: true,
+		},
+		{
+			name:     "short pattern other service",
+			arn:      "arn:aws:s3:::bucket",
+			pattern:  "arn:aws:iam::*",
+			expected: false,
+		},
+		{
+			name:     "short pattern without wildcard",
+			arn:      "arn:aws:iam::444455556666:role/example",
+			pattern:  "arn:aws:iam",
+			expected: false,
+		},
+		{
+			name:     "short pattern wildcard spans remaining sections",
+			arn:      "arn:aws:iam::444455556666:role/example",
+			pattern:  "arn:aws:*",
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := iampolicy.ARNLike(testCase.arn, testCase.pattern)
+
+			if got, want := err != nil, testCase.expectErr; got != want {
+				t.Fatalf("ARNLike(%q, %q) err %t, want %t", testCase.arn, testCase.pattern, got, want)
+			}
+			if got != testCase.expected {
+				t.Errorf("ARNLike(%q, %q) = %t, want %t", testCase.arn, testCase.pattern, got, testCase.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/YakDriver/regexache"
+)
+
+// DifferenceKind is the kind of a structural difference between two JSON documents.
+type DifferenceKind string
+
+const (
+	DifferenceKindAdded   DifferenceKind = "added"
+	DifferenceKindRemoved DifferenceKind = "removed"
+	DifferenceKindChanged DifferenceKind = "changed"
+)
+
+// Difference is a single structural difference between two JSON documents.
+type Difference struct {
+	Path string // JSONPath of the value, e.g. `$.Statement[0].Effect`.
+	Kind DifferenceKind
+	Old  string // JSON encoding of the value in the first document. Empty if the value was added.
+	New  string // JSON encoding of the value in the second document. Empty if the value was removed.
+}
+
+func (d Difference) String() string {
+	switch d.Kind {
+	case DifferenceKindAdded:
+		return fmt.Sprintf("%s: added %s", d.Path, d.New)
+	case DifferenceKindRemoved:
+		return fmt.Sprintf("%s: removed %s", d.Path, d.Old)
+	default:
+		return fmt.Sprintf("%s: changed %s to %s", d.Path, d.Old, d.New)
+	}
+}
+
+// Diff returns the structural differences between two JSON documents, in document order.
+// Object keys are compared in sorted order. Array elements are aligned on their longest common subsequence,
+// so an element inserted into or removed from an array is reported once rather than as a change to every following element.
+func Diff(a, b string) ([]Difference, error) {
+	var v1 any
+	if err := json.Unmarshal([]byte(a), &v1); err != nil {
+		return nil, fmt.Errorf("decoding first JSON document: %w", err)
+	}
+
+	var v2 any
+	if err := json.Unmarshal([]byte(b), &v2); err != nil {
+		return nil, fmt.Errorf("decoding second JSON document: %w", err)
+	}
+
+	var diffs []Difference
+	diffValues("$", v1, v2, &diffs)
+
+	return diffs, nil
+}
+
+func diffValues(path string, v1, v2 any, diffs *[]Difference) {
+	switch v1 := v1.(type) {
+	case map[string]any:
+		if v2, ok := v2.(map[string]any); ok {
+			keys := make([]string, 0, len(v1)+len(v2))
+			for k := range v1 {
+				keys = append(keys, k)
+			}
+			for k := range v2 {
+				if _, ok := v1[k]; !ok {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys)
+
+			for _, k := range keys {
+				path := path + pathKey(k)
+				e1, ok1 := v1[k]
+				e2, ok2 := v2[k]
+
+				switch {
+				case !ok1:
+					*diffs = append(*diffs, Difference{Path: path, Kind: DifferenceKindAdded, New: encode(e2)})
+				case !ok2:
+					*diffs = append(*diffs, Difference{Path: path, Kind: DifferenceKindRemoved, Old: encode(e1)})
+				default:
+					diffValues(path, e1, e2, diffs)
+				}
+			}
+
+			return
+		}
+
+	case []any:
+		if v2, ok := v2.([]any); ok {
+			diffArrays(path, v1, v2, diffs)
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(v1, v2) {
+		*diffs = append(*diffs, Difference{Path: path, Kind: DifferenceKindChanged, Old: encode(v1), New: encode(v2)})
+	}
+}
+
+// diffArrays appends the differences between two arrays.
+// Unmatched elements between matching elements are compared pairwise; any excess is reported as added or removed.
+func diffArrays(path string, v1, v2 []any, diffs *[]Difference) {
+	// lcs[i][j] is the length of the longest common subsequence of v1[i:] and v2[j:].
+	lcs := make([][]int, len(v1)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(v2)+1)
+	}
+	for i := len(v1) - 1; i >= 0; i-- {
+		for j := len(v2) - 1; j >= 0; j-- {
+			if reflect.DeepEqual(v1[i], v2[j]) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var removed, added []int
+	flush := func() {
+		for k := 0; k < len(removed) || k < len(added); k++ {
+			switch {
+			case k >= len(removed):
+				*diffs = append(*diffs, Difference{Path: fmt.Sprintf("%s[%d]", path, added[k]), Kind: DifferenceKindAdded, New: encode(v2[added[k]])})
+			case k >= len(added):
+				*diffs = append(*diffs, Difference{Path: fmt.Sprintf("%s[%d]", path, removed[k]), Kind: DifferenceKindRemoved, Old: encode(v1[removed[k]])})
+			default:
+				diffValues(fmt.Sprintf("%s[%d]", path, removed[k]), v1[removed[k]], v2[added[k]], diffs)
+			}
+		}
+		removed, added = nil, nil
+	}
+
+	i, j := 0, 0
+	for i < len(v1) || j < len(v2) {
+		switch {
+		case i < len(v1) && j < len(v2) && reflect.DeepEqual(v1[i], v2[j]):
+			flush()
+			i++
+			j++
+		case j >= len(v2) || (i < len(v1) && lcs[i+1][j] >= lcs[i][j+1]):
+			removed = append(removed, i)
+			i++
+		default:
+			added = append(added, j)
+			j++
+		}
+	}
+	flush()
+}
+
+var identifierRegexp = regexache.MustCompile(`^[A-Za-z_][0-9A-Za-z_]*$`)
+
+// pathKey returns the JSONPath selector for an object key.
+func pathKey(k string) string {
+	if identifierRegexp.MatchString(k) {
+		return "." + k
+	}
+
+	return "[" + strconv.Quote(k) + "]"
+}
+
+func encode(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(b)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		a        string
+		b        string
+		want     []Difference
+		wantErr  bool
+	}{
+		{
+			testName: "equal",
+			a:        `{"a": 1, "b": [true, null]}`,
+			b:        `{"b":[true,null],"a":1.0}`,
+		},
+		{
+			testName: "changed scalar",
+			a:        `{"Statement":[{"Effect":"Allow"}]}`,
+			b:        `{"Statement":[{"Effect":"Deny"}]}`,
+			want: []Difference{
+				{Path: "$.Statement[0].Effect", Kind: DifferenceKindChanged, Old: `"Allow"`, New: `"Deny"`},
+			},
+		},
+		{
+			testName: "added and removed keys",
+			a:        `{"a":1,"b":{"c":2}}`,
+			b:        `{"a":1,"d":"x"}`,
+			want: []Difference{
+				{Path: "$.b", Kind: DifferenceKindRemoved, Old: `{"c":2}`},
+				{Path: "$.d", Kind: DifferenceKindAdded, New: `"x"`},
+			},
+		},
+		{
+			testName: "array lengths",
+			a:        `[1,2]`,
+			b:        `[1,2,3]`,
+			want: []Difference{
+				{Path: "$[2]", Kind: DifferenceKindAdded, New: `3`},
+			},
+		},
+		{
+			testName: "array insertion",
+			a:        `["b","c"]`,
+			b:        `["a","b","c"]`,
+			want: []Difference{
+				{Path: "$[0]", Kind: DifferenceKindAdded, New: `"a"`},
+			},
+		},
+		{
+			testName: "array element changed",
+			a:        `[{"Sid":"1","Effect":"Allow"},{"Sid":"2"}]`,
+			b:        `[{"Sid":"1","Effect":"Deny"},{"Sid":"2"}]`,
+			want: []Difference{
+				{Path: "$[0].Effect", Kind: DifferenceKindChanged, Old: `"Allow"`, New: `"Deny"`},
+			},
+		},
+		{
+			testName: "changed type",
+			a:        `{"Action":"s3:GetObject"}`,
+			b:        `{"Action":["s3:GetObject"]}`,
+			want: []Difference{
+				{Path: "$.Action", Kind: DifferenceKindChanged, Old: `"s3:GetObject"`, New: `["s3:GetObject"]`},
+			},
+		},
+		{
+			testName: "non-identifier key",
+			a:        `{"aws:SourceArn":"a"}`,
+			b:        `{"aws:SourceArn":"b"}`,
+			want: []Difference{
+				{Path: `$["aws:SourceArn"]`, Kind: DifferenceKindChanged, Old: `"a"`, New: `"b"`},
+			},
+		},
+		{
+			testName: "invalid JSON",
+			a:        `{`,
+			b:        `{}`,
+			wantErr:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Diff(testCase.a, testCase.b)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("Diff(%q, %q) err = %v, want error = %t", testCase.a, testCase.b, err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDifferenceString(t *testing.T) {
+	t.Parallel()
+
+	d := Difference{Path: "$.a", Kind: DifferenceKindChanged, Old: "1", New: "2"}
+
+	if got, want := d.String(), "$.a: changed 1 to 2"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
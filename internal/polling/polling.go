@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package polling
+
+import (
+	"sync/atomic"
+)
+
+// delaysDisabled is set when waits between polls are to be skipped.
+var delaysDisabled atomic.Bool
+
+// SetDelaysDisabled controls whether waiters and retry loops poll without delay.
+// This is intended for use when acceptance tests replay recorded AWS API interactions.
+func SetDelaysDisabled(disabled bool) {
+	delaysDisabled.Store(disabled)
+}
+
+// DelaysDisabled returns whether waits between polls are to be skipped.
+func DelaysDisabled() bool {
+	return delaysDisabled.Load()
+}
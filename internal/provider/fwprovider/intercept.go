@@ -15,9 +15,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -54,6 +56,8 @@ type resourceInterceptor interface {
 	update(context.Context, resource.UpdateRequest, *resource.UpdateResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 	// delete is invoke for a Delete call.
 	delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+	// modifyPlan is invoked for a ModifyPlan call, after any resource ModifyPlan method.
+	modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient, diag.Diagnostics) (context.Context, diag.Diagnostics)
 }
 
 type resourceInterceptors []resourceInterceptor
@@ -103,6 +107,11 @@ const (
 func interceptedHandler[Request resourceCRUDRequest, Response resourceCRUDResponse](interceptors []resourceInterceptorFunc[Request, Response], f func(context.Context, Request, *Response) diag.Diagnostics, meta *conns.AWSClient) func(context.Context, Request, *Response) diag.Diagnostics {
 	return func(ctx context.Context, request Request, response *Response) diag.Diagnostics {
 		var diags diag.Diagnostics
+		ctx, span := conns.StartSpan(ctx, operation(request))
+		defer func() {
+			tracing.EndSpan(span, fwdiag.DiagnosticsError(diags))
+		}()
+
 		// Before interceptors are run first to last.
 		forward := interceptors
 
@@ -138,6 +147,22 @@ func interceptedHandler[Request resourceCRUDRequest, Response resourceCRUDRespon
 	}
 }
 
+// operation returns the name of the CRUD operation for the specified request.
+func operation[Request resourceCRUDRequest](request Request) string {
+	switch any(request).(type) {
+	case resource.CreateRequest:
+		return "Create"
+	case resource.ReadRequest:
+		return "Read"
+	case resource.UpdateRequest:
+		return "Update"
+	case resource.DeleteRequest:
+		return "Delete"
+	default:
+		return fmt.Sprintf("%T", request)
+	}
+}
+
 // contextFunc augments Context.
 type contextFunc func(context.Context, *conns.AWSClient) context.Context
 
@@ -170,6 +195,11 @@ func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.Schem
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx, span := conns.StartSpan(ctx, "Read")
+	defer func() {
+		tracing.EndSpan(span, fwdiag.DiagnosticsError(response.Diagnostics))
+	}()
+
 	// TODO Run interceptors.
 	w.inner.Read(ctx, request, response)
 }
@@ -282,11 +312,18 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ModifyPlan(ctx, request, response)
 
-		return
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for _, v := range w.interceptors {
+		ctx, response.Diagnostics = v.modifyPlan(ctx, request, response, w.meta, response.Diagnostics)
 	}
 }
 
@@ -350,6 +387,13 @@ func (r tagsResourceInterceptor) create(ctx context.Context, request resource.Cr
 		// Remove system tags.
 		tags = tags.IgnoreSystem(inContext.ServicePackageName)
 
+		// Enforce any provider configured tag_policy.
+		if err := meta.TagPolicyConfig.Validate(tags); err != nil {
+			diags.AddAttributeError(path.Root(names.AttrTags), "tags do not satisfy the provider tag_policy", err.Error())
+
+			return ctx, diags
+		}
+
 		tagsInContext.TagsIn = option.Some(tags)
 	case After:
 		// Set values for unknowns.
@@ -520,6 +564,13 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 		// Remove system tags.
 		tags = tags.IgnoreSystem(inContext.ServicePackageName)
 
+		// Enforce any provider configured tag_policy.
+		if err := meta.TagPolicyConfig.Validate(tags); err != nil {
+			diags.AddAttributeError(path.Root(names.AttrTags), "tags do not satisfy the provider tag_policy", err.Error())
+
+			return ctx, diags
+		}
+
 		tagsInContext.TagsIn = option.Some(tags)
 
 		var oldTagsAll, newTagsAll fwtypes.Map
@@ -589,3 +640,61 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if r.tags == nil || meta == nil || meta.TagPolicyConfig == nil {
+		return ctx, diags
+	}
+
+	// Nothing to enforce if the resource is being destroyed.
+	if request.Plan.Raw.IsNull() {
+		return ctx, diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	var configTags fwtypes.Map
+	diags.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrTags), &configTags)...)
+
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	// The set of configured tag keys is not yet known.
+	if configTags.IsUnknown() {
+		return ctx, diags
+	}
+
+	// Tags whose value is not yet known have a nil value.
+	tags := make(tftags.KeyValueTags)
+	for k, v := range configTags.Elements() {
+		v, ok := v.(fwtypes.String)
+		if !ok || v.IsNull() {
+			continue
+		}
+
+		tags[k] = &tftags.TagData{}
+		if !v.IsUnknown() {
+			tags[k].Value = v.ValueStringPointer()
+		}
+	}
+
+	// Merge the resource's configured tags with any provider configured default_tags.
+	tags = tagsInContext.DefaultConfig.MergeTags(tags)
+	// Remove system tags.
+	tags = tags.IgnoreSystem(inContext.ServicePackageName)
+
+	if err := meta.TagPolicyConfig.Validate(tags); err != nil {
+		diags.AddAttributeError(path.Root(names.AttrTags), "tags do not satisfy the provider tag_policy", err.Error())
+	}
+
+	return ctx, diags
+}
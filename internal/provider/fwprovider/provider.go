@@ -60,6 +60,14 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
 			},
+			"dry_run": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Record mutating AWS API calls to `dry_run_file` instead of executing them. Read-only AWS API calls are executed.",
+			},
+			"dry_run_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path of the file to which mutating AWS API calls are recorded in dry run mode. Defaults to `terraform-provider-aws-dry-run.jsonl`.",
+			},
 			"ec2_metadata_service_endpoint": schema.StringAttribute{
 				Optional:    true,
 				Description: "Address of the EC2 metadata service endpoint to use. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
@@ -252,6 +260,21 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"concurrency_limits": schema.SetNestedBlock{
+				Description: "Configuration block with settings to limit the number of concurrent mutating API calls to an AWS service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max": schema.Int64Attribute{
+							Required:    true,
+							Description: "The maximum number of concurrent mutating API calls to the service.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, e.g. `route53`. Use the names from the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
 			"default_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -288,6 +311,113 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"rate_limits": schema.SetNestedBlock{
+				Description: "Configuration block with settings to limit the rate of API requests to an AWS service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of requests that can be made at once. Defaults to `requests_per_second`, rounded up.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The maximum sustained number of API requests per second to the service. Defaults to the service's default rate limit.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, e.g. `route53`. Use the names from the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
+			"tag_key_normalization": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to canonicalize resource tag keys across all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"ignore_case": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Canonicalize resource tag keys that differ from a canonical key, alias or default tag key only in case.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"alias": schema.SetNestedBlock{
+							Description: "Configuration block with settings to canonicalize alternative spellings of a resource tag key.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"aliases": schema.SetAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Alternative spellings of the resource tag key.",
+									},
+									"key": schema.StringAttribute{
+										Required:    true,
+										Description: "The canonical resource tag key.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to enforce resource tags across all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"required_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag keys that must be present, with a non-empty value, on all resources.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.SetNestedBlock{
+							Description: "Configuration block with settings to constrain the value of a resource tag.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "The values the resource tag may have.",
+									},
+									"key": schema.StringAttribute{
+										Required:    true,
+										Description: "The resource tag key the rule applies to.",
+									},
+									"pattern": schema.StringAttribute{
+										CustomType:  fwtypes.RegexpType,
+										Optional:    true,
+										Description: "A regular expression the resource tag value must match.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tracing": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to export OpenTelemetry traces of AWS API calls.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"endpoint": schema.StringAttribute{
+							Optional:    true,
+							Description: "The OTLP/HTTP traces endpoint to send spans to, e.g. `http://localhost:4318/v1/traces`.",
+						},
+						"json_file": schema.StringAttribute{
+							Optional:    true,
+							Description: "The path of a file to append spans to, in the JSON format of the OpenTelemetry Go SDK's stdouttrace exporter.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -333,7 +463,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
@@ -404,7 +534,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
@@ -465,7 +595,15 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
+		tffunction.NewARNGlobFunction,
+		tffunction.NewARNMatchFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRAllocateFunction,
+		tffunction.NewCIDROverlapsFunction,
+		tffunction.NewIAMPolicyEvaluateFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewJSONDiffFunction,
+		tffunction.NewPolicyMergeFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -79,6 +81,21 @@ const (
 	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
 )
 
+func (w why) String() string {
+	switch w {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return fmt.Sprintf("why(%d)", w)
+	}
+}
+
 type interceptorItems []interceptorItem
 
 // why returns a slice of interceptors that run for the specified CRUD operation.
@@ -93,6 +110,11 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		var diags diag.Diagnostics
 		ctx = bootstrapContext(ctx, meta)
+		ctx, span := conns.StartSpan(ctx, why.String())
+		defer func() {
+			tracing.EndSpan(span, sdkdiag.DiagnosticsError(diags))
+		}()
+
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
 
@@ -243,6 +265,11 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 			// Remove system tags.
 			tags = tags.IgnoreSystem(inContext.ServicePackageName)
 
+			// Enforce any provider configured tag_policy.
+			if err := meta.(*conns.AWSClient).TagPolicyConfig.Validate(tags); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "%s %s tags do not satisfy the provider tag_policy: %s", serviceName, resourceName, err)
+			}
+
 			tagsInContext.TagsIn = option.Some(tags)
 
 			if why == Create {
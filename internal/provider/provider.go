@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -25,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -51,6 +55,25 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"concurrency_limits": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Configuration block with settings to limit the number of concurrent mutating API calls to an AWS service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The maximum number of concurrent mutating API calls to the service.",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The service, e.g. `route53`. Use the names from the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -74,6 +97,17 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"dry_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Record mutating AWS API calls to `dry_run_file` instead of executing them. " +
+					"Read-only AWS API calls are executed.",
+			},
+			"dry_run_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path of the file to which mutating AWS API calls are recorded in dry run mode. Defaults to `terraform-provider-aws-dry-run.jsonl`.",
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -152,6 +186,30 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limits": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Configuration block with settings to limit the rate of API requests to an AWS service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burst": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The maximum number of requests that can be made at once. Defaults to `requests_per_second`, rounded up.",
+						},
+						"requests_per_second": {
+							Type:        schema.TypeFloat,
+							Optional:    true,
+							Description: "The maximum sustained number of API requests per second to the service. Defaults to the service's default rate limit.",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The service, e.g. `route53`. Use the names from the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -228,6 +286,83 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_key_normalization": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to canonicalize resource tag keys across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Configuration block with settings to canonicalize alternative spellings of a resource tag key.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"aliases": {
+										Type:        schema.TypeSet,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Alternative spellings of the resource tag key.",
+									},
+									"key": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The canonical resource tag key.",
+									},
+								},
+							},
+						},
+						"ignore_case": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Canonicalize resource tag keys that differ from a canonical key, alias or default tag key only in case.",
+						},
+					},
+				},
+			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to enforce resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag keys that must be present, with a non-empty value, on all resources.",
+						},
+						"rule": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Configuration block with settings to constrain the value of a resource tag.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_values": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "The values the resource tag may have.",
+									},
+									"key": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The resource tag key the rule applies to.",
+									},
+									"pattern": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "A regular expression the resource tag value must match.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -239,6 +374,26 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:    true,
 				Description: "The capacity of the AWS SDK's token bucket rate limiter.",
 			},
+			"tracing": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to export OpenTelemetry traces of AWS API calls.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The OTLP/HTTP traces endpoint to send spans to, e.g. `http://localhost:4318/v1/traces`.",
+						},
+						"json_file": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The path of a file to append spans to, in the JSON format of the OpenTelemetry Go SDK's stdouttrace exporter.",
+						},
+					},
+				},
+			},
 			"use_dualstack_endpoint": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -288,7 +443,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
@@ -365,7 +520,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
@@ -408,6 +563,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 						readFunc:   tagsReadFunc,
 					},
 				})
+
+				// Enforce any provider configured tag_policy at plan time.
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(v, tagsPolicyCustomizeDiff)
+				} else {
+					r.CustomizeDiff = tagsPolicyCustomizeDiff
+				}
 			}
 
 			rs := &wrappedResource{
@@ -532,10 +694,41 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		})
 	}
 
+	if v, ok := d.GetOk("concurrency_limits"); ok && v.(*schema.Set).Len() > 0 {
+		config.ConcurrencyLimits = expandConcurrencyLimits(ctx, v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("tag_key_normalization"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		keyNormalizationConfig, err := expandTagKeyNormalization(ctx, v.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if config.DefaultTagsConfig == nil {
+			config.DefaultTagsConfig = &tftags.DefaultConfig{}
+		}
+
+		// default_tags keys are canonical.
+		if tags := config.DefaultTagsConfig.Tags; tags != nil {
+			tags, err := tags.NormalizeKeys(keyNormalizationConfig)
+			if err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "default_tags: %s", err)
+			}
+
+			keyNormalizationConfig.AddCanonicalKeys(tags.Keys()...)
+			config.DefaultTagsConfig.Tags = tags
+		}
+
+		config.DefaultTagsConfig.KeyNormalization = keyNormalizationConfig
+	}
+
+	config.DryRun = d.Get("dry_run").(bool)
+	config.DryRunFile = d.Get("dry_run_file").(string)
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -571,6 +764,22 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("rate_limits"); ok && v.(*schema.Set).Len() > 0 {
+		config.RateLimits = expandRateLimits(ctx, v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tagPolicyConfig, err := expandTagPolicy(ctx, v.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.TagPolicyConfig = tagPolicyConfig
+	}
+
+	if v, ok := d.GetOk("tracing"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.Tracing = expandTracing(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -838,6 +1047,23 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]interfa
 	return &assumeRole
 }
 
+func expandConcurrencyLimits(_ context.Context, tfList []interface{}) map[string]int {
+	limits := make(map[string]int)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if service, ok := tfMap["service"].(string); ok && service != "" {
+			limits[service] = tfMap["max"].(int)
+		}
+	}
+
+	return limits
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftags.DefaultConfig {
 	if tfMap == nil {
 		return nil
@@ -870,6 +1096,114 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandTagKeyNormalization(_ context.Context, tfMap map[string]interface{}) (*tftags.KeyNormalizationConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	aliases := make(map[string][]string)
+
+	if v, ok := tfMap["alias"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			key := tfMap["key"].(string)
+			aliases[key] = append(aliases[key], flex.ExpandStringValueSet(tfMap["aliases"].(*schema.Set))...)
+		}
+	}
+
+	keyNormalizationConfig, err := tftags.NewKeyNormalizationConfig(aliases, tfMap["ignore_case"].(bool))
+	if err != nil {
+		return nil, fmt.Errorf("tag_key_normalization: %w", err)
+	}
+
+	return keyNormalizationConfig, nil
+}
+
+func expandTagPolicy(_ context.Context, tfMap map[string]interface{}) (*tftags.PolicyConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.RequiredKeys = flex.ExpandStringValueSet(v)
+		slices.Sort(policyConfig.RequiredKeys)
+	}
+
+	if v, ok := tfMap["rule"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			rule := tftags.PolicyRule{
+				Key: tfMap["key"].(string),
+			}
+
+			if v, ok := tfMap["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+				rule.AllowedValues = flex.ExpandStringValueSet(v)
+				slices.Sort(rule.AllowedValues)
+			}
+
+			if v, ok := tfMap["pattern"].(string); ok && v != "" {
+				pattern, err := regexp.Compile(v)
+				if err != nil {
+					return nil, fmt.Errorf("tag_policy rule (%s) pattern: %w", rule.Key, err)
+				}
+				rule.Pattern = pattern
+			}
+
+			policyConfig.Rules = append(policyConfig.Rules, rule)
+		}
+
+		slices.SortFunc(policyConfig.Rules, func(a, b tftags.PolicyRule) int {
+			return strings.Compare(a.Key, b.Key)
+		})
+	}
+
+	return policyConfig, nil
+}
+
+func expandRateLimits(_ context.Context, tfList []interface{}) map[string]conns.RateLimit {
+	limits := make(map[string]conns.RateLimit)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if service, ok := tfMap["service"].(string); ok && service != "" {
+			limits[service] = conns.RateLimit{
+				Burst:             tfMap["burst"].(int),
+				RequestsPerSecond: tfMap["requests_per_second"].(float64),
+			}
+		}
+	}
+
+	return limits
+}
+
+func expandTracing(_ context.Context, tfMap map[string]interface{}) *tracing.Config {
+	config := &tracing.Config{}
+
+	if v, ok := tfMap["endpoint"].(string); ok {
+		config.Endpoint = v
+	}
+
+	if v, ok := tfMap["json_file"].(string); ok {
+		config.JSONFile = v
+	}
+
+	return config
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
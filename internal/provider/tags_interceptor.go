@@ -5,10 +5,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -172,3 +175,66 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 
 	return ctx, diags
 }
+
+// tagsPolicyCustomizeDiff enforces any provider configured tag_policy at plan time.
+func tagsPolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	v, ok := meta.(*conns.AWSClient)
+	if !ok || v.TagPolicyConfig == nil {
+		return nil
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	configTags, ok := tagsFromRawConfig(ctx, d.GetRawConfig())
+	if !ok {
+		return nil
+	}
+
+	// Merge the resource's configured tags with any provider configured default_tags.
+	tags := tagsInContext.DefaultConfig.MergeTags(configTags)
+	// Remove system tags.
+	tags = tags.IgnoreSystem(inContext.ServicePackageName)
+
+	if err := v.TagPolicyConfig.Validate(tags); err != nil {
+		return fmt.Errorf("tags do not satisfy the provider tag_policy: %w", err)
+	}
+
+	return nil
+}
+
+// tagsFromRawConfig returns the tags configured for a resource.
+// Tags whose value is not yet known have a nil value.
+// Returns false if the set of configured tag keys is not yet known.
+func tagsFromRawConfig(ctx context.Context, config cty.Value) (tftags.KeyValueTags, bool) {
+	if config.IsNull() || !config.IsKnown() {
+		return nil, false
+	}
+
+	c := config.GetAttr(names.AttrTags)
+	if !c.IsKnown() {
+		return nil, false
+	}
+
+	configTags := make(map[string]interface{})
+	if !c.IsNull() {
+		for k, v := range c.AsValueMap() {
+			switch {
+			case v.IsNull():
+			case !v.IsKnown():
+				configTags[k] = nil
+			default:
+				configTags[k] = v.AsString()
+			}
+		}
+	}
+
+	return tftags.New(ctx, configTags), true
+}
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -87,7 +89,7 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
-		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
+		ctx = conns.NewResourceContext(ctx, "Test", "aws_test", "aws_test")
 		if v, ok := meta.(*conns.AWSClient); ok {
 			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 		}
@@ -107,6 +109,68 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}
 }
 
+func TestTagsFromRawConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		config   cty.Value
+		wantOK   bool
+		wantTags tftags.KeyValueTags
+	}{
+		{
+			name:   "null config",
+			config: cty.NullVal(cty.Object(map[string]cty.Type{"tags": cty.Map(cty.String)})),
+		},
+		{
+			name: "unknown tags",
+			config: cty.ObjectVal(map[string]cty.Value{
+				"tags": cty.UnknownVal(cty.Map(cty.String)),
+			}),
+		},
+		{
+			name: "null tags",
+			config: cty.ObjectVal(map[string]cty.Value{
+				"tags": cty.NullVal(cty.Map(cty.String)),
+			}),
+			wantOK:   true,
+			wantTags: tftags.KeyValueTags{},
+		},
+		{
+			name: "tags",
+			config: cty.ObjectVal(map[string]cty.Value{
+				"tags": cty.MapVal(map[string]cty.Value{
+					"tag1": cty.StringVal("value1"),
+					"tag2": cty.UnknownVal(cty.String),
+					"tag3": cty.NullVal(cty.String),
+				}),
+			}),
+			wantOK: true,
+			wantTags: tftags.KeyValueTags{
+				"tag1": &tftags.TagData{Value: aws.String("value1")},
+				"tag2": &tftags.TagData{},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotTags, gotOK := tagsFromRawConfig(context.Background(), testCase.config)
+
+			if gotOK != testCase.wantOK {
+				t.Fatalf("ok = %t, want %t", gotOK, testCase.wantOK)
+			}
+
+			if diff := cmp.Diff(gotTags, testCase.wantTags); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 type resourceData struct{}
 
 func (d *resourceData) GetRawConfig() cty.Value {
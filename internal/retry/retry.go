@@ -8,6 +8,8 @@ import (
 	"math"
 	"math/rand"
 	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/polling"
 )
 
 // Inspired by "github.com/ServiceWeaver/weaver/runtime/retry".
@@ -59,6 +61,10 @@ func (r *Retry) Reset() {
 }
 
 func (r *Retry) backoffDelay() time.Duration {
+	if polling.DelaysDisabled() {
+		return 0
+	}
+
 	mult := math.Pow(r.options.BackoffMultiplier, float64(r.attempt))
 	return time.Duration(float64(r.options.BackoffMinDuration) * mult)
 }
@@ -334,7 +334,7 @@ func waitWorkspaceCreated(ctx context.Context, conn *amp.Client, id string) (*ty
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.WorkspaceDescription); ok {
 		return output, err
@@ -354,7 +354,7 @@ func waitWorkspaceUpdated(ctx context.Context, conn *amp.Client, id string) (*ty
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.WorkspaceDescription); ok {
 		return output, err
@@ -374,7 +374,7 @@ func waitWorkspaceDeleted(ctx context.Context, conn *amp.Client, id string) (*ty
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.WorkspaceDescription); ok {
 		return output, err
@@ -435,7 +435,7 @@ func waitLoggingConfigurationCreated(ctx context.Context, conn *amp.Client, work
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.LoggingConfigurationMetadata); ok {
 		if statusCode := output.Status.StatusCode; statusCode == types.LoggingConfigurationStatusCodeCreationFailed {
@@ -459,7 +459,7 @@ func waitLoggingConfigurationUpdated(ctx context.Context, conn *amp.Client, work
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.LoggingConfigurationMetadata); ok {
 		if statusCode := output.Status.StatusCode; statusCode == types.LoggingConfigurationStatusCodeUpdateFailed {
@@ -483,7 +483,7 @@ func waitLoggingConfigurationDeleted(ctx context.Context, conn *amp.Client, work
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.LoggingConfigurationMetadata); ok {
 		return output, err
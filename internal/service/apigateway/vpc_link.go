@@ -244,7 +244,7 @@ func waitVPCLinkAvailable(ctx context.Context, conn *apigateway.Client, id strin
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*apigateway.GetVpcLinkOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -267,7 +267,7 @@ func waitVPCLinkDeleted(ctx context.Context, conn *apigateway.Client, id string)
 		Refresh:    vpcLinkStatus(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*apigateway.GetVpcLinkOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/applicationinsights"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -25,7 +26,7 @@ func waitApplicationCreated(ctx context.Context, conn *applicationinsights.Appli
 		Timeout: ApplicationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*applicationinsights.ApplicationInfo); ok {
 		return output, err
 	}
@@ -41,7 +42,7 @@ func waitApplicationTerminated(ctx context.Context, conn *applicationinsights.Ap
 		Timeout: ApplicationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*applicationinsights.ApplicationInfo); ok {
 		return output, err
 	}
@@ -225,7 +225,7 @@ func waitDeploymentSucceeded(ctx context.Context, conn *apprunner.Client, servic
 		NotFoundChecks: 30,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.OperationSummary); ok {
 		return output, err
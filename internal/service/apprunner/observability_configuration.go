@@ -229,7 +229,7 @@ func waitObservabilityConfigurationCreated(ctx context.Context, conn *apprunner.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ObservabilityConfiguration); ok {
 		return output, err
@@ -249,7 +249,7 @@ func waitObservabilityConfigurationDeleted(ctx context.Context, conn *apprunner.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ObservabilityConfiguration); ok {
 		return output, err
@@ -586,7 +586,7 @@ func waitSchemaCreated(ctx context.Context, conn *appsync.AppSync, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*appsync.GetSchemaCreationStatusOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Details)))
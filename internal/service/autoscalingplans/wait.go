@@ -31,7 +31,7 @@ func waitScalingPlanCreated(ctx context.Context, conn *autoscalingplans.Client,
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ScalingPlan); ok {
 		if output.StatusCode == awstypes.ScalingPlanStatusCodeCreationFailed {
@@ -53,7 +53,7 @@ func waitScalingPlanDeleted(ctx context.Context, conn *autoscalingplans.Client,
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ScalingPlan); ok {
 		if output.StatusCode == awstypes.ScalingPlanStatusCodeDeletionFailed {
@@ -75,7 +75,7 @@ func waitScalingPlanUpdated(ctx context.Context, conn *autoscalingplans.Client,
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ScalingPlan); ok {
 		if output.StatusCode == awstypes.ScalingPlanStatusCodeUpdateFailed {
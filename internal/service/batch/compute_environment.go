@@ -742,7 +742,7 @@ func waitComputeEnvironmentCreated(ctx context.Context, conn *batch.Batch, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*batch.ComputeEnvironmentDetail); ok {
 		if status := aws.StringValue(output.Status); status == batch.CEStatusInvalid {
@@ -763,7 +763,7 @@ func waitComputeEnvironmentDeleted(ctx context.Context, conn *batch.Batch, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*batch.ComputeEnvironmentDetail); ok {
 		if status := aws.StringValue(output.Status); status == batch.CEStatusInvalid {
@@ -784,7 +784,7 @@ func waitComputeEnvironmentDisabled(ctx context.Context, conn *batch.Batch, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*batch.ComputeEnvironmentDetail); ok {
 		if status := aws.StringValue(output.Status); status == batch.CEStatusInvalid {
@@ -805,7 +805,7 @@ func waitComputeEnvironmentUpdated(ctx context.Context, conn *batch.Batch, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if _, ok := outputRaw.(*batch.ComputeEnvironmentDetail); ok {
 		return err
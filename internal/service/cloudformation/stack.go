@@ -113,10 +113,11 @@ func ResourceStack() *schema.Resource {
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"template_body": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: verify.ValidStringIsJSONOrYAML,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     verify.ValidStringIsJSONOrYAML,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONOrYAMLDiffs,
 				StateFunc: func(v interface{}) string {
 					template, _ := verify.NormalizeJSONOrYAMLString(v)
 					return template
@@ -477,7 +478,7 @@ func WaitStackCreated(ctx context.Context, conn *cloudformation.CloudFormation,
 		Refresh:    statusStack(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -539,7 +540,7 @@ func WaitStackUpdated(ctx context.Context, conn *cloudformation.CloudFormation,
 		Refresh:    statusStack(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -580,7 +581,7 @@ func WaitStackDeleted(ctx context.Context, conn *cloudformation.CloudFormation,
 		Refresh:    statusStack(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -27,7 +27,7 @@ func WaitChangeSetCreated(ctx context.Context, conn *cloudformation.CloudFormati
 		Refresh: StatusChangeSet(ctx, conn, stackID, changeSetName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*cloudformation.DescribeChangeSetOutput); ok {
 		if status := aws.StringValue(output.Status); status == cloudformation.ChangeSetStatusFailed {
@@ -53,7 +53,7 @@ func WaitStackSetOperationSucceeded(ctx context.Context, conn *cloudformation.Cl
 		Delay:   stackSetOperationDelay,
 	}
 
-	outputRaw, waitErr := stateConf.WaitForStateContext(ctx)
+	outputRaw, waitErr := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudformation.StackSetOperation); ok {
 		if status := aws.StringValue(output.Status); status == cloudformation.StackSetOperationStatusFailed {
@@ -99,7 +99,7 @@ func WaitTypeRegistrationProgressStatusComplete(ctx context.Context, conn *cloud
 		Timeout: TypeRegistrationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudformation.DescribeTypeRegistrationOutput); ok {
 		return output, err
@@ -1153,7 +1153,7 @@ func WaitDistributionDeployed(ctx context.Context, conn *cloudfront.CloudFront,
 		Delay:      30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	return err
 }
 
@@ -1167,7 +1167,7 @@ func WaitDistributionDeleted(ctx context.Context, conn *cloudfront.CloudFront, i
 		Delay:      15 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	return err
 }
 
@@ -307,7 +307,7 @@ func waitKeyValueStoreCreated(ctx context.Context, conn *cloudfront.Client, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudfront.DescribeKeyValueStoreOutput); ok {
 		return output, err
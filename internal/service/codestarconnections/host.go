@@ -292,7 +292,7 @@ func waitHostPendingOrAvailable(ctx context.Context, conn *codestarconnections.C
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*codestarconnections.GetHostOutput); ok {
 		return output, err
@@ -651,7 +651,7 @@ func waitDocumentClassifierCreated(ctx context.Context, conn *comprehend.Client,
 		Timeout:      timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*types.DocumentClassifierProperties); ok {
 		if output.Status == types.ModelStatusInError {
 			tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
@@ -672,7 +672,7 @@ func waitDocumentClassifierStopped(ctx context.Context, conn *comprehend.Client,
 		Timeout:      timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*types.DocumentClassifierProperties); ok {
 		return out, err
 	}
@@ -691,7 +691,7 @@ func waitDocumentClassifierDeleted(ctx context.Context, conn *comprehend.Client,
 		Timeout:        timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*types.DocumentClassifierProperties); ok {
 		return out, err
 	}
@@ -401,7 +401,7 @@ func waitConfigRuleDeleted(ctx context.Context, conn *configservice.Client, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*types.ConfigRule); ok {
 		return v, err
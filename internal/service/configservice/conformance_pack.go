@@ -315,7 +315,7 @@ func waitConformancePackCreated(ctx context.Context, conn *configservice.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ConformancePackStatusDetail); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ConformancePackStatusReason)))
@@ -334,7 +334,7 @@ func waitConformancePackDeleted(ctx context.Context, conn *configservice.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ConformancePackStatusDetail); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ConformancePackStatusReason)))
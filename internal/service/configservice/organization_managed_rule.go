@@ -479,7 +479,7 @@ func waitOrganizationConfigRuleCreated(ctx context.Context, conn *configservice.
 		NotFoundChecks: 10,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.OrganizationConfigRuleStatus); ok {
 		tfresource.SetLastError(err, organizationConfigRuleStatusError(ctx, conn, output))
@@ -499,7 +499,7 @@ func waitOrganizationConfigRuleUpdated(ctx context.Context, conn *configservice.
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.OrganizationConfigRuleStatus); ok {
 		tfresource.SetLastError(err, organizationConfigRuleStatusError(ctx, conn, output))
@@ -520,7 +520,7 @@ func waitOrganizationConfigRuleDeleted(ctx context.Context, conn *configservice.
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.OrganizationConfigRuleStatus); ok {
 		tfresource.SetLastError(err, organizationConfigRuleStatusError(ctx, conn, output))
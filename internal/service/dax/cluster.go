@@ -296,7 +296,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	log.Printf("[DEBUG] Waiting for state to become available: %v", d.Id())
-	_, sterr := stateConf.WaitForStateContext(ctx)
+	_, sterr := tfresource.WaitForStateContext(ctx, stateConf)
 	if sterr != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for DAX cluster (%s) to be created: %s", d.Id(), sterr)
 	}
@@ -462,7 +462,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			Delay:      30 * time.Second,
 		}
 
-		_, sterr := stateConf.WaitForStateContext(ctx)
+		_, sterr := tfresource.WaitForStateContext(ctx, stateConf)
 		if sterr != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for DAX (%s) to update: %s", d.Id(), sterr)
 		}
@@ -541,7 +541,7 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 		Delay:      30 * time.Second,
 	}
 
-	_, sterr := stateConf.WaitForStateContext(ctx)
+	_, sterr := tfresource.WaitForStateContext(ctx, stateConf)
 	if sterr != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for DAX (%s) to delete: %s", d.Id(), sterr)
 	}
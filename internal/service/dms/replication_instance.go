@@ -411,7 +411,7 @@ func waitReplicationInstanceCreated(ctx context.Context, conn *dms.DatabaseMigra
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.ReplicationInstance); ok {
 		return output, err
@@ -430,7 +430,7 @@ func waitReplicationInstanceUpdated(ctx context.Context, conn *dms.DatabaseMigra
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.ReplicationInstance); ok {
 		return output, err
@@ -449,7 +449,7 @@ func waitReplicationInstanceDeleted(ctx context.Context, conn *dms.DatabaseMigra
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.ReplicationInstance); ok {
 		return output, err
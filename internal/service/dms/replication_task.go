@@ -413,7 +413,7 @@ func waitReplicationTaskDeleted(ctx context.Context, conn *dms.DatabaseMigration
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -433,7 +433,7 @@ func waitReplicationTaskModified(ctx context.Context, conn *dms.DatabaseMigratio
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -453,7 +453,7 @@ func waitReplicationTaskMoved(ctx context.Context, conn *dms.DatabaseMigrationSe
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -473,7 +473,7 @@ func waitReplicationTaskReady(ctx context.Context, conn *dms.DatabaseMigrationSe
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -496,7 +496,7 @@ func waitReplicationTaskRunning(ctx context.Context, conn *dms.DatabaseMigration
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -520,7 +520,7 @@ func waitReplicationTaskStopped(ctx context.Context, conn *dms.DatabaseMigration
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -544,7 +544,7 @@ func waitReplicationTaskSteady(ctx context.Context, conn *dms.DatabaseMigrationS
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dms.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
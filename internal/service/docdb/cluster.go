@@ -858,7 +858,7 @@ func waitDBClusterAvailable(ctx context.Context, conn *docdb.DocDB, id string, t
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*docdb.DBCluster); ok {
 		return output, err
@@ -882,7 +882,7 @@ func waitDBClusterDeleted(ctx context.Context, conn *docdb.DocDB, id string, tim
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*docdb.DBCluster); ok {
 		return output, err
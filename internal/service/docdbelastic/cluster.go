@@ -405,7 +405,7 @@ func waitClusterCreated(ctx context.Context, conn *docdbelastic.Client, id strin
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.Cluster); ok {
 		return out, err
 	}
@@ -423,7 +423,7 @@ func waitClusterUpdated(ctx context.Context, conn *docdbelastic.Client, id strin
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.Cluster); ok {
 		return out, err
 	}
@@ -439,7 +439,7 @@ func waitClusterDeleted(ctx context.Context, conn *docdbelastic.Client, id strin
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.Cluster); ok {
 		return out, err
 	}
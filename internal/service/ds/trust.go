@@ -504,7 +504,7 @@ func waitTrustCreated(ctx context.Context, conn directoryservice.DescribeTrustsA
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	// Wrap any error returned with waiting message
 	defer func() {
@@ -539,7 +539,7 @@ func waitTrustVerified(ctx context.Context, conn directoryservice.DescribeTrusts
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	// Wrap any error returned with waiting message
 	defer func() {
@@ -572,7 +572,7 @@ func waitTrustUpdated(ctx context.Context, conn directoryservice.DescribeTrustsA
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	// Wrap any error returned with waiting message
 	defer func() {
@@ -602,7 +602,7 @@ func waitTrustDeleted(ctx context.Context, conn directoryservice.DescribeTrustsA
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	// Wrap any error returned with waiting message
 	defer func() {
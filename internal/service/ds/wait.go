@@ -22,7 +22,7 @@ func waitDomainControllerCreated(ctx context.Context, conn *directoryservice.Dir
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directoryservice.DomainController); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
@@ -41,7 +41,7 @@ func waitDomainControllerDeleted(ctx context.Context, conn *directoryservice.Dir
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directoryservice.DomainController); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
@@ -60,7 +60,7 @@ func waitRadiusCompleted(ctx context.Context, conn *directoryservice.DirectorySe
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directoryservice.DirectoryDescription); ok {
 		return output, err
@@ -77,7 +77,7 @@ func waitRegionCreated(ctx context.Context, conn *directoryservice.DirectoryServ
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directoryservice.RegionDescription); ok {
 		return output, err
@@ -94,7 +94,7 @@ func waitRegionDeleted(ctx context.Context, conn *directoryservice.DirectoryServ
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directoryservice.RegionDescription); ok {
 		return output, err
@@ -120,7 +120,7 @@ func waitSharedDirectoryDeleted(ctx context.Context, conn *directoryservice.Dire
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directoryservice.SharedDirectory); ok {
 		return output, err
@@ -138,7 +138,7 @@ func waitDirectoryShared(ctx context.Context, conn *directoryservice.DirectorySe
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*directoryservice.SharedDirectory); ok {
 		return output, err
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -43,7 +44,7 @@ func waitTableActive(ctx context.Context, conn *dynamodb.DynamoDB, tableName str
 		Refresh: statusTable(ctx, conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
 		return output, err
@@ -60,7 +61,7 @@ func waitImportComplete(ctx context.Context, conn *dynamodb.DynamoDB, importArn
 		Refresh: statusImport(ctx, conn, importArn),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if err != nil {
 		err = fmt.Errorf("ImportArn %q : %w", importArn, err)
@@ -81,7 +82,7 @@ func waitTableDeleted(ctx context.Context, conn *dynamodb.DynamoDB, tableName st
 		Refresh: statusTable(ctx, conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
 		return output, err
@@ -98,7 +99,7 @@ func waitReplicaActive(ctx context.Context, conn *dynamodb.DynamoDB, tableName,
 		Refresh: statusReplicaUpdate(ctx, conn, tableName, region),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -116,7 +117,7 @@ func waitReplicaDeleted(ctx context.Context, conn *dynamodb.DynamoDB, tableName,
 		Refresh: statusReplicaDelete(ctx, conn, tableName, region),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -129,7 +130,7 @@ func waitGSIActive(ctx context.Context, conn *dynamodb.DynamoDB, tableName, inde
 		Refresh: statusGSI(ctx, conn, tableName, indexName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.GlobalSecondaryIndexDescription); ok {
 		return output, err
@@ -146,7 +147,7 @@ func waitGSIDeleted(ctx context.Context, conn *dynamodb.DynamoDB, tableName, ind
 		Refresh: statusGSI(ctx, conn, tableName, indexName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.GlobalSecondaryIndexDescription); ok {
 		return output, err
@@ -175,7 +176,7 @@ func waitPITRUpdated(ctx context.Context, conn *dynamodb.DynamoDB, tableName str
 		MinTimeout: 15 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.PointInTimeRecoveryDescription); ok {
 		return output, err
@@ -200,7 +201,7 @@ func waitTTLUpdated(ctx context.Context, conn *dynamodb.DynamoDB, tableName stri
 		Refresh: statusTTL(ctx, conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TimeToLiveDescription); ok {
 		return output, err
@@ -218,7 +219,7 @@ func waitSSEUpdated(ctx context.Context, conn *dynamodb.DynamoDB, tableName stri
 		Refresh: statusTableSES(ctx, conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
 		return output, err
@@ -238,7 +239,7 @@ func waitReplicaSSEUpdated(ctx context.Context, client *conns.AWSClient, region
 		Refresh: statusTableSES(ctx, conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
 		return output, err
@@ -255,7 +256,7 @@ func waitContributorInsightsCreated(ctx context.Context, conn *dynamodb.DynamoDB
 		Refresh: statusContributorInsights(ctx, conn, tableName, indexName),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -268,7 +269,7 @@ func waitContributorInsightsDeleted(ctx context.Context, conn *dynamodb.DynamoDB
 		Refresh: statusContributorInsights(ctx, conn, tableName, indexName),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -281,7 +282,7 @@ func waitTableExportCreated(ctx context.Context, conn *dynamodb.DynamoDB, id str
 		Timeout: maxDuration(createTableExportTimeout, timeout),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*dynamodb.ExportDescription); ok {
 		return out, err
 	}
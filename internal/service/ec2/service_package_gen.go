@@ -335,6 +335,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceSecurityGroup,
 			TypeName: "aws_security_group",
 		},
+		{
+			Factory:  DataSourceSecurityGroupEffectiveRules,
+			TypeName: "aws_security_group_effective_rules",
+		},
 		{
 			Factory:  DataSourceSecurityGroups,
 			TypeName: "aws_security_groups",
@@ -389,6 +393,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceVPCPeeringConnections,
 			TypeName: "aws_vpc_peering_connections",
 		},
+		{
+			Factory:  DataSourceVPCReachabilityAnalysis,
+			TypeName: "aws_vpc_reachability_analysis",
+		},
 		{
 			Factory:  DataSourceVPCs,
 			TypeName: "aws_vpcs",
@@ -14,13 +14,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func testAccTransitGatewayConnectPeer_basic(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayConnectPeer_basic(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v ec2.TransitGatewayConnectPeer
 	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
@@ -60,7 +60,7 @@ func testAccTransitGatewayConnectPeer_basic(t *testing.T, semaphore tfsync.Semap
 	})
 }
 
-func testAccTransitGatewayConnectPeer_disappears(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayConnectPeer_disappears(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v ec2.TransitGatewayConnectPeer
 	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
@@ -88,7 +88,7 @@ func testAccTransitGatewayConnectPeer_disappears(t *testing.T, semaphore tfsync.
 	})
 }
 
-func testAccTransitGatewayConnectPeer_bgpASN(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayConnectPeer_bgpASN(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v ec2.TransitGatewayConnectPeer
 	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
@@ -115,7 +115,7 @@ func testAccTransitGatewayConnectPeer_bgpASN(t *testing.T, semaphore tfsync.Sema
 	})
 }
 
-func testAccTransitGatewayConnectPeer_insideCIDRBlocks(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayConnectPeer_insideCIDRBlocks(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v ec2.TransitGatewayConnectPeer
 	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
@@ -149,7 +149,7 @@ func testAccTransitGatewayConnectPeer_insideCIDRBlocks(t *testing.T, semaphore t
 	})
 }
 
-func testAccTransitGatewayConnectPeer_tags(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayConnectPeer_tags(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v ec2.TransitGatewayConnectPeer
 	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
@@ -199,7 +199,7 @@ func testAccTransitGatewayConnectPeer_tags(t *testing.T, semaphore tfsync.Semaph
 	})
 }
 
-func testAccTransitGatewayConnectPeer_TransitGatewayAddress(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayConnectPeer_TransitGatewayAddress(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v ec2.TransitGatewayConnectPeer
 	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
@@ -16,13 +16,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func testAccTransitGatewayConnect_basic(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayConnect_basic(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v ec2.TransitGatewayConnect
 	resourceName := "aws_ec2_transit_gateway_connect.test"
@@ -61,7 +61,7 @@ func testAccTransitGatewayConnect_basic(t *testing.T, semaphore tfsync.Semaphore
 	})
 }
 
-func testAccTransitGatewayConnect_disappears(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayConnect_disappears(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v ec2.TransitGatewayConnect
 	resourceName := "aws_ec2_transit_gateway_connect.test"
@@ -89,7 +89,7 @@ func testAccTransitGatewayConnect_disappears(t *testing.T, semaphore tfsync.Sema
 	})
 }
 
-func testAccTransitGatewayConnect_tags(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayConnect_tags(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v ec2.TransitGatewayConnect
 	resourceName := "aws_ec2_transit_gateway_connect.test"
@@ -139,7 +139,7 @@ func testAccTransitGatewayConnect_tags(t *testing.T, semaphore tfsync.Semaphore)
 	})
 }
 
-func testAccTransitGatewayConnect_TransitGatewayDefaultRouteTableAssociationAndPropagationDisabled(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayConnect_TransitGatewayDefaultRouteTableAssociationAndPropagationDisabled(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1 ec2.TransitGateway
 	var transitGatewayConnect1 ec2.TransitGatewayConnect
@@ -177,7 +177,7 @@ func testAccTransitGatewayConnect_TransitGatewayDefaultRouteTableAssociationAndP
 	})
 }
 
-func testAccTransitGatewayConnect_TransitGatewayDefaultRouteTableAssociation(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayConnect_TransitGatewayDefaultRouteTableAssociation(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2, transitGateway3 ec2.TransitGateway
 	var transitGatewayConnect1, transitGatewayConnect2, transitGatewayConnect3 ec2.TransitGatewayConnect
@@ -233,7 +233,7 @@ func testAccTransitGatewayConnect_TransitGatewayDefaultRouteTableAssociation(t *
 	})
 }
 
-func testAccTransitGatewayConnect_TransitGatewayDefaultRouteTablePropagation(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayConnect_TransitGatewayDefaultRouteTablePropagation(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2, transitGateway3 ec2.TransitGateway
 	var transitGatewayConnect1, transitGatewayConnect2, transitGatewayConnect3 ec2.TransitGatewayConnect
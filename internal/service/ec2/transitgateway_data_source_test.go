@@ -10,7 +10,7 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -18,7 +18,7 @@ func TestAccTransitGatewayDataSource_serial(t *testing.T) {
 	t.Parallel()
 
 	semaphore := tfsync.GetSemaphore("TransitGateway", "AWS_EC2_TRANSIT_GATEWAY_LIMIT", 5)
-	testCases := map[string]map[string]func(*testing.T, tfsync.Semaphore){
+	testCases := map[string]map[string]func(*testing.T, *tfsync.Semaphore){
 		"Attachment": {
 			"Filter": testAccTransitGatewayAttachmentDataSource_Filter,
 			"ID":     testAccTransitGatewayAttachmentDataSource_ID,
@@ -90,7 +90,7 @@ func TestAccTransitGatewayDataSource_serial(t *testing.T) {
 	acctest.RunLimitedConcurrencyTests2Levels(t, semaphore, testCases)
 }
 
-func testAccTransitGatewayDataSource_Filter(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayDataSource_Filter(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	dataSourceName := "data.aws_ec2_transit_gateway.test"
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -129,7 +129,7 @@ func testAccTransitGatewayDataSource_Filter(t *testing.T, semaphore tfsync.Semap
 	})
 }
 
-func testAccTransitGatewayDataSource_ID(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayDataSource_ID(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	dataSourceName := "data.aws_ec2_transit_gateway.test"
 	resourceName := "aws_ec2_transit_gateway.test"
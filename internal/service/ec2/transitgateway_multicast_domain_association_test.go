@@ -14,13 +14,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func testAccTransitGatewayMulticastDomainAssociation_basic(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayMulticastDomainAssociation_basic(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v ec2.TransitGatewayMulticastDomainAssociation
 	resourceName := "aws_ec2_transit_gateway_multicast_domain_association.test"
@@ -46,7 +46,7 @@ func testAccTransitGatewayMulticastDomainAssociation_basic(t *testing.T, semapho
 	})
 }
 
-func testAccTransitGatewayMulticastDomainAssociation_disappears(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayMulticastDomainAssociation_disappears(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v ec2.TransitGatewayMulticastDomainAssociation
 	resourceName := "aws_ec2_transit_gateway_multicast_domain_association.test"
@@ -74,7 +74,7 @@ func testAccTransitGatewayMulticastDomainAssociation_disappears(t *testing.T, se
 	})
 }
 
-func testAccTransitGatewayMulticastDomainAssociation_Disappears_domain(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayMulticastDomainAssociation_Disappears_domain(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v ec2.TransitGatewayMulticastDomainAssociation
 	resourceName := "aws_ec2_transit_gateway_multicast_domain_association.test"
@@ -103,7 +103,7 @@ func testAccTransitGatewayMulticastDomainAssociation_Disappears_domain(t *testin
 	})
 }
 
-func testAccTransitGatewayMulticastDomainAssociation_twoAssociations(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayMulticastDomainAssociation_twoAssociations(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v1, v2 ec2.TransitGatewayMulticastDomainAssociation
 	resource1Name := "aws_ec2_transit_gateway_multicast_domain_association.test1"
@@ -10,11 +10,11 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func testAccTransitGatewayMulticastDomainDataSource_Filter(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayMulticastDomainDataSource_Filter(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	dataSourceName := "data.aws_ec2_transit_gateway_multicast_domain.test"
 	resourceName := "aws_ec2_transit_gateway_multicast_domain.test"
@@ -49,7 +49,7 @@ func testAccTransitGatewayMulticastDomainDataSource_Filter(t *testing.T, semapho
 	})
 }
 
-func testAccTransitGatewayMulticastDomainDataSource_ID(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayMulticastDomainDataSource_ID(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	dataSourceName := "data.aws_ec2_transit_gateway_multicast_domain.test"
 	resourceName := "aws_ec2_transit_gateway_multicast_domain.test"
@@ -9,11 +9,11 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func testAccTransitGatewayPeeringAttachmentDataSource_Filter_sameAccount(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayPeeringAttachmentDataSource_Filter_sameAccount(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_ec2_transit_gateway_peering_attachment.test"
@@ -44,7 +44,7 @@ func testAccTransitGatewayPeeringAttachmentDataSource_Filter_sameAccount(t *test
 	})
 }
 
-func testAccTransitGatewayPeeringAttachmentDataSource_Filter_differentAccount(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayPeeringAttachmentDataSource_Filter_differentAccount(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_ec2_transit_gateway_peering_attachment.test"
@@ -76,7 +76,7 @@ func testAccTransitGatewayPeeringAttachmentDataSource_Filter_differentAccount(t
 	})
 }
 
-func testAccTransitGatewayPeeringAttachmentDataSource_ID_sameAccount(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayPeeringAttachmentDataSource_ID_sameAccount(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_ec2_transit_gateway_peering_attachment.test"
@@ -106,7 +106,7 @@ func testAccTransitGatewayPeeringAttachmentDataSource_ID_sameAccount(t *testing.
 	})
 }
 
-func testAccTransitGatewayPeeringAttachmentDataSource_ID_differentAccount(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayPeeringAttachmentDataSource_ID_differentAccount(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_ec2_transit_gateway_peering_attachment.test"
@@ -138,7 +138,7 @@ func testAccTransitGatewayPeeringAttachmentDataSource_ID_differentAccount(t *tes
 	})
 }
 
-func testAccTransitGatewayPeeringAttachmentDataSource_Tags(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayPeeringAttachmentDataSource_Tags(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_ec2_transit_gateway_peering_attachment.test"
@@ -17,13 +17,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func testAccTransitGatewayPolicyTable_basic(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayPolicyTable_basic(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGatewayPolicyTable1 ec2.TransitGatewayPolicyTable
 	resourceName := "aws_ec2_transit_gateway_policy_table.test"
@@ -59,7 +59,7 @@ func testAccTransitGatewayPolicyTable_basic(t *testing.T, semaphore tfsync.Semap
 	})
 }
 
-func testAccTransitGatewayPolicyTable_disappears(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayPolicyTable_disappears(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGatewayPolicyTable1 ec2.TransitGatewayPolicyTable
 	resourceName := "aws_ec2_transit_gateway_policy_table.test"
@@ -87,7 +87,7 @@ func testAccTransitGatewayPolicyTable_disappears(t *testing.T, semaphore tfsync.
 	})
 }
 
-func testAccTransitGatewayPolicyTable_disappears_TransitGateway(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayPolicyTable_disappears_TransitGateway(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1 ec2.TransitGateway
 	var transitGatewayPolicyTable1 ec2.TransitGatewayPolicyTable
@@ -118,7 +118,7 @@ func testAccTransitGatewayPolicyTable_disappears_TransitGateway(t *testing.T, se
 	})
 }
 
-func testAccTransitGatewayPolicyTable_tags(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayPolicyTable_tags(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGatewayPolicyTable1, transitGatewayPolicyTable2, transitGatewayPolicyTable3 ec2.TransitGatewayPolicyTable
 	resourceName := "aws_ec2_transit_gateway_policy_table.test"
@@ -13,13 +13,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func testAccTransitGatewayPrefixListReference_basic(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayPrefixListReference_basic(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	managedPrefixListResourceName := "aws_ec2_managed_prefix_list.test"
 	resourceName := "aws_ec2_transit_gateway_prefix_list_reference.test"
@@ -57,7 +57,7 @@ func testAccTransitGatewayPrefixListReference_basic(t *testing.T, semaphore tfsy
 	})
 }
 
-func testAccTransitGatewayPrefixListReference_disappears(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayPrefixListReference_disappears(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ec2_transit_gateway_prefix_list_reference.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -85,7 +85,7 @@ func testAccTransitGatewayPrefixListReference_disappears(t *testing.T, semaphore
 	})
 }
 
-func testAccTransitGatewayPrefixListReference_disappears_TransitGateway(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayPrefixListReference_disappears_TransitGateway(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ec2_transit_gateway_prefix_list_reference.test"
 	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
@@ -114,7 +114,7 @@ func testAccTransitGatewayPrefixListReference_disappears_TransitGateway(t *testi
 	})
 }
 
-func testAccTransitGatewayPrefixListReference_TransitGatewayAttachmentID(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayPrefixListReference_TransitGatewayAttachmentID(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ec2_transit_gateway_prefix_list_reference.test"
 	transitGatewayVpcAttachmentResourceName1 := "aws_ec2_transit_gateway_vpc_attachment.test.0"
@@ -17,13 +17,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func testAccTransitGatewayRouteTable_basic(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayRouteTable_basic(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
 	resourceName := "aws_ec2_transit_gateway_route_table.test"
@@ -60,7 +60,7 @@ func testAccTransitGatewayRouteTable_basic(t *testing.T, semaphore tfsync.Semaph
 	})
 }
 
-func testAccTransitGatewayRouteTable_disappears(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayRouteTable_disappears(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
 	resourceName := "aws_ec2_transit_gateway_route_table.test"
@@ -88,7 +88,7 @@ func testAccTransitGatewayRouteTable_disappears(t *testing.T, semaphore tfsync.S
 	})
 }
 
-func testAccTransitGatewayRouteTable_disappears_TransitGateway(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayRouteTable_disappears_TransitGateway(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1 ec2.TransitGateway
 	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
@@ -119,7 +119,7 @@ func testAccTransitGatewayRouteTable_disappears_TransitGateway(t *testing.T, sem
 	})
 }
 
-func testAccTransitGatewayRouteTable_tags(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayRouteTable_tags(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGatewayRouteTable1, transitGatewayRouteTable2, transitGatewayRouteTable3 ec2.TransitGatewayRouteTable
 	resourceName := "aws_ec2_transit_gateway_route_table.test"
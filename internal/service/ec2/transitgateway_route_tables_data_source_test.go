@@ -10,11 +10,11 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func testAccTransitGatewayRouteTablesDataSource_basic(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayRouteTablesDataSource_basic(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_ec2_transit_gateway_route_tables.test"
@@ -38,7 +38,7 @@ func testAccTransitGatewayRouteTablesDataSource_basic(t *testing.T, semaphore tf
 	})
 }
 
-func testAccTransitGatewayRouteTablesDataSource_filter(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayRouteTablesDataSource_filter(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_ec2_transit_gateway_route_tables.test"
@@ -62,7 +62,7 @@ func testAccTransitGatewayRouteTablesDataSource_filter(t *testing.T, semaphore t
 	})
 }
 
-func testAccTransitGatewayRouteTablesDataSource_tags(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayRouteTablesDataSource_tags(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_ec2_transit_gateway_route_tables.test"
@@ -86,7 +86,7 @@ func testAccTransitGatewayRouteTablesDataSource_tags(t *testing.T, semaphore tfs
 	})
 }
 
-func testAccTransitGatewayRouteTablesDataSource_empty(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGatewayRouteTablesDataSource_empty(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_ec2_transit_gateway_route_tables.test"
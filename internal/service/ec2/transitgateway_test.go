@@ -18,8 +18,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -28,7 +28,7 @@ func TestAccTransitGateway_serial(t *testing.T) {
 	t.Parallel()
 
 	semaphore := tfsync.GetSemaphore("TransitGateway", "AWS_EC2_TRANSIT_GATEWAY_LIMIT", 5)
-	testCases := map[string]map[string]func(*testing.T, tfsync.Semaphore){
+	testCases := map[string]map[string]func(*testing.T, *tfsync.Semaphore){
 		"Connect": {
 			"basic":      testAccTransitGatewayConnect_basic,
 			"disappears": testAccTransitGatewayConnect_disappears,
@@ -154,11 +154,11 @@ func TestAccTransitGateway_serial(t *testing.T) {
 	acctest.RunLimitedConcurrencyTests2Levels(t, semaphore, testCases)
 }
 
-func testAccPreCheckTransitGatewaySynchronize(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccPreCheckTransitGatewaySynchronize(t *testing.T, semaphore *tfsync.Semaphore) {
 	tfsync.TestAccPreCheckSyncronize(t, semaphore, "TransitGateway")
 }
 
-func testAccTransitGateway_basic(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_basic(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1 ec2.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -201,7 +201,7 @@ func testAccTransitGateway_basic(t *testing.T, semaphore tfsync.Semaphore) {
 	})
 }
 
-func testAccTransitGateway_disappears(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_disappears(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1 ec2.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -228,7 +228,7 @@ func testAccTransitGateway_disappears(t *testing.T, semaphore tfsync.Semaphore)
 	})
 }
 
-func testAccTransitGateway_AmazonSideASN(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_AmazonSideASN(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2 ec2.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -268,7 +268,7 @@ func testAccTransitGateway_AmazonSideASN(t *testing.T, semaphore tfsync.Semaphor
 	})
 }
 
-func testAccTransitGateway_AutoAcceptSharedAttachments(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_AutoAcceptSharedAttachments(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2 ec2.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -308,7 +308,7 @@ func testAccTransitGateway_AutoAcceptSharedAttachments(t *testing.T, semaphore t
 	})
 }
 
-func testAccTransitGateway_cidrBlocks(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_cidrBlocks(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v1, v2, v3 ec2.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -361,7 +361,7 @@ func testAccTransitGateway_cidrBlocks(t *testing.T, semaphore tfsync.Semaphore)
 	})
 }
 
-func testAccTransitGateway_DefaultRouteTableAssociationAndPropagationDisabled(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_DefaultRouteTableAssociationAndPropagationDisabled(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1 ec2.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -394,7 +394,7 @@ func testAccTransitGateway_DefaultRouteTableAssociationAndPropagationDisabled(t
 	})
 }
 
-func testAccTransitGateway_DefaultRouteTableAssociation(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_DefaultRouteTableAssociation(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2, transitGateway3 ec2.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -442,7 +442,7 @@ func testAccTransitGateway_DefaultRouteTableAssociation(t *testing.T, semaphore
 	})
 }
 
-func testAccTransitGateway_DefaultRouteTablePropagation(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_DefaultRouteTablePropagation(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2, transitGateway3 ec2.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -490,7 +490,7 @@ func testAccTransitGateway_DefaultRouteTablePropagation(t *testing.T, semaphore
 	})
 }
 
-func testAccTransitGateway_DNSSupport(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_DNSSupport(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2 ec2.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -530,7 +530,7 @@ func testAccTransitGateway_DNSSupport(t *testing.T, semaphore tfsync.Semaphore)
 	})
 }
 
-func testAccTransitGateway_VPNECMPSupport(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_VPNECMPSupport(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2 ec2.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -570,7 +570,7 @@ func testAccTransitGateway_VPNECMPSupport(t *testing.T, semaphore tfsync.Semapho
 	})
 }
 
-func testAccTransitGateway_Description(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_Description(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2 ec2.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -610,7 +610,7 @@ func testAccTransitGateway_Description(t *testing.T, semaphore tfsync.Semaphore)
 	})
 }
 
-func testAccTransitGateway_tags(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccTransitGateway_tags(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2, transitGateway3 ec2.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -12,13 +12,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func testAccVerifiedAccessInstanceTrustProviderAttachment_basic(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccVerifiedAccessInstanceTrustProviderAttachment_basic(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_verifiedaccess_instance_trust_provider_attachment.test"
 	instanceResourceName := "aws_verifiedaccess_instance.test"
@@ -51,7 +51,7 @@ func testAccVerifiedAccessInstanceTrustProviderAttachment_basic(t *testing.T, se
 	})
 }
 
-func testAccVerifiedAccessInstanceTrustProviderAttachment_disappears(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccVerifiedAccessInstanceTrustProviderAttachment_disappears(t *testing.T, semaphore *tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_verifiedaccess_instance_trust_provider_attachment.test"
 
@@ -7,14 +7,14 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 )
 
 func TestAccVerifiedAccess_serial(t *testing.T) {
 	t.Parallel()
 
 	semaphore := tfsync.GetSemaphore("VerifiedAccess", "AWS_EC2_VERIFIED_ACCESS_INSTANCE_LIMIT", 5)
-	testCases := map[string]map[string]func(*testing.T, tfsync.Semaphore){
+	testCases := map[string]map[string]func(*testing.T, *tfsync.Semaphore){
 		"Endpoint": {
 			"basic":            testAccVerifiedAccessEndpoint_basic,
 			"networkInterface": testAccVerifiedAccessEndpoint_networkInterface,
@@ -57,6 +57,6 @@ func TestAccVerifiedAccess_serial(t *testing.T) {
 	acctest.RunLimitedConcurrencyTests2Levels(t, semaphore, testCases)
 }
 
-func testAccPreCheckVerifiedAccessSynchronize(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccPreCheckVerifiedAccessSynchronize(t *testing.T, semaphore *tfsync.Semaphore) {
 	tfsync.TestAccPreCheckSyncronize(t, semaphore, "Verified Access")
 }
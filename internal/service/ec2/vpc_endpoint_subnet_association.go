@@ -70,7 +70,11 @@ func resourceVPCEndpointSubnetAssociationCreate(ctx context.Context, d *schema.R
 	// See https://github.com/hashicorp/terraform-provider-aws/issues/3382.
 	// Prevent concurrent subnet association requests and delay between requests.
 	mk := "vpc_endpoint_subnet_association_" + endpointID
-	conns.GlobalMutexKV.Lock(mk)
+	lockCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	if err := conns.GlobalMutexKV.LockContext(lockCtx, mk); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for lock on VPC Endpoint (%s): %s", endpointID, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mk)
 
 	c := &retry.StateChangeConf{
@@ -83,7 +87,7 @@ func resourceVPCEndpointSubnetAssociationCreate(ctx context.Context, d *schema.R
 			return output, "ok", err
 		},
 	}
-	_, err := c.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, c)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating VPC Endpoint Subnet Association (%s): %s", id, err)
@@ -68,9 +68,13 @@ func resourceManagedPrefixListEntryCreate(ctx context.Context, d *schema.Resourc
 		addPrefixListEntry.Description = aws.String(v.(string))
 	}
 
+	lockCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
 		mutexKey := fmt.Sprintf("vpc-managed-prefix-list-%s", plID)
-		conns.GlobalMutexKV.Lock(mutexKey)
+		if err := conns.GlobalMutexKV.LockContext(lockCtx, mutexKey); err != nil {
+			return nil, fmt.Errorf("waiting for lock on VPC Managed Prefix List (%s): %w", plID, err)
+		}
 		defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 		pl, err := FindManagedPrefixListByID(ctx, conn, plID)
@@ -145,9 +149,13 @@ func resourceManagedPrefixListEntryDelete(ctx context.Context, d *schema.Resourc
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	lockCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
 		mutexKey := fmt.Sprintf("vpc-managed-prefix-list-%s", plID)
-		conns.GlobalMutexKV.Lock(mutexKey)
+		if err := conns.GlobalMutexKV.LockContext(lockCtx, mutexKey); err != nil {
+			return nil, fmt.Errorf("waiting for lock on VPC Managed Prefix List (%s): %w", plID, err)
+		}
 		defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 		pl, err := FindManagedPrefixListByID(ctx, conn, plID)
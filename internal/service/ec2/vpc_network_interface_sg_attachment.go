@@ -59,7 +59,11 @@ func resourceNetworkInterfaceSGAttachmentCreate(ctx context.Context, d *schema.R
 	networkInterfaceID := d.Get("network_interface_id").(string)
 	sgID := d.Get("security_group_id").(string)
 	mutexKey := "network_interface_sg_attachment_" + networkInterfaceID
-	conns.GlobalMutexKV.Lock(mutexKey)
+	lockCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	if err := conns.GlobalMutexKV.LockContext(lockCtx, mutexKey); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for lock on EC2 Network Interface (%s): %s", networkInterfaceID, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	eni, err := FindNetworkInterfaceByID(ctx, conn, networkInterfaceID)
@@ -144,7 +148,11 @@ func resourceNetworkInterfaceSGAttachmentDelete(ctx context.Context, d *schema.R
 	networkInterfaceID := d.Get("network_interface_id").(string)
 	sgID := d.Get("security_group_id").(string)
 	mutexKey := "network_interface_sg_attachment_" + networkInterfaceID
-	conns.GlobalMutexKV.Lock(mutexKey)
+	lockCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	if err := conns.GlobalMutexKV.LockContext(lockCtx, mutexKey); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for lock on EC2 Network Interface (%s): %s", networkInterfaceID, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
 	eni, err := FindNetworkInterfaceByID(ctx, conn, networkInterfaceID)
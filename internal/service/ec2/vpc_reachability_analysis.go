@@ -0,0 +1,627 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	reachabilityComponentTypeNetworkACL    = "network_acl"
+	reachabilityComponentTypeRouteTable    = "route_table"
+	reachabilityComponentTypeSecurityGroup = "security_group"
+)
+
+// reachabilityEndpoint is the source or destination of traffic.
+// An endpoint without a subnet is outside the VPC.
+type reachabilityEndpoint struct {
+	ip               net.IP
+	securityGroupIDs []string
+	subnetID         string
+}
+
+// reachabilityQuery is the traffic whose reachability is analyzed.
+type reachabilityQuery struct {
+	source      reachabilityEndpoint
+	destination reachabilityEndpoint
+	protocol    string // "tcp", "udp", "icmp" or "icmpv6".
+	port        int64  // Destination port. For ICMP, the type.
+	sourcePort  int64  // Destination port of return traffic.
+}
+
+func (q reachabilityQuery) isIPv6() bool {
+	return q.source.ip.To4() == nil
+}
+
+// returnTrafficEvaluated returns whether the stateless parts of the path are evaluated for return traffic.
+// Only TCP and UDP replies are addressed to a known port.
+func (q reachabilityQuery) returnTrafficEvaluated() bool {
+	return q.protocol == "tcp" || q.protocol == "udp"
+}
+
+// reachabilityRoute is a route in the shape of aws_route_table's route blocks.
+type reachabilityRoute struct {
+	tfMap     map[string]interface{}
+	blackhole bool
+}
+
+type reachabilityRouteTable struct {
+	id        string
+	main      bool
+	subnetIDs []string
+	routes    []reachabilityRoute
+}
+
+type reachabilityNetworkACL struct {
+	id        string
+	subnetIDs []string
+	entries   []*ec2.NetworkAclEntry
+}
+
+// reachabilityConfiguration is the VPC configuration that reachability is analyzed over.
+type reachabilityConfiguration struct {
+	networkACLs    []*reachabilityNetworkACL
+	routeTables    []*reachabilityRouteTable
+	securityGroups map[string][]securityGroupRulePermission
+	subnetVPCIDs   map[string]string // VPC IDs keyed by subnet ID.
+}
+
+func newReachabilityConfiguration() *reachabilityConfiguration {
+	return &reachabilityConfiguration{
+		securityGroups: make(map[string][]securityGroupRulePermission),
+		subnetVPCIDs:   make(map[string]string),
+	}
+}
+
+// sameVPC returns whether two subnets are known to be in the same VPC.
+func (c *reachabilityConfiguration) sameVPC(subnetID1, subnetID2 string) bool {
+	if subnetID1 == "" || subnetID2 == "" {
+		return false
+	}
+
+	if subnetID1 == subnetID2 {
+		return true
+	}
+
+	vpcID := c.subnetVPCIDs[subnetID1]
+
+	return vpcID != "" && vpcID == c.subnetVPCIDs[subnetID2]
+}
+
+// routeTableForSubnet returns the route table explicitly associated with a subnet or, failing that, the main route table.
+func (c *reachabilityConfiguration) routeTableForSubnet(subnetID string) *reachabilityRouteTable {
+	var main *reachabilityRouteTable
+
+	for _, v := range c.routeTables {
+		for _, id := range v.subnetIDs {
+			if id == subnetID {
+				return v
+			}
+		}
+
+		if v.main && main == nil {
+			main = v
+		}
+	}
+
+	return main
+}
+
+func (c *reachabilityConfiguration) networkACLForSubnet(subnetID string) *reachabilityNetworkACL {
+	for _, v := range c.networkACLs {
+		for _, id := range v.subnetIDs {
+			if id == subnetID {
+				return v
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolve reads the parts of the configuration that q needs but that weren't specified.
+func (c *reachabilityConfiguration) resolve(ctx context.Context, conn *ec2.EC2, q reachabilityQuery) error {
+	// Traffic is routed by the source's subnet. If the source is outside the VPC, return traffic is routed by the destination's subnet.
+	routedSubnetID := q.source.subnetID
+	if routedSubnetID == "" {
+		routedSubnetID = q.destination.subnetID
+	}
+
+	// Traffic between subnets only uses the local route if they're in the same VPC.
+	if q.source.subnetID != "" && q.destination.subnetID != "" && q.source.subnetID != q.destination.subnetID {
+		for _, subnetID := range []string{q.source.subnetID, q.destination.subnetID} {
+			if _, ok := c.subnetVPCIDs[subnetID]; ok {
+				continue
+			}
+
+			subnet, err := FindSubnetByID(ctx, conn, subnetID)
+
+			if err != nil {
+				return fmt.Errorf("reading Subnet (%s): %w", subnetID, err)
+			}
+
+			c.subnetVPCIDs[subnetID] = aws.StringValue(subnet.VpcId)
+		}
+	}
+
+	for _, endpoint := range []reachabilityEndpoint{q.source, q.destination} {
+		if subnetID := endpoint.subnetID; subnetID != "" {
+			if subnetID == routedSubnetID && c.routeTableForSubnet(subnetID) == nil {
+				routeTable, err := findRouteTableForSubnet(ctx, conn, subnetID)
+
+				if err != nil {
+					return fmt.Errorf("reading Route Table for Subnet (%s): %w", subnetID, err)
+				}
+
+				c.routeTables = append(c.routeTables, &reachabilityRouteTable{
+					id:        aws.StringValue(routeTable.RouteTableId),
+					subnetIDs: []string{subnetID},
+					routes:    expandReachabilityRoutes(routeTable.Routes),
+				})
+			}
+
+			if c.networkACLForSubnet(subnetID) == nil {
+				input := &ec2.DescribeNetworkAclsInput{
+					Filters: newAttributeFilterList(map[string]string{
+						"association.subnet-id": subnetID,
+					}),
+				}
+
+				networkACL, err := FindNetworkACL(ctx, conn, input)
+
+				if err != nil {
+					return fmt.Errorf("reading Network ACL for Subnet (%s): %w", subnetID, err)
+				}
+
+				c.networkACLs = append(c.networkACLs, &reachabilityNetworkACL{
+					id:        aws.StringValue(networkACL.NetworkAclId),
+					subnetIDs: []string{subnetID},
+					entries:   networkACL.Entries,
+				})
+			}
+		}
+
+		for _, groupID := range endpoint.securityGroupIDs {
+			if _, ok := c.securityGroups[groupID]; ok {
+				continue
+			}
+
+			rules, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, groupID)
+
+			if err != nil {
+				return fmt.Errorf("reading Security Group (%s) rules: %w", groupID, err)
+			}
+
+			permissions := make([]securityGroupRulePermission, 0, len(rules))
+			for _, v := range rules {
+				permissions = append(permissions, expandSecurityGroupRulePermissionFromRule(v))
+			}
+
+			c.securityGroups[groupID] = permissions
+		}
+	}
+
+	return nil
+}
+
+// findRouteTableForSubnet returns the route table that routes a subnet's traffic.
+func findRouteTableForSubnet(ctx context.Context, conn *ec2.EC2, subnetID string) (*ec2.RouteTable, error) {
+	input := &ec2.DescribeRouteTablesInput{
+		Filters: newAttributeFilterList(map[string]string{
+			"association.subnet-id": subnetID,
+		}),
+	}
+
+	routeTable, err := FindRouteTable(ctx, conn, input)
+
+	if !tfresource.NotFound(err) {
+		return routeTable, err
+	}
+
+	// A subnet without an explicit association uses its VPC's main route table.
+	subnet, err := FindSubnetByID(ctx, conn, subnetID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return FindMainRouteTableByVPCID(ctx, conn, aws.StringValue(subnet.VpcId))
+}
+
+func expandReachabilityRoutes(apiObjects []*ec2.Route) []reachabilityRoute {
+	var routes []reachabilityRoute
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		routes = append(routes, reachabilityRoute{
+			tfMap:     flattenRoute(apiObject),
+			blackhole: aws.StringValue(apiObject.State) == ec2.RouteStateBlackhole,
+		})
+	}
+
+	return routes
+}
+
+// reachabilityExplanation explains how one component of the path treats the traffic.
+type reachabilityExplanation struct {
+	componentType string
+	componentID   string
+	direction     string // "egress" or "ingress". Empty for route tables.
+	returnTraffic bool
+	allowed       bool
+	explanation   string
+}
+
+func (e reachabilityExplanation) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s %s", e.componentType, e.componentID)
+	if e.direction != "" {
+		fmt.Fprintf(&b, " %s", e.direction)
+	}
+	if e.returnTraffic {
+		b.WriteString(" (return traffic)")
+	}
+	fmt.Fprintf(&b, ": %s", e.explanation)
+
+	return b.String()
+}
+
+// analyzeReachability evaluates the security groups, route tables and network ACLs on the path from source to destination.
+// Traffic is reachable if every returned explanation allows it.
+//
+// If both endpoints are in subnets of the same VPC, traffic between them uses the VPC's local route.
+// Security groups are stateful, so return traffic is only evaluated against route tables and network ACLs.
+func analyzeReachability(c *reachabilityConfiguration, q reachabilityQuery) []reachabilityExplanation {
+	var explanations []reachabilityExplanation
+
+	source, destination := q.source, q.destination
+	sameVPC := c.sameVPC(source.subnetID, destination.subnetID)
+	// Network ACLs don't apply to traffic within a subnet.
+	sameSubnet := source.subnetID != "" && source.subnetID == destination.subnetID
+
+	if len(source.securityGroupIDs) > 0 {
+		explanations = append(explanations, evaluateReachabilitySecurityGroups(c, q, securityGroupRuleTypeEgress)...)
+	}
+
+	if source.subnetID != "" {
+		explanations = append(explanations, evaluateReachabilityRouteTable(c, source.subnetID, destination.ip, sameVPC, false))
+
+		if !sameSubnet {
+			explanations = append(explanations, evaluateReachabilityNetworkACL(c, q, source.subnetID, true, false))
+		}
+	}
+
+	if destination.subnetID != "" && !sameSubnet {
+		explanations = append(explanations, evaluateReachabilityNetworkACL(c, q, destination.subnetID, false, false))
+	}
+
+	if len(destination.securityGroupIDs) > 0 {
+		explanations = append(explanations, evaluateReachabilitySecurityGroups(c, q, securityGroupRuleTypeIngress)...)
+	}
+
+	// Return traffic.
+	if destination.subnetID != "" && source.subnetID == "" {
+		explanations = append(explanations, evaluateReachabilityRouteTable(c, destination.subnetID, source.ip, false, true))
+	}
+
+	if q.returnTrafficEvaluated() && !sameSubnet {
+		if destination.subnetID != "" {
+			explanations = append(explanations, evaluateReachabilityNetworkACL(c, q, destination.subnetID, true, true))
+		}
+
+		if source.subnetID != "" {
+			explanations = append(explanations, evaluateReachabilityNetworkACL(c, q, source.subnetID, false, true))
+		}
+	}
+
+	return explanations
+}
+
+// evaluateReachabilitySecurityGroups evaluates the source's security groups' egress rules or the destination's security groups' ingress rules.
+// Traffic is allowed if a rule in any of the endpoint's security groups allows it.
+func evaluateReachabilitySecurityGroups(c *reachabilityConfiguration, q reachabilityQuery, ruleType string) []reachabilityExplanation {
+	endpoint, peer := q.source, q.destination
+	if ruleType == securityGroupRuleTypeIngress {
+		endpoint, peer = q.destination, q.source
+	}
+
+	fromPort, toPort := q.port, q.port
+	if q.protocol == "icmp" || q.protocol == "icmpv6" {
+		// ICMP type and code 0.
+		toPort = 0
+	}
+
+	peerType, bits := "cidr_ipv4", 32
+	if q.isIPv6() {
+		peerType, bits = "cidr_ipv6", 128
+	}
+
+	// The traffic as a permission for each way a rule can match the peer.
+	traffic := []securityGroupRulePermission{
+		newSecurityGroupRulePermission(ruleType, q.protocol, fromPort, toPort, peerType, (&net.IPNet{IP: peer.ip, Mask: net.CIDRMask(bits, bits)}).String()),
+	}
+	for _, groupID := range peer.securityGroupIDs {
+		traffic = append(traffic, newSecurityGroupRulePermission(ruleType, q.protocol, fromPort, toPort, "referenced_security_group_id", groupID))
+	}
+
+	for _, groupID := range endpoint.securityGroupIDs {
+		for _, rule := range c.securityGroups[groupID] {
+			for _, v := range traffic {
+				if rule.covers(v) {
+					return []reachabilityExplanation{{
+						componentType: reachabilityComponentTypeSecurityGroup,
+						componentID:   groupID,
+						direction:     ruleType,
+						allowed:       true,
+						explanation:   fmt.Sprintf("rule %s allows the traffic", rule),
+					}}
+				}
+			}
+		}
+	}
+
+	var explanations []reachabilityExplanation
+
+	for _, groupID := range endpoint.securityGroupIDs {
+		explanation := fmt.Sprintf("no rule allows %s", traffic[0])
+
+		// Prefix lists aren't resolved, so rules that would otherwise match are pointed out.
+		var prefixListIDs []string
+		for _, rule := range c.securityGroups[groupID] {
+			if rule.peerType == "prefix_list_id" && rule.covers(newSecurityGroupRulePermission(ruleType, q.protocol, fromPort, toPort, rule.peerType, rule.peer)) {
+				prefixListIDs = append(prefixListIDs, rule.peer)
+			}
+		}
+		if len(prefixListIDs) > 0 {
+			explanation += fmt.Sprintf("; rules for prefix lists (%s) aren't evaluated", strings.Join(prefixListIDs, ", "))
+		}
+
+		explanations = append(explanations, reachabilityExplanation{
+			componentType: reachabilityComponentTypeSecurityGroup,
+			componentID:   groupID,
+			direction:     ruleType,
+			explanation:   explanation,
+		})
+	}
+
+	return explanations
+}
+
+// evaluateReachabilityRouteTable evaluates a subnet's route table for traffic to ip.
+// The most specific route that matches ip is used.
+func evaluateReachabilityRouteTable(c *reachabilityConfiguration, subnetID string, ip net.IP, local, returnTraffic bool) reachabilityExplanation {
+	explanation := reachabilityExplanation{
+		componentType: reachabilityComponentTypeRouteTable,
+		returnTraffic: returnTraffic,
+	}
+
+	routeTable := c.routeTableForSubnet(subnetID)
+
+	if routeTable == nil {
+		explanation.explanation = fmt.Sprintf("no route table is associated with subnet %s", subnetID)
+		return explanation
+	}
+
+	explanation.componentID = routeTable.id
+
+	if local {
+		explanation.allowed = true
+		explanation.explanation = "the local route routes the traffic within the VPC"
+		return explanation
+	}
+
+	var match *reachabilityRoute
+	matchOnes := -1
+	var prefixListIDs []string
+
+	for i, route := range routeTable.routes {
+		key, destination := routeTableRouteDestinationAttribute(route.tfMap)
+
+		if key == "destination_prefix_list_id" {
+			prefixListIDs = append(prefixListIDs, destination)
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(destination)
+
+		if err != nil || !ipNet.Contains(ip) {
+			continue
+		}
+
+		if ones, _ := ipNet.Mask.Size(); ones > matchOnes {
+			match, matchOnes = &routeTable.routes[i], ones
+		}
+	}
+
+	if match == nil {
+		explanation.explanation = fmt.Sprintf("no route matches %s", ip)
+		if len(prefixListIDs) > 0 {
+			explanation.explanation += fmt.Sprintf("; routes to prefix lists (%s) aren't evaluated", strings.Join(prefixListIDs, ", "))
+		}
+		return explanation
+	}
+
+	_, destination := routeTableRouteDestinationAttribute(match.tfMap)
+	_, target := routeTableRouteTargetAttribute(match.tfMap)
+
+	switch {
+	case match.blackhole:
+		explanation.explanation = fmt.Sprintf("route %s to %s is a blackhole", destination, target)
+	case target == gatewayIDLocal:
+		explanation.allowed = true
+		explanation.explanation = fmt.Sprintf("local route %s routes the traffic within the VPC", destination)
+	default:
+		explanation.allowed = true
+		explanation.explanation = fmt.Sprintf("route %s routes the traffic to %s", destination, target)
+	}
+
+	return explanation
+}
+
+// evaluateReachabilityNetworkACL evaluates a subnet's network ACL for traffic leaving (egress) or entering the subnet.
+// Rules are evaluated in rule number order and the first matching rule allows or denies the traffic.
+func evaluateReachabilityNetworkACL(c *reachabilityConfiguration, q reachabilityQuery, subnetID string, egress, returnTraffic bool) reachabilityExplanation {
+	explanation := reachabilityExplanation{
+		componentType: reachabilityComponentTypeNetworkACL,
+		direction:     securityGroupRuleTypeIngress,
+		returnTraffic: returnTraffic,
+	}
+	if egress {
+		explanation.direction = securityGroupRuleTypeEgress
+	}
+
+	networkACL := c.networkACLForSubnet(subnetID)
+
+	if networkACL == nil {
+		explanation.explanation = fmt.Sprintf("no network ACL is associated with subnet %s", subnetID)
+		return explanation
+	}
+
+	explanation.componentID = networkACL.id
+
+	packetSource, packetDestination, port := q.source.ip, q.destination.ip, q.port
+	if returnTraffic {
+		packetSource, packetDestination, port = q.destination.ip, q.source.ip, q.sourcePort
+	}
+
+	// Egress rules match a packet's destination and ingress rules its source.
+	peer := packetSource
+	if egress {
+		peer = packetDestination
+	}
+
+	protocolNumber := securityGroupProtocolIntegers[q.protocol]
+
+	var entries []*ec2.NetworkAclEntry
+	for _, v := range networkACL.entries {
+		if aws.BoolValue(v.Egress) == egress {
+			entries = append(entries, v)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return aws.Int64Value(entries[i].RuleNumber) < aws.Int64Value(entries[j].RuleNumber)
+	})
+
+	for _, v := range entries {
+		if !networkACLEntryMatches(v, protocolNumber, peer, port) {
+			continue
+		}
+
+		action := "denies"
+		if aws.StringValue(v.RuleAction) == ec2.RuleActionAllow {
+			explanation.allowed = true
+			action = "allows"
+		}
+		explanation.explanation = fmt.Sprintf("rule %s %s %s", networkACLRuleNumberString(aws.Int64Value(v.RuleNumber)), action, describeNetworkACLEntry(v))
+
+		return explanation
+	}
+
+	explanation.explanation = "no rule matches; rule * denies the traffic"
+
+	return explanation
+}
+
+// networkACLEntryMatches returns whether a network ACL entry matches traffic to or from peer.
+// port is the packet's destination port or, for ICMP, its type. ICMP code 0 is assumed.
+func networkACLEntryMatches(apiObject *ec2.NetworkAclEntry, protocolNumber int, peer net.IP, port int64) bool {
+	cidrBlock := aws.StringValue(apiObject.CidrBlock)
+	if peer.To4() == nil {
+		cidrBlock = aws.StringValue(apiObject.Ipv6CidrBlock)
+	}
+
+	if _, ipNet, err := net.ParseCIDR(cidrBlock); err != nil || !ipNet.Contains(peer) {
+		return false
+	}
+
+	protocol := aws.StringValue(apiObject.Protocol)
+
+	if protocol == "-1" {
+		return true
+	}
+
+	if protocol != strconv.Itoa(protocolNumber) {
+		return false
+	}
+
+	switch protocolNumber {
+	case 6, 17:
+		if v := apiObject.PortRange; v != nil {
+			return aws.Int64Value(v.From) <= port && port <= aws.Int64Value(v.To)
+		}
+	case 1, 58:
+		if v := apiObject.IcmpTypeCode; v != nil {
+			icmpType, icmpCode := aws.Int64Value(v.Type), aws.Int64Value(v.Code)
+			return (icmpType == -1 || icmpType == port) && (icmpCode == -1 || icmpCode == 0)
+		}
+	}
+
+	return true
+}
+
+// describeNetworkACLEntry describes the traffic a network ACL entry matches, e.g. "tcp ports 1024-65535 from 0.0.0.0/0".
+func describeNetworkACLEntry(apiObject *ec2.NetworkAclEntry) string {
+	protocol := aws.StringValue(apiObject.Protocol)
+	if v, err := strconv.Atoi(protocol); err == nil {
+		if name, ok := ianaProtocolIToA[v]; ok {
+			protocol = name
+		}
+	}
+
+	var traffic string
+	switch protocol {
+	case "-1", "all":
+		traffic = "all traffic"
+	case "tcp", "udp":
+		if v := apiObject.PortRange; v != nil && aws.Int64Value(v.From) == aws.Int64Value(v.To) {
+			traffic = fmt.Sprintf("%s port %d", protocol, aws.Int64Value(v.From))
+		} else if v != nil {
+			traffic = fmt.Sprintf("%s ports %d-%d", protocol, aws.Int64Value(v.From), aws.Int64Value(v.To))
+		} else {
+			traffic = protocol
+		}
+	case "icmp", "ipv6-icmp":
+		if v := apiObject.IcmpTypeCode; v != nil && aws.Int64Value(v.Type) != -1 {
+			traffic = fmt.Sprintf("%s type %d", protocol, aws.Int64Value(v.Type))
+		} else {
+			traffic = fmt.Sprintf("all %s", protocol)
+		}
+	default:
+		traffic = fmt.Sprintf("protocol %s", protocol)
+	}
+
+	cidrBlock := aws.StringValue(apiObject.CidrBlock)
+	if cidrBlock == "" {
+		cidrBlock = aws.StringValue(apiObject.Ipv6CidrBlock)
+	}
+
+	direction := "from"
+	if aws.BoolValue(apiObject.Egress) {
+		direction = "to"
+	}
+
+	return fmt.Sprintf("%s %s %s", traffic, direction, cidrBlock)
+}
+
+// networkACLRuleNumberString returns a network ACL rule number as shown in the console, where the default rule is "*".
+func networkACLRuleNumberString(ruleNumber int64) string {
+	if ruleNumber == 32767 {
+		return "*"
+	}
+
+	return strconv.FormatInt(ruleNumber, 10)
+}
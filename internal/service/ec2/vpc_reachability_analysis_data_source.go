@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_vpc_reachability_analysis")
+func DataSourceVPCReachabilityAnalysis() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVPCReachabilityAnalysisRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			endpointSchema := func() *schema.Schema {
+				return &schema.Schema{
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"ip_address": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.IsIPAddress,
+							},
+							"security_group_ids": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"subnet_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				}
+			}
+			networkACLRuleSetSchema := func() *schema.Schema {
+				return &schema.Schema{
+					Type:       schema.TypeSet,
+					Optional:   true,
+					ConfigMode: schema.SchemaConfigModeAttr,
+					Elem:       networkACLRuleNestedBlock(),
+					Set:        networkACLRuleHash,
+				}
+			}
+			securityGroupRuleSetSchema := func() *schema.Schema {
+				return &schema.Schema{
+					Type:       schema.TypeSet,
+					Optional:   true,
+					ConfigMode: schema.SchemaConfigModeAttr,
+					Elem:       securityGroupRuleNestedBlock,
+					Set:        SecurityGroupRuleHash,
+				}
+			}
+
+			return map[string]*schema.Schema{
+				"destination": endpointSchema(),
+				"explanations": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"allowed": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"component_id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"component_type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"direction": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"explanation": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"return_traffic": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"network_acl": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"egress":  networkACLRuleSetSchema(),
+							"ingress": networkACLRuleSetSchema(),
+							"network_acl_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"subnet_ids": {
+								Type:     schema.TypeSet,
+								Required: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"port": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IsPortNumberOrZero,
+				},
+				"protocol": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"icmp", "icmpv6", "tcp", "udp"}, false),
+				},
+				"reachable": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"route_table": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"main": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"route": {
+								Type:       schema.TypeSet,
+								Optional:   true,
+								ConfigMode: schema.SchemaConfigModeAttr,
+								Elem:       routeTableRouteNestedBlock(),
+								Set:        resourceRouteTableHash,
+							},
+							"route_table_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"subnet_ids": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"security_group": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"egress":  securityGroupRuleSetSchema(),
+							"ingress": securityGroupRuleSetSchema(),
+							"security_group_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+				"source": endpointSchema(),
+				"source_port": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      49152,
+					ValidateFunc: validation.IsPortNumber,
+				},
+			}
+		},
+	}
+}
+
+func dataSourceVPCReachabilityAnalysisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	query := reachabilityQuery{
+		source:      expandReachabilityEndpoint(d.Get("source").([]interface{})),
+		destination: expandReachabilityEndpoint(d.Get("destination").([]interface{})),
+		protocol:    d.Get("protocol").(string),
+		port:        int64(d.Get("port").(int)),
+		sourcePort:  int64(d.Get("source_port").(int)),
+	}
+
+	if (query.source.ip.To4() == nil) != (query.destination.ip.To4() == nil) {
+		return sdkdiag.AppendErrorf(diags, "source and destination IP addresses must both be IPv4 or both be IPv6")
+	}
+
+	if query.source.subnetID == "" && len(query.source.securityGroupIDs) == 0 && query.destination.subnetID == "" && len(query.destination.securityGroupIDs) == 0 {
+		return sdkdiag.AppendErrorf(diags, "at least one of source or destination must specify subnet_id or security_group_ids")
+	}
+
+	if _, ok := d.GetOk("port"); !ok && (query.protocol == "tcp" || query.protocol == "udp") {
+		return sdkdiag.AppendErrorf(diags, "port must be specified for protocol %s", query.protocol)
+	}
+
+	configuration := newReachabilityConfiguration()
+
+	for _, tfMapRaw := range d.Get("route_table").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		routeTable := &reachabilityRouteTable{
+			id:        tfMap["route_table_id"].(string),
+			main:      tfMap["main"].(bool),
+			subnetIDs: flex.ExpandStringValueSet(tfMap["subnet_ids"].(*schema.Set)),
+		}
+
+		for _, v := range tfMap["route"].(*schema.Set).List() {
+			routeTable.routes = append(routeTable.routes, reachabilityRoute{tfMap: v.(map[string]interface{})})
+		}
+
+		configuration.routeTables = append(configuration.routeTables, routeTable)
+	}
+
+	for _, tfMapRaw := range d.Get("network_acl").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		configuration.networkACLs = append(configuration.networkACLs, &reachabilityNetworkACL{
+			id:        tfMap["network_acl_id"].(string),
+			subnetIDs: flex.ExpandStringValueSet(tfMap["subnet_ids"].(*schema.Set)),
+			entries: append(
+				expandNetworkACLEntries(tfMap["egress"].(*schema.Set).List(), true),
+				expandNetworkACLEntries(tfMap["ingress"].(*schema.Set).List(), false)...,
+			),
+		})
+	}
+
+	for _, tfMapRaw := range d.Get("security_group").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		groupID := tfMap["security_group_id"].(string)
+		configuration.securityGroups[groupID] = append(
+			expandSecurityGroupRulePermissionsFromInlineRules(groupID, securityGroupRuleTypeEgress, tfMap["egress"].(*schema.Set)),
+			expandSecurityGroupRulePermissionsFromInlineRules(groupID, securityGroupRuleTypeIngress, tfMap["ingress"].(*schema.Set))...,
+		)
+	}
+
+	if err := configuration.resolve(ctx, conn, query); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC configuration: %s", err)
+	}
+
+	explanations := analyzeReachability(configuration, query)
+
+	reachable := true
+	for _, v := range explanations {
+		if !v.allowed {
+			reachable = false
+			break
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s-%s-%s-%d", query.source.ip, query.destination.ip, query.protocol, query.port))
+	if err := d.Set("explanations", flattenReachabilityExplanations(explanations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting explanations: %s", err)
+	}
+	d.Set("reachable", reachable)
+
+	return diags
+}
+
+func expandReachabilityEndpoint(tfList []interface{}) reachabilityEndpoint {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return reachabilityEndpoint{}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	endpoint := reachabilityEndpoint{
+		ip:               net.ParseIP(tfMap["ip_address"].(string)),
+		securityGroupIDs: flex.ExpandStringValueSet(tfMap["security_group_ids"].(*schema.Set)),
+		subnetID:         tfMap["subnet_id"].(string),
+	}
+	sort.Strings(endpoint.securityGroupIDs)
+
+	return endpoint
+}
+
+func flattenReachabilityExplanations(explanations []reachabilityExplanation) []interface{} {
+	tfList := make([]interface{}, 0, len(explanations))
+
+	for _, v := range explanations {
+		tfList = append(tfList, map[string]interface{}{
+			"allowed":        v.allowed,
+			"component_id":   v.componentID,
+			"component_type": v.componentType,
+			"direction":      v.direction,
+			"explanation":    v.explanation,
+			"return_traffic": v.returnTraffic,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCReachabilityAnalysisDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_reachability_analysis.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCReachabilityAnalysisDataSourceConfig_basic(rName, 443),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "reachable", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "explanations.#", "7"),
+					resource.TestCheckResourceAttr(dataSourceName, "explanations.0.component_type", "security_group"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "explanations.0.component_id", "aws_security_group.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "explanations.0.direction", "egress"),
+					resource.TestCheckResourceAttr(dataSourceName, "explanations.1.component_type", "route_table"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "explanations.1.component_id", "aws_vpc.test", "main_route_table_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "explanations.2.component_type", "network_acl"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "explanations.2.component_id", "aws_vpc.test", "default_network_acl_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "explanations.4.explanation", "rule ingress tcp port 443 from 10.0.0.0/16 allows the traffic"),
+					resource.TestCheckResourceAttr(dataSourceName, "explanations.6.return_traffic", "true"),
+				),
+			},
+			{
+				Config: testAccVPCReachabilityAnalysisDataSourceConfig_basic(rName, 22),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "reachable", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "explanations.#", "7"),
+					resource.TestCheckResourceAttr(dataSourceName, "explanations.4.allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "explanations.4.explanation", "no rule allows ingress tcp port 22 from 10.0.0.10/32"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCReachabilityAnalysisDataSource_configuration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_reachability_analysis.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCReachabilityAnalysisDataSourceConfig_configuration(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "reachable", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "explanations.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "explanations.0.allowed", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "explanations.1.component_id", "aws_route_table.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "explanations.1.allowed", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "explanations.2.component_id", "aws_network_acl.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "explanations.2.explanation", "rule 100 allows tcp port 443 to 0.0.0.0/0"),
+					resource.TestCheckResourceAttr(dataSourceName, "explanations.3.allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "explanations.3.explanation", "no rule matches; rule * denies the traffic"),
+					resource.TestCheckResourceAttr(dataSourceName, "explanations.3.return_traffic", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCReachabilityAnalysisDataSourceConfig_basic(rName string, port int) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  ingress {
+    protocol    = "tcp"
+    from_port   = 443
+    to_port     = 443
+    cidr_blocks = [aws_vpc.test.cidr_block]
+  }
+
+  egress {
+    protocol    = "-1"
+    from_port   = 0
+    to_port     = 0
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_vpc_reachability_analysis" "test" {
+  protocol = "tcp"
+  port     = %[2]d
+
+  source {
+    ip_address         = cidrhost(aws_subnet.test[0].cidr_block, 10)
+    subnet_id          = aws_subnet.test[0].id
+    security_group_ids = [aws_security_group.test.id]
+  }
+
+  destination {
+    ip_address         = cidrhost(aws_subnet.test[1].cidr_block, 10)
+    subnet_id          = aws_subnet.test[1].id
+    security_group_ids = [aws_security_group.test.id]
+  }
+}
+`, rName, port))
+}
+
+func testAccVPCReachabilityAnalysisDataSourceConfig_configuration(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_association" "test" {
+  subnet_id      = aws_subnet.test[0].id
+  route_table_id = aws_route_table.test.id
+}
+
+# Return traffic isn't allowed.
+resource "aws_network_acl" "test" {
+  vpc_id     = aws_vpc.test.id
+  subnet_ids = aws_subnet.test[*].id
+
+  egress {
+    protocol   = "tcp"
+    rule_no    = 100
+    action     = "allow"
+    cidr_block = "0.0.0.0/0"
+    from_port  = 443
+    to_port    = 443
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  egress {
+    protocol    = "tcp"
+    from_port   = 443
+    to_port     = 443
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_vpc_reachability_analysis" "test" {
+  protocol = "tcp"
+  port     = 443
+
+  source {
+    ip_address         = cidrhost(aws_subnet.test[0].cidr_block, 10)
+    subnet_id          = aws_subnet.test[0].id
+    security_group_ids = [aws_security_group.test.id]
+  }
+
+  destination {
+    ip_address = "203.0.113.10"
+  }
+
+  route_table {
+    route_table_id = aws_route_table.test.id
+    subnet_ids     = [aws_route_table_association.test.subnet_id]
+    route          = aws_route_table.test.route
+  }
+
+  network_acl {
+    network_acl_id = aws_network_acl.test.id
+    subnet_ids     = aws_network_acl.test.subnet_ids
+    ingress        = aws_network_acl.test.ingress
+    egress         = aws_network_acl.test.egress
+  }
+
+  security_group {
+    security_group_id = aws_security_group.test.id
+    ingress           = aws_security_group.test.ingress
+    egress            = aws_security_group.test.egress
+  }
+}
+`, rName))
+}
@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"net"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAnalyzeReachability(t *testing.T) {
+	t.Parallel()
+
+	networkACLEntry := func(ruleNo int, action, protocol, cidrBlock string, fromPort, toPort int, egress bool) *ec2.NetworkAclEntry {
+		return expandNetworkACLEntry(map[string]interface{}{
+			"action":     action,
+			"cidr_block": cidrBlock,
+			"from_port":  fromPort,
+			"protocol":   protocol,
+			"rule_no":    ruleNo,
+			"to_port":    toPort,
+		}, egress)
+	}
+	allowAll := []*ec2.NetworkAclEntry{
+		networkACLEntry(100, "allow", "-1", "0.0.0.0/0", 0, 0, true),
+		networkACLEntry(100, "allow", "-1", "0.0.0.0/0", 0, 0, false),
+	}
+
+	newConfiguration := func() *reachabilityConfiguration {
+		c := newReachabilityConfiguration()
+		c.routeTables = []*reachabilityRouteTable{
+			{
+				id:        "rtb-public",
+				subnetIDs: []string{"subnet-public"},
+				routes: []reachabilityRoute{
+					{tfMap: map[string]interface{}{"cidr_block": "0.0.0.0/0", "gateway_id": "igw-1"}},
+					{tfMap: map[string]interface{}{"cidr_block": "10.0.0.0/16", "gateway_id": "local"}},
+				},
+			},
+			{
+				id:   "rtb-main",
+				main: true,
+				routes: []reachabilityRoute{
+					{tfMap: map[string]interface{}{"cidr_block": "0.0.0.0/0", "nat_gateway_id": "nat-1"}, blackhole: true},
+					{tfMap: map[string]interface{}{"cidr_block": "192.0.2.0/24", "transit_gateway_id": "tgw-1"}},
+					{tfMap: map[string]interface{}{"destination_prefix_list_id": "pl-1", "vpc_endpoint_id": "vpce-1"}},
+				},
+			},
+		}
+		c.networkACLs = []*reachabilityNetworkACL{
+			{id: "acl-public", subnetIDs: []string{"subnet-public"}, entries: allowAll},
+			{id: "acl-peer", subnetIDs: []string{"subnet-peer"}, entries: allowAll},
+			{
+				id:        "acl-private",
+				subnetIDs: []string{"subnet-private"},
+				entries: []*ec2.NetworkAclEntry{
+					networkACLEntry(90, "deny", "tcp", "10.0.1.0/24", 22, 22, false),
+					networkACLEntry(100, "allow", "tcp", "10.0.0.0/16", 0, 65535, false),
+					networkACLEntry(100, "allow", "tcp", "0.0.0.0/0", 1024, 32767, true),
+				},
+			},
+		}
+		c.securityGroups = map[string][]securityGroupRulePermission{
+			"sg-web": {
+				newSecurityGroupRulePermission("egress", "-1", 0, 0, "cidr_ipv4", "0.0.0.0/0"),
+			},
+			"sg-db": {
+				newSecurityGroupRulePermission("ingress", "tcp", 5432, 5432, "referenced_security_group_id", "sg-web"),
+				newSecurityGroupRulePermission("ingress", "tcp", 22, 22, "prefix_list_id", "pl-admin"),
+			},
+		}
+		c.subnetVPCIDs = map[string]string{
+			"subnet-peer":    "vpc-2",
+			"subnet-private": "vpc-1",
+			"subnet-public":  "vpc-1",
+		}
+
+		return c
+	}
+
+	web := reachabilityEndpoint{ip: net.ParseIP("10.0.1.10"), securityGroupIDs: []string{"sg-web"}, subnetID: "subnet-public"}
+	db := reachabilityEndpoint{ip: net.ParseIP("10.0.2.20"), securityGroupIDs: []string{"sg-db"}, subnetID: "subnet-private"}
+
+	testCases := []struct {
+		name  string
+		query reachabilityQuery
+		want  []string
+	}{
+		{
+			name: "to internet",
+			query: reachabilityQuery{
+				source:      web,
+				destination: reachabilityEndpoint{ip: net.ParseIP("203.0.113.10")},
+				protocol:    "tcp",
+				port:        443,
+				sourcePort:  49152,
+			},
+			want: []string{
+				"security_group sg-web egress: rule egress all traffic to 0.0.0.0/0 allows the traffic",
+				"route_table rtb-public: route 0.0.0.0/0 routes the traffic to igw-1",
+				"network_acl acl-public egress: rule 100 allows all traffic to 0.0.0.0/0",
+				"network_acl acl-public ingress (return traffic): rule 100 allows all traffic from 0.0.0.0/0",
+			},
+		},
+		{
+			name: "within VPC",
+			query: reachabilityQuery{
+				source:      web,
+				destination: db,
+				protocol:    "tcp",
+				port:        5432,
+				sourcePort:  49152,
+			},
+			want: []string{
+				"security_group sg-web egress: rule egress all traffic to 0.0.0.0/0 allows the traffic",
+				"route_table rtb-public: the local route routes the traffic within the VPC",
+				"network_acl acl-public egress: rule 100 allows all traffic to 0.0.0.0/0",
+				"network_acl acl-private ingress: rule 100 allows tcp ports 0-65535 from 10.0.0.0/16",
+				"security_group sg-db ingress: rule ingress tcp port 5432 from sg-web allows the traffic",
+				"network_acl acl-private egress (return traffic): no rule matches; rule * denies the traffic",
+				"network_acl acl-public ingress (return traffic): rule 100 allows all traffic from 0.0.0.0/0",
+			},
+		},
+		{
+			name: "denied by network ACL and security group",
+			query: reachabilityQuery{
+				source:      web,
+				destination: db,
+				protocol:    "tcp",
+				port:        22,
+				sourcePort:  1024,
+			},
+			want: []string{
+				"security_group sg-web egress: rule egress all traffic to 0.0.0.0/0 allows the traffic",
+				"route_table rtb-public: the local route routes the traffic within the VPC",
+				"network_acl acl-public egress: rule 100 allows all traffic to 0.0.0.0/0",
+				"network_acl acl-private ingress: rule 90 denies tcp port 22 from 10.0.1.0/24",
+				"security_group sg-db ingress: no rule allows ingress tcp port 22 from 10.0.1.10/32; rules for prefix lists (pl-admin) aren't evaluated",
+				"network_acl acl-private egress (return traffic): rule 100 allows tcp ports 1024-32767 to 0.0.0.0/0",
+				"network_acl acl-public ingress (return traffic): rule 100 allows all traffic from 0.0.0.0/0",
+			},
+		},
+		{
+			name: "same subnet",
+			query: reachabilityQuery{
+				source:      reachabilityEndpoint{ip: net.ParseIP("10.0.1.11"), securityGroupIDs: []string{"sg-web"}, subnetID: "subnet-public"},
+				destination: reachabilityEndpoint{ip: net.ParseIP("10.0.1.10"), subnetID: "subnet-public"},
+				protocol:    "icmp",
+				port:        8,
+			},
+			want: []string{
+				"security_group sg-web egress: rule egress all traffic to 0.0.0.0/0 allows the traffic",
+				"route_table rtb-public: the local route routes the traffic within the VPC",
+			},
+		},
+		{
+			name: "different VPCs",
+			query: reachabilityQuery{
+				source:      web,
+				destination: reachabilityEndpoint{ip: net.ParseIP("10.1.0.10"), subnetID: "subnet-peer"},
+				protocol:    "icmp",
+				port:        8,
+			},
+			want: []string{
+				"security_group sg-web egress: rule egress all traffic to 0.0.0.0/0 allows the traffic",
+				"route_table rtb-public: route 0.0.0.0/0 routes the traffic to igw-1",
+				"network_acl acl-public egress: rule 100 allows all traffic to 0.0.0.0/0",
+				"network_acl acl-peer ingress: rule 100 allows all traffic from 0.0.0.0/0",
+			},
+		},
+		{
+			name: "blackhole route",
+			query: reachabilityQuery{
+				source:      reachabilityEndpoint{ip: net.ParseIP("10.0.2.20"), subnetID: "subnet-private"},
+				destination: reachabilityEndpoint{ip: net.ParseIP("198.51.100.1")},
+				protocol:    "udp",
+				port:        53,
+				sourcePort:  49152,
+			},
+			want: []string{
+				"route_table rtb-main: route 0.0.0.0/0 to nat-1 is a blackhole",
+				"network_acl acl-private egress: no rule matches; rule * denies the traffic",
+				"network_acl acl-private ingress (return traffic): no rule matches; rule * denies the traffic",
+			},
+		},
+		{
+			name: "more specific route",
+			query: reachabilityQuery{
+				source:      reachabilityEndpoint{ip: net.ParseIP("192.0.2.1")},
+				destination: reachabilityEndpoint{ip: net.ParseIP("10.0.2.20"), subnetID: "subnet-private"},
+				protocol:    "tcp",
+				port:        443,
+				sourcePort:  2000,
+			},
+			want: []string{
+				"network_acl acl-private ingress: no rule matches; rule * denies the traffic",
+				"route_table rtb-main (return traffic): route 192.0.2.0/24 routes the traffic to tgw-1",
+				"network_acl acl-private egress (return traffic): rule 100 allows tcp ports 1024-32767 to 0.0.0.0/0",
+			},
+		},
+		{
+			name: "return traffic blackhole",
+			query: reachabilityQuery{
+				source:      reachabilityEndpoint{ip: net.ParseIP("198.51.100.1")},
+				destination: reachabilityEndpoint{ip: net.ParseIP("10.0.2.20"), subnetID: "subnet-private"},
+				protocol:    "icmp",
+				port:        8,
+			},
+			want: []string{
+				"network_acl acl-private ingress: no rule matches; rule * denies the traffic",
+				"route_table rtb-main (return traffic): route 0.0.0.0/0 to nat-1 is a blackhole",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, v := range analyzeReachability(newConfiguration(), testCase.query) {
+				got = append(got, v.String())
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAnalyzeReachabilityNoRoute(t *testing.T) {
+	t.Parallel()
+
+	c := newReachabilityConfiguration()
+	c.routeTables = []*reachabilityRouteTable{{
+		id:        "rtb-1",
+		subnetIDs: []string{"subnet-1"},
+		routes: []reachabilityRoute{
+			{tfMap: map[string]interface{}{"ipv6_cidr_block": "2001:db8::/56", "gateway_id": "local"}},
+			{tfMap: map[string]interface{}{"destination_prefix_list_id": "pl-1", "vpc_endpoint_id": "vpce-1"}},
+		},
+	}}
+	c.networkACLs = []*reachabilityNetworkACL{{id: "acl-1", subnetIDs: []string{"subnet-1"}}}
+
+	query := reachabilityQuery{
+		source:      reachabilityEndpoint{ip: net.ParseIP("2001:db8::10"), subnetID: "subnet-1"},
+		destination: reachabilityEndpoint{ip: net.ParseIP("2001:db8:1::1")},
+		protocol:    "icmpv6",
+		port:        128,
+	}
+
+	explanations := analyzeReachability(c, query)
+
+	if got, want := len(explanations), 2; got != want {
+		t.Fatalf("explanations = %d, want %d", got, want)
+	}
+
+	if got, want := explanations[0].String(), "route_table rtb-1: no route matches 2001:db8:1::1; routes to prefix lists (pl-1) aren't evaluated"; got != want {
+		t.Errorf("explanation = %q, want %q", got, want)
+	}
+
+	if explanations[1].allowed {
+		t.Errorf("network ACL without rules allowed the traffic")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -112,7 +114,10 @@ func ResourceSecurityGroup() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			securityGroupInlineRulesCustomizeDiff("aws_security_group"),
+		),
 	}
 }
 
@@ -359,7 +364,7 @@ func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, me
 
 	// conditionally revoke rules first before attempting to delete the group
 	if v := d.Get("revoke_rules_on_delete").(bool); v {
-		err := forceRevokeSecurityGroupRules(ctx, conn, d.Id(), false)
+		err := forceRevokeSecurityGroupRules(ctx, conn, d.Id(), false, d.Timeout(schema.TimeoutDelete))
 
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -387,7 +392,7 @@ func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, me
 
 	if tfawserr.ErrCodeEquals(err, errCodeDependencyViolation) || tfawserr.ErrCodeEquals(err, errCodeInvalidGroupInUse) {
 		if v := d.Get("revoke_rules_on_delete").(bool); v {
-			err := forceRevokeSecurityGroupRules(ctx, conn, d.Id(), true)
+			err := forceRevokeSecurityGroupRules(ctx, conn, d.Id(), true, d.Timeout(schema.TimeoutDelete))
 
 			if err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
@@ -430,14 +435,44 @@ func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, me
 // this security group with rules that originate in other groups but point here, will cause
 // a DepedencyViolation error. searchAll = true means to search every security group
 // looking for a rule depending on this security group. Otherwise, it will only look at
-// groups that this group knows about.
-func forceRevokeSecurityGroupRules(ctx context.Context, conn *ec2.EC2, id string, searchAll bool) error {
-	conns.GlobalMutexKV.Lock(id)
-	defer conns.GlobalMutexKV.Unlock(id)
+// groups that this group knows about. timeout bounds the wait for the locks on the
+// security groups whose rules are revoked.
+func forceRevokeSecurityGroupRules(ctx context.Context, conn *ec2.EC2, id string, searchAll bool, timeout time.Duration) error {
+	lockCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Lock this security group and any other security group that owns one of the rules.
+	// The rules are read again once the locks are held, as they may have changed while waiting.
+	// If another security group is now involved, the locks are released and all of the groups are locked again
+	// so that the locks are always acquired in order.
+	groupIDs := []string{id}
+	var rules []*ec2.SecurityGroupRule
+	for {
+		unlock, err := conns.GlobalMutexKV.LockMany(lockCtx, groupIDs...)
+		if err != nil {
+			return fmt.Errorf("waiting for lock on Security Group (%s): %w", id, err)
+		}
 
-	rules, err := rulesInSGsTouchingThis(ctx, conn, id, searchAll)
-	if err != nil {
-		return fmt.Errorf("describing security group rules: %s", err)
+		rules, err = rulesInSGsTouchingThis(ctx, conn, id, searchAll)
+		if err != nil {
+			unlock()
+			return fmt.Errorf("describing security group rules: %s", err)
+		}
+
+		var newGroupIDs []string
+		for _, rule := range rules {
+			if v := aws.StringValue(rule.GroupId); v != "" && !slices.Contains(groupIDs, v) && !slices.Contains(newGroupIDs, v) {
+				newGroupIDs = append(newGroupIDs, v)
+			}
+		}
+
+		if len(newGroupIDs) == 0 {
+			defer unlock()
+			break
+		}
+
+		unlock()
+		groupIDs = append(groupIDs, newGroupIDs...)
 	}
 
 	for _, rule := range rules {
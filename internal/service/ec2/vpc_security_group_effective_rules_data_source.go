@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_security_group_effective_rules")
+func DataSourceSecurityGroupEffectiveRules() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSecurityGroupEffectiveRulesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"egress":  securityGroupEffectiveRuleSchema(),
+			"ingress": securityGroupEffectiveRuleSchema(),
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func securityGroupEffectiveRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cidr_ipv4": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"cidr_ipv6": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"from_port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"ip_protocol": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"prefix_list_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"referenced_security_group_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"security_group_rule_ids": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"to_port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSecurityGroupEffectiveRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	groupID := d.Get("security_group_id").(string)
+
+	if _, err := FindSecurityGroupByID(ctx, conn, groupID); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Group (%s): %s", groupID, err)
+	}
+
+	output, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, groupID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Group (%s) rules: %s", groupID, err)
+	}
+
+	// Of equal rules, the one with the lowest ID is effective.
+	sort.Slice(output, func(i, j int) bool {
+		return aws.StringValue(output[i].SecurityGroupRuleId) < aws.StringValue(output[j].SecurityGroupRuleId)
+	})
+
+	rules := make([]ownedSecurityGroupRule, len(output))
+	for i, v := range output {
+		rules[i] = ownedSecurityGroupRule{
+			securityGroupRulePermission: expandSecurityGroupRulePermissionFromRule(v),
+			owner:                       aws.StringValue(v.SecurityGroupRuleId),
+		}
+	}
+
+	effective := effectiveSecurityGroupRules(rules)
+	sort.SliceStable(effective, func(i, j int) bool {
+		return effective[i].key() < effective[j].key()
+	})
+
+	d.SetId(groupID)
+	if err := d.Set("egress", flattenEffectiveSecurityGroupRules(effective, securityGroupRuleTypeEgress)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting egress: %s", err)
+	}
+	if err := d.Set("ingress", flattenEffectiveSecurityGroupRules(effective, securityGroupRuleTypeIngress)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ingress: %s", err)
+	}
+
+	return diags
+}
+
+func flattenEffectiveSecurityGroupRules(rules []effectiveSecurityGroupRule, ruleType string) []interface{} {
+	tfList := []interface{}{}
+
+	for _, v := range rules {
+		if v.ruleType != ruleType {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"from_port":               int(v.fromPort),
+			"ip_protocol":             v.protocol,
+			"security_group_rule_ids": v.owners,
+			"to_port":                 int(v.toPort),
+		}
+		tfMap[v.peerType] = v.peer
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
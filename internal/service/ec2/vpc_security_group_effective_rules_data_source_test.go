@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCSecurityGroupEffectiveRulesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_security_group_effective_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupEffectiveRulesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_group_id", "aws_security_group.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "egress.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "egress.0.cidr_ipv4", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr(dataSourceName, "egress.0.ip_protocol", "-1"),
+					resource.TestCheckResourceAttr(dataSourceName, "ingress.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "ingress.*", map[string]string{
+						"cidr_ipv4":                 "10.0.0.0/8",
+						"from_port":                 "0",
+						"ip_protocol":               "tcp",
+						"security_group_rule_ids.#": "2",
+						"to_port":                   "65535",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "ingress.*", map[string]string{
+						"cidr_ipv6":                 "::/0",
+						"from_port":                 "443",
+						"ip_protocol":               "tcp",
+						"security_group_rule_ids.#": "1",
+						"to_port":                   "443",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCSecurityGroupEffectiveRulesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_ingress_rule" "wide" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 0
+  ip_protocol = "tcp"
+  to_port     = 65535
+}
+
+# Shadowed by the wide rule.
+resource "aws_vpc_security_group_ingress_rule" "narrow" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.1.0.0/16"
+  from_port   = 443
+  ip_protocol = "tcp"
+  to_port     = 443
+}
+
+resource "aws_vpc_security_group_ingress_rule" "ipv6" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv6   = "::/0"
+  from_port   = 443
+  ip_protocol = "tcp"
+  to_port     = 443
+}
+
+resource "aws_vpc_security_group_egress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "0.0.0.0/0"
+  ip_protocol = "-1"
+}
+
+data "aws_security_group_effective_rules" "test" {
+  security_group_id = aws_security_group.test.id
+
+  depends_on = [
+    aws_vpc_security_group_ingress_rule.wide,
+    aws_vpc_security_group_ingress_rule.narrow,
+    aws_vpc_security_group_ingress_rule.ipv6,
+    aws_vpc_security_group_egress_rule.test,
+  ]
+}
+`)
+}
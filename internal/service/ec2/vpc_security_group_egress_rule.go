@@ -16,6 +16,7 @@ import (
 // @Tags(identifierAttribute="id")
 func newResourceSecurityGroupEgressRule(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceSecurityGroupEgressRule{}
+	r.ruleType = securityGroupRuleTypeEgress
 	r.create = r.createSecurityGroupRule
 	r.delete = r.deleteSecurityGroupRule
 	r.findByID = r.findSecurityGroupRuleByID
@@ -34,6 +34,7 @@ import (
 // @Tags(identifierAttribute="id")
 func newResourceSecurityGroupIngressRule(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceSecurityGroupIngressRule{}
+	r.ruleType = securityGroupRuleTypeIngress
 	r.create = r.createSecurityGroupRule
 	r.delete = r.deleteSecurityGroupRule
 	r.findByID = r.findSecurityGroupRuleByID
@@ -91,6 +92,7 @@ type resourceSecurityGroupRule struct {
 	create   func(context.Context, *resourceSecurityGroupRuleData) (string, error)
 	delete   func(context.Context, *resourceSecurityGroupRuleData) error
 	findByID func(context.Context, string) (*ec2.SecurityGroupRule, error)
+	ruleType string
 }
 
 func (r *resourceSecurityGroupRule) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -342,9 +344,68 @@ func (r *resourceSecurityGroupRule) ModifyPlan(ctx context.Context, request reso
 		}
 	}
 
+	if !request.Plan.Raw.IsNull() {
+		var data resourceSecurityGroupRuleData
+
+		response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		for _, v := range r.plannedRuleConflicts(ctx, &data) {
+			response.Diagnostics.AddWarning(v.summary, v.detail)
+		}
+	}
+
 	r.SetTagsAll(ctx, request, response)
 }
 
+// plannedRuleConflicts returns warnings about conflicts between the planned rule and the security group's current rules.
+func (r *resourceSecurityGroupRule) plannedRuleConflicts(ctx context.Context, data *resourceSecurityGroupRuleData) []securityGroupRuleWarning {
+	attributeName := data.sourceAttributeName()
+	values := map[string]types.String{
+		"cidr_ipv4":                    data.CIDRIPv4,
+		"cidr_ipv6":                    data.CIDRIPv6,
+		"prefix_list_id":               data.PrefixListID,
+		"referenced_security_group_id": data.ReferencedSecurityGroupID,
+	}
+
+	if attributeName == "" || values[attributeName].IsUnknown() || data.SecurityGroupID.IsUnknown() || data.IPProtocol.IsUnknown() || data.FromPort.IsUnknown() || data.ToPort.IsUnknown() {
+		return nil
+	}
+
+	peer := values[attributeName].ValueString()
+	if attributeName == "referenced_security_group_id" {
+		// [UserID/]GroupID.
+		if _, id, ok := strings.Cut(peer, "/"); ok {
+			peer = id
+		}
+	}
+
+	fromPort, toPort := int64(-1), int64(-1)
+	if !data.FromPort.IsNull() {
+		fromPort = data.FromPort.ValueInt64()
+	}
+	if !data.ToPort.IsNull() {
+		toPort = data.ToPort.ValueInt64()
+	}
+
+	permission := newSecurityGroupRulePermission(r.ruleType, data.IPProtocol.ValueString(), fromPort, toPort, attributeName, peer)
+
+	resourceType := fmt.Sprintf("aws_vpc_security_group_%s_rule", r.ruleType)
+	resource, ruleID := resourceType, ""
+	if id := data.ID; !id.IsUnknown() && !id.IsNull() {
+		ruleID = id.ValueString()
+		resource = resourceType + " " + ruleID
+	}
+
+	planned := newPlannedSecurityGroupRules(resource, ruleID != "", nil, []securityGroupRulePermission{permission})
+	planned.ruleID = ruleID
+
+	return findSecurityGroupRuleConflicts(ctx, r.Meta().EC2Conn(ctx), data.SecurityGroupID.ValueString(), planned)
+}
+
 func (r *resourceSecurityGroupRule) ConfigValidators(_ context.Context) []resource.ConfigValidator {
 	return []resource.ConfigValidator{
 		resourcevalidator.ExactlyOneOf(
@@ -149,6 +149,8 @@ func ResourceSecurityGroupRule() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(securityGroupRuleType_Values(), false),
 			},
 		},
+
+		CustomizeDiff: securityGroupRuleCustomizeDiff,
 	}
 }
 
@@ -158,7 +160,11 @@ func resourceSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 	securityGroupID := d.Get("security_group_id").(string)
 
-	conns.GlobalMutexKV.Lock(securityGroupID)
+	lockCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	if err := conns.GlobalMutexKV.LockContext(lockCtx, securityGroupID); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for lock on Security Group (%s): %s", securityGroupID, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(securityGroupID)
 
 	sg, err := FindSecurityGroupByID(ctx, conn, securityGroupID)
@@ -329,7 +335,11 @@ func resourceSecurityGroupRuleUpdate(ctx context.Context, d *schema.ResourceData
 	if d.HasChange("description") {
 		securityGroupID := d.Get("security_group_id").(string)
 
-		conns.GlobalMutexKV.Lock(securityGroupID)
+		lockCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+		defer cancel()
+		if err := conns.GlobalMutexKV.LockContext(lockCtx, securityGroupID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for lock on Security Group (%s): %s", securityGroupID, err)
+		}
 		defer conns.GlobalMutexKV.Unlock(securityGroupID)
 
 		sg, err := FindSecurityGroupByID(ctx, conn, securityGroupID)
@@ -373,7 +383,11 @@ func resourceSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 	securityGroupID := d.Get("security_group_id").(string)
 
-	conns.GlobalMutexKV.Lock(securityGroupID)
+	lockCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	if err := conns.GlobalMutexKV.LockContext(lockCtx, securityGroupID); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for lock on Security Group (%s): %s", securityGroupID, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(securityGroupID)
 
 	sg, err := FindSecurityGroupByID(ctx, conn, securityGroupID)
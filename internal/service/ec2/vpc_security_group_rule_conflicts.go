@@ -0,0 +1,564 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// securityGroupRulePermission is a security group rule that allows one protocol and port range
+// to or from one peer: an IPv4 CIDR block, an IPv6 CIDR block, a prefix list or a security group.
+type securityGroupRulePermission struct {
+	ruleType string // "ingress" or "egress".
+	protocol string // As returned by ProtocolForValue. "-1" is all protocols.
+	fromPort int64  // For ICMP, the type. -1 if ports don't apply.
+	toPort   int64  // For ICMP, the code. -1 if ports don't apply.
+	peerType string // "cidr_ipv4", "cidr_ipv6", "prefix_list_id" or "referenced_security_group_id".
+	peer     string
+}
+
+func newSecurityGroupRulePermission(ruleType, protocol string, fromPort, toPort int64, peerType, peer string) securityGroupRulePermission {
+	p := securityGroupRulePermission{
+		ruleType: ruleType,
+		protocol: ProtocolForValue(protocol),
+		fromPort: fromPort,
+		toPort:   toPort,
+		peerType: peerType,
+		peer:     peer,
+	}
+
+	switch p.protocol {
+	case "tcp", "udp":
+	case "icmp", "icmpv6":
+		// All ICMP types include all codes.
+		if p.fromPort == -1 {
+			p.toPort = -1
+		}
+	default:
+		// Ports only apply to TCP, UDP and ICMP.
+		p.fromPort, p.toPort = -1, -1
+	}
+
+	switch peerType {
+	case "cidr_ipv4", "cidr_ipv6":
+		if _, ipNet, err := net.ParseCIDR(peer); err == nil {
+			p.peer = ipNet.String()
+		}
+	}
+
+	return p
+}
+
+// key returns a string that is equal for equal permissions.
+func (p securityGroupRulePermission) key() string {
+	return strings.Join([]string{
+		p.ruleType,
+		p.protocol,
+		strconv.FormatInt(p.fromPort, 10),
+		strconv.FormatInt(p.toPort, 10),
+		p.peerType,
+		p.peer,
+	}, "_")
+}
+
+// covers returns whether p allows all the traffic that other allows.
+func (p securityGroupRulePermission) covers(other securityGroupRulePermission) bool {
+	if p.ruleType != other.ruleType || p.peerType != other.peerType {
+		return false
+	}
+
+	switch p.peerType {
+	case "cidr_ipv4", "cidr_ipv6":
+		_, pNet, err := net.ParseCIDR(p.peer)
+		if err != nil {
+			return p.peer == other.peer
+		}
+		_, otherNet, err := net.ParseCIDR(other.peer)
+		if err != nil {
+			return false
+		}
+		pOnes, _ := pNet.Mask.Size()
+		otherOnes, _ := otherNet.Mask.Size()
+		if pOnes > otherOnes || !pNet.Contains(otherNet.IP) {
+			return false
+		}
+	default:
+		if p.peer != other.peer {
+			return false
+		}
+	}
+
+	switch {
+	case p.protocol == "-1":
+		return true
+	case p.protocol != other.protocol:
+		return false
+	case p.protocol == "tcp" || p.protocol == "udp":
+		return p.fromPort <= other.fromPort && other.toPort <= p.toPort
+	case p.protocol == "icmp" || p.protocol == "icmpv6":
+		// A type of -1 is all types and a code of -1 is all codes.
+		return p.fromPort == -1 || (p.fromPort == other.fromPort && (p.toPort == -1 || p.toPort == other.toPort))
+	default:
+		return true
+	}
+}
+
+func (p securityGroupRulePermission) String() string {
+	var traffic string
+	switch p.protocol {
+	case "-1":
+		traffic = "all traffic"
+	case "tcp", "udp":
+		if p.fromPort == p.toPort {
+			traffic = fmt.Sprintf("%s port %d", p.protocol, p.fromPort)
+		} else {
+			traffic = fmt.Sprintf("%s ports %d-%d", p.protocol, p.fromPort, p.toPort)
+		}
+	case "icmp", "icmpv6":
+		switch {
+		case p.fromPort == -1:
+			traffic = fmt.Sprintf("all %s", p.protocol)
+		case p.toPort == -1:
+			traffic = fmt.Sprintf("%s type %d", p.protocol, p.fromPort)
+		default:
+			traffic = fmt.Sprintf("%s type %d code %d", p.protocol, p.fromPort, p.toPort)
+		}
+	default:
+		traffic = fmt.Sprintf("protocol %s", p.protocol)
+	}
+
+	direction := "from"
+	if p.ruleType == securityGroupRuleTypeEgress {
+		direction = "to"
+	}
+
+	return fmt.Sprintf("%s %s %s %s", p.ruleType, traffic, direction, p.peer)
+}
+
+// ownedSecurityGroupRule is a security group rule permission and the resource or rule ID that owns it.
+type ownedSecurityGroupRule struct {
+	securityGroupRulePermission
+	owner string
+}
+
+func (r ownedSecurityGroupRule) String() string {
+	return fmt.Sprintf("%s (%s)", r.owner, r.securityGroupRulePermission)
+}
+
+// securityGroupRuleOverlap is a rule that is made redundant by another rule in the same security group.
+type securityGroupRuleOverlap struct {
+	rule      ownedSecurityGroupRule
+	by        ownedSecurityGroupRule
+	duplicate bool // The rules are equal. Otherwise rule is shadowed by a broader rule.
+}
+
+// findSecurityGroupRuleOverlaps returns the pairs of rules in which one rule duplicates or shadows the other.
+// Security group rules only allow traffic, so a rule that allows a subset of the traffic another rule allows has no effect.
+func findSecurityGroupRuleOverlaps(rules []ownedSecurityGroupRule) []securityGroupRuleOverlap {
+	var overlaps []securityGroupRuleOverlap
+
+	for i := range rules {
+		for j := i + 1; j < len(rules); j++ {
+			if v, ok := securityGroupRulesOverlap(rules[i], rules[j]); ok {
+				overlaps = append(overlaps, v)
+			}
+		}
+	}
+
+	return overlaps
+}
+
+// securityGroupRulesOverlap returns whether one of two rules duplicates or shadows the other.
+// If the rules are equal, b is reported as the duplicate.
+func securityGroupRulesOverlap(a, b ownedSecurityGroupRule) (securityGroupRuleOverlap, bool) {
+	switch {
+	case a.key() == b.key():
+		return securityGroupRuleOverlap{rule: b, by: a, duplicate: true}, true
+	case a.covers(b.securityGroupRulePermission):
+		return securityGroupRuleOverlap{rule: b, by: a}, true
+	case b.covers(a.securityGroupRulePermission):
+		return securityGroupRuleOverlap{rule: a, by: b}, true
+	default:
+		return securityGroupRuleOverlap{}, false
+	}
+}
+
+// effectiveSecurityGroupRule is a rule that isn't made redundant by another rule, with the owners
+// of all the rules it makes redundant.
+type effectiveSecurityGroupRule struct {
+	securityGroupRulePermission
+	owners []string
+}
+
+// effectiveSecurityGroupRules removes duplicate and shadowed rules, returning the rules that determine
+// what traffic a security group allows. Rules are returned in the order they were specified.
+func effectiveSecurityGroupRules(rules []ownedSecurityGroupRule) []effectiveSecurityGroupRule {
+	var effective []effectiveSecurityGroupRule
+	var redundant []ownedSecurityGroupRule
+
+	for i, rule := range rules {
+		covered := false
+
+		for j, other := range rules {
+			// A rule is redundant if another rule is broader or an earlier rule is equivalent.
+			if i != j && other.covers(rule.securityGroupRulePermission) && (!rule.covers(other.securityGroupRulePermission) || j < i) {
+				covered = true
+				break
+			}
+		}
+
+		if covered {
+			redundant = append(redundant, rule)
+		} else {
+			effective = append(effective, effectiveSecurityGroupRule{
+				securityGroupRulePermission: rule.securityGroupRulePermission,
+				owners:                      []string{rule.owner},
+			})
+		}
+	}
+
+	for _, rule := range redundant {
+		for i := range effective {
+			if effective[i].covers(rule.securityGroupRulePermission) {
+				effective[i].owners = append(effective[i].owners, rule.owner)
+				break
+			}
+		}
+	}
+
+	return effective
+}
+
+// expandSecurityGroupRulePermissionFromRule returns the permission in an EC2 security group rule.
+func expandSecurityGroupRulePermissionFromRule(apiObject *ec2.SecurityGroupRule) securityGroupRulePermission {
+	ruleType := securityGroupRuleTypeIngress
+	if aws.BoolValue(apiObject.IsEgress) {
+		ruleType = securityGroupRuleTypeEgress
+	}
+
+	var peerType, peer string
+	switch {
+	case apiObject.CidrIpv4 != nil:
+		peerType, peer = "cidr_ipv4", aws.StringValue(apiObject.CidrIpv4)
+	case apiObject.CidrIpv6 != nil:
+		peerType, peer = "cidr_ipv6", aws.StringValue(apiObject.CidrIpv6)
+	case apiObject.PrefixListId != nil:
+		peerType, peer = "prefix_list_id", aws.StringValue(apiObject.PrefixListId)
+	case apiObject.ReferencedGroupInfo != nil:
+		peerType, peer = "referenced_security_group_id", aws.StringValue(apiObject.ReferencedGroupInfo.GroupId)
+	}
+
+	return newSecurityGroupRulePermission(ruleType, aws.StringValue(apiObject.IpProtocol), aws.Int64Value(apiObject.FromPort), aws.Int64Value(apiObject.ToPort), peerType, peer)
+}
+
+// expandSecurityGroupRulePermissionsFromInlineRules returns the permissions in aws_security_group ingress or egress blocks, one per peer.
+// A security group ID in security_groups may be prefixed by an account ID.
+func expandSecurityGroupRulePermissionsFromInlineRules(groupID, ruleType string, rules *schema.Set) []securityGroupRulePermission {
+	var permissions []securityGroupRulePermission
+
+	for _, tfMapRaw := range SecurityGroupExpandRules(rules).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		var peerType, peer string
+		if v, ok := tfMap["self"].(bool); ok && v {
+			peerType, peer = "referenced_security_group_id", groupID
+		} else if v, ok := tfMap["cidr_blocks"].([]interface{}); ok && len(v) > 0 {
+			peerType, peer = "cidr_ipv4", v[0].(string)
+		} else if v, ok := tfMap["ipv6_cidr_blocks"].([]interface{}); ok && len(v) > 0 {
+			peerType, peer = "cidr_ipv6", v[0].(string)
+		} else if v, ok := tfMap["prefix_list_ids"].([]interface{}); ok && len(v) > 0 {
+			peerType, peer = "prefix_list_id", v[0].(string)
+		} else if v, ok := tfMap["security_groups"].(*schema.Set); ok && v.Len() > 0 {
+			peerType, peer = "referenced_security_group_id", v.List()[0].(string)
+			if _, id, ok := strings.Cut(peer, "/"); ok {
+				peer = id
+			}
+		} else {
+			continue
+		}
+
+		permissions = append(permissions, newSecurityGroupRulePermission(ruleType, tfMap["protocol"].(string), int64(tfMap["from_port"].(int)), int64(tfMap["to_port"].(int)), peerType, peer))
+	}
+
+	return permissions
+}
+
+// plannedSecurityGroupRules is the security group rules that one resource plans to manage.
+type plannedSecurityGroupRules struct {
+	resource    string   // Names the resource in warnings, e.g. "aws_security_group sg-0123".
+	exists      bool     // Whether the resource exists, in which case the security group's rules that match its planned rules are its own.
+	ruleID      string   // The ID of the rule that a resource managing a single rule owns, if known.
+	inlineTypes []string // Rule types managed authoritatively by the resource's inline rule blocks.
+	rules       []ownedSecurityGroupRule
+}
+
+// securityGroupRuleWarning is a plan-time warning about a security group's rules.
+type securityGroupRuleWarning struct {
+	summary   string
+	detail    string
+	resources []string // The resources and rules involved, the resource being planned first.
+}
+
+// newPlannedSecurityGroupRules returns the rules that a resource plans to manage.
+func newPlannedSecurityGroupRules(resource string, exists bool, inlineTypes []string, permissions []securityGroupRulePermission) *plannedSecurityGroupRules {
+	planned := &plannedSecurityGroupRules{
+		resource:    resource,
+		exists:      exists,
+		inlineTypes: inlineTypes,
+	}
+
+	for _, v := range permissions {
+		planned.rules = append(planned.rules, ownedSecurityGroupRule{
+			securityGroupRulePermission: v,
+			owner:                       resource,
+		})
+	}
+
+	return planned
+}
+
+func (p *plannedSecurityGroupRules) managesInline(ruleType string) bool {
+	for _, v := range p.inlineTypes {
+		if v == ruleType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// owns returns whether a security group's current rule is one of the rules that the resource manages.
+func (p *plannedSecurityGroupRules) owns(ruleID string, permission securityGroupRulePermission) bool {
+	if p.ruleID != "" {
+		return ruleID == p.ruleID
+	}
+
+	if !p.exists {
+		return false
+	}
+
+	for _, v := range p.rules {
+		if v.key() == permission.key() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// securityGroupRuleConflicts returns warnings about conflicts between the rules that a resource plans to manage
+// and a security group's current rules, and about redundant rules among them.
+// A security group rule can only have one owner, so conflicts don't depend on the order in which resources are planned.
+func securityGroupRuleConflicts(groupID string, planned *plannedSecurityGroupRules, current []*ec2.SecurityGroupRule) []securityGroupRuleWarning {
+	var others []ownedSecurityGroupRule
+	unmanaged := make(map[string][]string)
+
+	for _, apiObject := range current {
+		ruleID := aws.StringValue(apiObject.SecurityGroupRuleId)
+		permission := expandSecurityGroupRulePermissionFromRule(apiObject)
+
+		if planned.owns(ruleID, permission) {
+			continue
+		}
+
+		// Inline rule blocks remove the security group's other rules of the same type.
+		if planned.managesInline(permission.ruleType) {
+			unmanaged[permission.ruleType] = append(unmanaged[permission.ruleType], ruleID)
+			continue
+		}
+
+		others = append(others, ownedSecurityGroupRule{
+			securityGroupRulePermission: permission,
+			owner:                       "security group rule " + ruleID,
+		})
+	}
+
+	var warnings []securityGroupRuleWarning
+
+	for _, ruleType := range securityGroupRuleType_Values() {
+		ruleIDs := unmanaged[ruleType]
+		if len(ruleIDs) == 0 {
+			continue
+		}
+
+		sort.Strings(ruleIDs)
+
+		warnings = append(warnings, securityGroupRuleWarning{
+			summary: "Security group rules managed inline and by rule resources",
+			detail: fmt.Sprintf("Security group %[1]s's %[2]s rules are managed by the inline %[2]s blocks of %[3]s, which remove its other %[2]s rules (%[4]s). "+
+				"If those rules are managed by security group rule resources, each resource removes the rules that the other adds, so the configuration never converges. "+
+				"Use either inline rules or security group rule resources for a security group.",
+				groupID, ruleType, planned.resource, strings.Join(ruleIDs, ", ")),
+			resources: append([]string{planned.resource}, ruleIDs...),
+		})
+	}
+
+	overlaps := findSecurityGroupRuleOverlaps(planned.rules)
+	for _, a := range others {
+		for _, b := range planned.rules {
+			if v, ok := securityGroupRulesOverlap(a, b); ok {
+				overlaps = append(overlaps, v)
+			}
+		}
+	}
+
+	for _, overlap := range overlaps {
+		// The other rule is whichever rule isn't the planned resource's. Rules within the planned resource only name it once.
+		resources := []string{planned.resource}
+		if overlap.by.owner != planned.resource {
+			resources = append(resources, overlap.by.owner)
+		} else if overlap.rule.owner != planned.resource {
+			resources = append(resources, overlap.rule.owner)
+		}
+
+		if overlap.duplicate {
+			warnings = append(warnings, securityGroupRuleWarning{
+				summary:   "Duplicate security group rule",
+				detail:    fmt.Sprintf("In security group %s, %s duplicates %s.", groupID, overlap.rule, overlap.by),
+				resources: resources,
+			})
+		} else {
+			warnings = append(warnings, securityGroupRuleWarning{
+				summary: "Shadowed security group rule",
+				detail: fmt.Sprintf("In security group %s, %s is shadowed by %s, which allows the same traffic and more. "+
+					"Security group rules only allow traffic, so the shadowed rule has no effect.", groupID, overlap.rule, overlap.by),
+				resources: resources,
+			})
+		}
+	}
+
+	return warnings
+}
+
+// findSecurityGroupRuleConflicts reads a security group's current rules and returns warnings about conflicts with the rules that a resource plans to manage.
+// If the rules can't be read no warnings are returned, so that planning doesn't fail.
+func findSecurityGroupRuleConflicts(ctx context.Context, conn *ec2.EC2, groupID string, planned *plannedSecurityGroupRules) []securityGroupRuleWarning {
+	current, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, groupID)
+
+	if err != nil {
+		tflog.Debug(ctx, "reading security group rules for conflict detection", map[string]any{
+			"security_group_id": groupID,
+			"error":             err.Error(),
+		})
+
+		return nil
+	}
+
+	return securityGroupRuleConflicts(groupID, planned, current)
+}
+
+// logSecurityGroupRuleWarnings logs warnings for resources that can't return plan-time warnings.
+func logSecurityGroupRuleWarnings(ctx context.Context, groupID string, warnings []securityGroupRuleWarning) {
+	for _, v := range warnings {
+		tflog.Warn(ctx, v.summary, map[string]any{
+			"security_group_id": groupID,
+			"resources":         v.resources,
+			"detail":            v.detail,
+		})
+	}
+}
+
+// securityGroupInlineRulesCustomizeDiff checks the inline rules of an existing aws_security_group or aws_default_security_group
+// against the security group's current rules and logs warnings about conflicting and redundant rules.
+func securityGroupInlineRulesCustomizeDiff(resourceType string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		// Rule resources can't reference a new security group's ID until it's created.
+		groupID := d.Id()
+		if groupID == "" {
+			return nil
+		}
+
+		config := d.GetRawConfig()
+		if config.IsNull() || !config.IsKnown() {
+			return nil
+		}
+
+		var inlineTypes []string
+		var permissions []securityGroupRulePermission
+		for _, ruleType := range securityGroupRuleType_Values() {
+			v := config.GetAttr(ruleType)
+			if v.IsNull() {
+				continue
+			}
+
+			// The rules that will be removed aren't known until the inline rules are.
+			if !v.IsWhollyKnown() {
+				continue
+			}
+
+			inlineTypes = append(inlineTypes, ruleType)
+			permissions = append(permissions, expandSecurityGroupRulePermissionsFromInlineRules(groupID, ruleType, d.Get(ruleType).(*schema.Set))...)
+		}
+
+		if len(inlineTypes) == 0 {
+			return nil
+		}
+
+		conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+		planned := newPlannedSecurityGroupRules(resourceType+" "+groupID, true, inlineTypes, permissions)
+		logSecurityGroupRuleWarnings(ctx, groupID, findSecurityGroupRuleConflicts(ctx, conn, groupID, planned))
+
+		return nil
+	}
+}
+
+// securityGroupRuleCustomizeDiff checks the rules of an aws_security_group_rule against the security group's current rules
+// and logs warnings about conflicting and redundant rules.
+func securityGroupRuleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsWhollyKnown() {
+		return nil
+	}
+
+	groupID := d.Get("security_group_id").(string)
+	ruleType := d.Get("type").(string)
+	protocol := d.Get("protocol").(string)
+	fromPort, toPort := int64(d.Get("from_port").(int)), int64(d.Get("to_port").(int))
+
+	var permissions []securityGroupRulePermission
+	add := func(peerType, peer string) {
+		permissions = append(permissions, newSecurityGroupRulePermission(ruleType, protocol, fromPort, toPort, peerType, peer))
+	}
+
+	for _, v := range d.Get("cidr_blocks").([]interface{}) {
+		add("cidr_ipv4", v.(string))
+	}
+	for _, v := range d.Get("ipv6_cidr_blocks").([]interface{}) {
+		add("cidr_ipv6", v.(string))
+	}
+	for _, v := range d.Get("prefix_list_ids").([]interface{}) {
+		add("prefix_list_id", v.(string))
+	}
+	if d.Get("self").(bool) {
+		add("referenced_security_group_id", groupID)
+	} else if v := d.Get("source_security_group_id").(string); v != "" {
+		if _, id, ok := strings.Cut(v, "/"); ok {
+			v = id
+		}
+		add("referenced_security_group_id", v)
+	}
+
+	const resourceType = "aws_security_group_rule"
+	resource := resourceType
+	if id := d.Id(); id != "" {
+		resource = resourceType + " " + id
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+	planned := newPlannedSecurityGroupRules(resource, d.Id() != "", nil, permissions)
+	logSecurityGroupRuleWarnings(ctx, groupID, findSecurityGroupRuleConflicts(ctx, conn, groupID, planned))
+
+	return nil
+}
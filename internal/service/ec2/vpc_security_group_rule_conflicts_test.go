@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSecurityGroupRulePermissionCovers(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		a, b securityGroupRulePermission
+		want bool
+	}{
+		{
+			name: "equal",
+			a:    newSecurityGroupRulePermission("ingress", "tcp", 443, 443, "cidr_ipv4", "10.0.0.0/8"),
+			b:    newSecurityGroupRulePermission("ingress", "6", 443, 443, "cidr_ipv4", "10.0.0.0/8"),
+			want: true,
+		},
+		{
+			name: "wider port range",
+			a:    newSecurityGroupRulePermission("ingress", "tcp", 0, 65535, "cidr_ipv4", "10.0.0.0/8"),
+			b:    newSecurityGroupRulePermission("ingress", "tcp", 443, 443, "cidr_ipv4", "10.0.0.0/8"),
+			want: true,
+		},
+		{
+			name: "narrower port range",
+			a:    newSecurityGroupRulePermission("ingress", "tcp", 443, 443, "cidr_ipv4", "10.0.0.0/8"),
+			b:    newSecurityGroupRulePermission("ingress", "tcp", 0, 65535, "cidr_ipv4", "10.0.0.0/8"),
+			want: false,
+		},
+		{
+			name: "wider CIDR block",
+			a:    newSecurityGroupRulePermission("ingress", "tcp", 443, 443, "cidr_ipv4", "10.0.0.0/8"),
+			b:    newSecurityGroupRulePermission("ingress", "tcp", 443, 443, "cidr_ipv4", "10.1.2.0/24"),
+			want: true,
+		},
+		{
+			name: "disjoint CIDR blocks",
+			a:    newSecurityGroupRulePermission("ingress", "tcp", 443, 443, "cidr_ipv4", "10.0.0.0/16"),
+			b:    newSecurityGroupRulePermission("ingress", "tcp", 443, 443, "cidr_ipv4", "10.1.0.0/16"),
+			want: false,
+		},
+		{
+			name: "IPv6 CIDR block",
+			a:    newSecurityGroupRulePermission("ingress", "tcp", 443, 443, "cidr_ipv6", "::/0"),
+			b:    newSecurityGroupRulePermission("ingress", "tcp", 443, 443, "cidr_ipv6", "2001:db8::/32"),
+			want: true,
+		},
+		{
+			name: "all protocols",
+			a:    newSecurityGroupRulePermission("egress", "-1", 0, 0, "cidr_ipv4", "0.0.0.0/0"),
+			b:    newSecurityGroupRulePermission("egress", "udp", 53, 53, "cidr_ipv4", "10.0.0.2/32"),
+			want: true,
+		},
+		{
+			name: "different protocols",
+			a:    newSecurityGroupRulePermission("ingress", "tcp", 0, 65535, "cidr_ipv4", "0.0.0.0/0"),
+			b:    newSecurityGroupRulePermission("ingress", "udp", 53, 53, "cidr_ipv4", "0.0.0.0/0"),
+			want: false,
+		},
+		{
+			name: "different rule types",
+			a:    newSecurityGroupRulePermission("egress", "-1", 0, 0, "cidr_ipv4", "0.0.0.0/0"),
+			b:    newSecurityGroupRulePermission("ingress", "tcp", 22, 22, "cidr_ipv4", "0.0.0.0/0"),
+			want: false,
+		},
+		{
+			name: "all ICMP types",
+			a:    newSecurityGroupRulePermission("ingress", "icmp", -1, -1, "cidr_ipv4", "0.0.0.0/0"),
+			b:    newSecurityGroupRulePermission("ingress", "icmp", 8, 0, "cidr_ipv4", "0.0.0.0/0"),
+			want: true,
+		},
+		{
+			name: "different ICMP types",
+			a:    newSecurityGroupRulePermission("ingress", "icmp", 0, -1, "cidr_ipv4", "0.0.0.0/0"),
+			b:    newSecurityGroupRulePermission("ingress", "icmp", 8, -1, "cidr_ipv4", "0.0.0.0/0"),
+			want: false,
+		},
+		{
+			name: "same security group",
+			a:    newSecurityGroupRulePermission("ingress", "tcp", 0, 65535, "referenced_security_group_id", "sg-1"),
+			b:    newSecurityGroupRulePermission("ingress", "tcp", 80, 80, "referenced_security_group_id", "sg-1"),
+			want: true,
+		},
+		{
+			name: "different peer types",
+			a:    newSecurityGroupRulePermission("ingress", "tcp", 80, 80, "cidr_ipv4", "0.0.0.0/0"),
+			b:    newSecurityGroupRulePermission("ingress", "tcp", 80, 80, "prefix_list_id", "pl-1"),
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.a.covers(testCase.b); got != testCase.want {
+				t.Errorf("covers = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestEffectiveSecurityGroupRules(t *testing.T) {
+	t.Parallel()
+
+	rules := []ownedSecurityGroupRule{
+		{newSecurityGroupRulePermission("ingress", "tcp", 443, 443, "cidr_ipv4", "10.1.0.0/16"), "sgr-1"},
+		{newSecurityGroupRulePermission("ingress", "tcp", 0, 65535, "cidr_ipv4", "10.0.0.0/8"), "sgr-2"},
+		{newSecurityGroupRulePermission("ingress", "tcp", 22, 22, "cidr_ipv4", "192.0.2.0/24"), "sgr-3"},
+		{newSecurityGroupRulePermission("ingress", "tcp", 22, 22, "cidr_ipv4", "192.0.2.0/24"), "sgr-4"},
+		{newSecurityGroupRulePermission("egress", "-1", 0, 0, "cidr_ipv4", "0.0.0.0/0"), "sgr-5"},
+		{newSecurityGroupRulePermission("egress", "tcp", 443, 443, "cidr_ipv4", "0.0.0.0/0"), "sgr-6"},
+	}
+
+	var got []string
+	for _, v := range effectiveSecurityGroupRules(rules) {
+		got = append(got, v.String()+" "+strings.Join(v.owners, ","))
+	}
+
+	want := []string{
+		"ingress tcp ports 0-65535 from 10.0.0.0/8 sgr-2,sgr-1",
+		"ingress tcp port 22 from 192.0.2.0/24 sgr-3,sgr-4",
+		"egress all traffic to 0.0.0.0/0 sgr-5,sgr-6",
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestSecurityGroupRuleConflicts(t *testing.T) {
+	t.Parallel()
+
+	rule := func(id string, egress bool, protocol string, fromPort, toPort int64, cidrIPv4 string) *ec2.SecurityGroupRule {
+		return &ec2.SecurityGroupRule{
+			CidrIpv4:            aws.String(cidrIPv4),
+			FromPort:            aws.Int64(fromPort),
+			IpProtocol:          aws.String(protocol),
+			IsEgress:            aws.Bool(egress),
+			SecurityGroupRuleId: aws.String(id),
+			ToPort:              aws.Int64(toPort),
+		}
+	}
+	https := func(cidrIPv4 string) securityGroupRulePermission {
+		return newSecurityGroupRulePermission("ingress", "tcp", 443, 443, "cidr_ipv4", cidrIPv4)
+	}
+	current := []*ec2.SecurityGroupRule{
+		rule("sgr-1", false, "tcp", 443, 443, "10.0.0.0/8"),
+		rule("sgr-2", false, "tcp", 443, 443, "10.1.0.0/16"),
+		rule("sgr-3", true, "-1", -1, -1, "0.0.0.0/0"),
+	}
+
+	type warning struct {
+		summary   string
+		resources []string
+	}
+
+	testCases := []struct {
+		name    string
+		planned func() *plannedSecurityGroupRules
+		want    []warning
+	}{
+		{
+			name: "inline rules",
+			planned: func() *plannedSecurityGroupRules {
+				return newPlannedSecurityGroupRules("aws_security_group sg-1", true, []string{"ingress"}, []securityGroupRulePermission{https("10.0.0.0/8")})
+			},
+			want: []warning{
+				{"Security group rules managed inline and by rule resources", []string{"aws_security_group sg-1", "sgr-2"}},
+			},
+		},
+		{
+			name: "existing rule resource",
+			planned: func() *plannedSecurityGroupRules {
+				planned := newPlannedSecurityGroupRules("aws_vpc_security_group_ingress_rule sgr-2", true, nil, []securityGroupRulePermission{https("10.1.0.0/16")})
+				planned.ruleID = "sgr-2"
+				return planned
+			},
+			want: []warning{
+				{"Shadowed security group rule", []string{"aws_vpc_security_group_ingress_rule sgr-2", "security group rule sgr-1"}},
+			},
+		},
+		{
+			name: "new duplicate rule",
+			planned: func() *plannedSecurityGroupRules {
+				return newPlannedSecurityGroupRules("aws_vpc_security_group_ingress_rule", false, nil, []securityGroupRulePermission{https("10.0.0.0/8")})
+			},
+			want: []warning{
+				{"Duplicate security group rule", []string{"aws_vpc_security_group_ingress_rule", "security group rule sgr-1"}},
+				{"Shadowed security group rule", []string{"aws_vpc_security_group_ingress_rule", "security group rule sgr-2"}},
+			},
+		},
+		{
+			name: "redundant rules in one resource",
+			planned: func() *plannedSecurityGroupRules {
+				return newPlannedSecurityGroupRules("aws_security_group_rule sgrule-1", true, nil, []securityGroupRulePermission{https("10.0.0.0/8"), https("10.1.0.0/16")})
+			},
+			want: []warning{
+				{"Shadowed security group rule", []string{"aws_security_group_rule sgrule-1"}},
+			},
+		},
+		{
+			name: "egress rule",
+			planned: func() *plannedSecurityGroupRules {
+				return newPlannedSecurityGroupRules("aws_vpc_security_group_egress_rule", false, nil, []securityGroupRulePermission{
+					newSecurityGroupRulePermission("egress", "tcp", 443, 443, "cidr_ipv4", "10.0.0.0/8"),
+				})
+			},
+			want: []warning{
+				{"Shadowed security group rule", []string{"aws_vpc_security_group_egress_rule", "security group rule sgr-3"}},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []warning
+			for _, v := range securityGroupRuleConflicts("sg-1", testCase.planned(), current) {
+				got = append(got, warning{v.summary, v.resources})
+			}
+
+			if diff := cmp.Diff(got, testCase.want, cmp.AllowUnexported(warning{})); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -7,7 +7,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 )
 
 // This is part of an experimental feature, do not use this as a starting point for tests
@@ -19,7 +19,7 @@ func TestAccClientVPNEndpoint_serial(t *testing.T) {
 	t.Parallel()
 
 	semaphore := tfsync.GetSemaphore("ClientVPN", "AWS_EC2_CLIENT_VPN_LIMIT", 5)
-	testCases := map[string]map[string]func(*testing.T, tfsync.Semaphore){
+	testCases := map[string]map[string]func(*testing.T, *tfsync.Semaphore){
 		"Endpoint": {
 			"basic":                        testAccClientVPNEndpoint_basic,
 			"disappears":                   testAccClientVPNEndpoint_disappears,
@@ -60,6 +60,6 @@ func TestAccClientVPNEndpoint_serial(t *testing.T) {
 	acctest.RunLimitedConcurrencyTests2Levels(t, semaphore, testCases)
 }
 
-func testAccPreCheckClientVPNSyncronize(t *testing.T, semaphore tfsync.Semaphore) {
+func testAccPreCheckClientVPNSyncronize(t *testing.T, semaphore *tfsync.Semaphore) {
 	tfsync.TestAccPreCheckSyncronize(t, semaphore, "Client VPN")
 }
@@ -487,7 +487,7 @@ func waitFileSystemAvailable(ctx context.Context, conn *efs.EFS, fileSystemID st
 		MinTimeout: fileSystemAvailableMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*efs.FileSystemDescription); ok {
 		return output, err
@@ -506,7 +506,7 @@ func waitFileSystemDeleted(ctx context.Context, conn *efs.EFS, fileSystemID stri
 		MinTimeout: fileSystemDeletedMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*efs.FileSystemDescription); ok {
 		return output, err
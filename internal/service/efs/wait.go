@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -29,7 +30,7 @@ func waitAccessPointCreated(ctx context.Context, conn *efs.EFS, accessPointId st
 		Timeout: accessPointCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*efs.AccessPointDescription); ok {
 		return output, err
@@ -47,7 +48,7 @@ func waitAccessPointDeleted(ctx context.Context, conn *efs.EFS, accessPointId st
 		Timeout: accessPointDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*efs.AccessPointDescription); ok {
 		return output, err
@@ -64,7 +65,7 @@ func waitBackupPolicyDisabled(ctx context.Context, conn *efs.EFS, id string) (*e
 		Timeout: backupPolicyDisabledTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*efs.BackupPolicy); ok {
 		return output, err
@@ -81,7 +82,7 @@ func waitBackupPolicyEnabled(ctx context.Context, conn *efs.EFS, id string) (*ef
 		Timeout: backupPolicyEnabledTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*efs.BackupPolicy); ok {
 		return output, err
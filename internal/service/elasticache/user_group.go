@@ -268,7 +268,7 @@ func waitUserGroupCreated(ctx context.Context, conn *elasticache.ElastiCache, id
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticache.UserGroup); ok {
 		return output, err
@@ -287,7 +287,7 @@ func waitUserGroupUpdated(ctx context.Context, conn *elasticache.ElastiCache, id
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticache.UserGroup); ok {
 		return output, err
@@ -306,7 +306,7 @@ func waitUserGroupDeleted(ctx context.Context, conn *elasticache.ElastiCache, id
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*elasticache.UserGroup); ok {
 		return output, err
@@ -11,6 +11,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -40,7 +41,7 @@ func WaitReplicationGroupAvailable(ctx context.Context, conn *elasticache.Elasti
 		Delay:      replicationGroupAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(*elasticache.ReplicationGroup); ok {
 		return v, err
 	}
@@ -62,7 +63,7 @@ func WaitReplicationGroupDeleted(ctx context.Context, conn *elasticache.ElastiCa
 		Delay:      replicationGroupDeletedDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(*elasticache.ReplicationGroup); ok {
 		return v, err
 	}
@@ -84,7 +85,7 @@ func WaitReplicationGroupMemberClustersAvailable(ctx context.Context, conn *elas
 		Delay:      cacheClusterAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.([]*elasticache.CacheCluster); ok {
 		return v, err
 	}
@@ -119,7 +120,7 @@ func waitCacheClusterAvailable(ctx context.Context, conn *elasticache.ElastiCach
 		Delay:      cacheClusterAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(*elasticache.CacheCluster); ok {
 		return v, err
 	}
@@ -149,7 +150,7 @@ func waitServerlessCacheAvailable(ctx context.Context, conn *elasticache_v2.Clie
 		Delay:      ServerlessCacheAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(awstypes.ServerlessCache); ok {
 		return v, err
 	}
@@ -171,7 +172,7 @@ func waitServerlessCacheDeleted(ctx context.Context, conn *elasticache_v2.Client
 		Delay:      ServerlessCacheDeletedDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(awstypes.ServerlessCache); ok {
 		return v, err
 	}
@@ -197,7 +198,7 @@ func WaitCacheClusterDeleted(ctx context.Context, conn *elasticache.ElastiCache,
 		Delay:      cacheClusterDeletedDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(*elasticache.CacheCluster); ok {
 		return v, err
 	}
@@ -228,7 +229,7 @@ func waitGlobalReplicationGroupAvailable(ctx context.Context, conn *elasticache.
 		Delay:      globalReplicationGroupAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroup); ok {
 		return v, err
 	}
@@ -251,7 +252,7 @@ func waitGlobalReplicationGroupDeleted(ctx context.Context, conn *elasticache.El
 		Delay:      globalReplicationGroupDeletedDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroup); ok {
 		return v, err
 	}
@@ -282,7 +283,7 @@ func waitGlobalReplicationGroupMemberDetached(ctx context.Context, conn *elastic
 		Delay:      globalReplicationGroupDisassociationDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroupMember); ok {
 		return v, err
 	}
@@ -13,14 +13,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_evidently_project", &resource.Sweeper{
+	sweep.AddTestSweepers(names.Evidently, "aws_evidently_project", &resource.Sweeper{
 		Name: "aws_evidently_project",
 		F:    sweepProjects,
 	})
-	resource.AddTestSweepers("aws_evidently_segment", &resource.Sweeper{
+	sweep.AddTestSweepers(names.Evidently, "aws_evidently_segment", &resource.Sweeper{
 		Name: "aws_evidently_segment",
 		F:    sweepSegments,
 	})
@@ -252,7 +252,7 @@ func waitKxScalingGroupCreated(ctx context.Context, conn *finspace.Client, id st
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxScalingGroupOutput); ok {
 		return out, err
 	}
@@ -268,7 +268,7 @@ func waitKxScalingGroupDeleted(ctx context.Context, conn *finspace.Client, id st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*finspace.GetKxScalingGroupOutput); ok {
 		return out, err
 	}
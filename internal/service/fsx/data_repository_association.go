@@ -455,7 +455,7 @@ func waitDataRepositoryAssociationCreated(ctx context.Context, conn *fsx.FSx, id
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.DataRepositoryAssociation); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.DataRepositoryLifecycleFailed && details != nil {
@@ -477,7 +477,7 @@ func waitDataRepositoryAssociationUpdated(ctx context.Context, conn *fsx.FSx, id
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.DataRepositoryAssociation); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.DataRepositoryLifecycleFailed && details != nil {
@@ -499,7 +499,7 @@ func waitDataRepositoryAssociationDeleted(ctx context.Context, conn *fsx.FSx, id
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*fsx.DataRepositoryAssociation); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.DataRepositoryLifecycleFailed && details != nil {
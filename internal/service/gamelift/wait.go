@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -27,7 +28,7 @@ func waitBuildReady(ctx context.Context, conn *gamelift.GameLift, id string) (*g
 		Timeout: buildReadyTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*gamelift.Build); ok {
 		return output, err
@@ -50,7 +51,7 @@ func waitFleetActive(ctx context.Context, conn *gamelift.GameLift, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*gamelift.FleetAttributes); ok {
 		return output, err
@@ -72,7 +73,7 @@ func waitFleetTerminated(ctx context.Context, conn *gamelift.GameLift, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if err != nil {
 		events, fErr := getFleetFailures(ctx, conn, id)
@@ -161,7 +162,7 @@ func waitGameServerGroupActive(ctx context.Context, conn *gamelift.GameLift, nam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*gamelift.GameServerGroup); ok {
 		return output, err
@@ -181,7 +182,7 @@ func waitGameServerGroupTerminated(ctx context.Context, conn *gamelift.GameLift,
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
 		return nil
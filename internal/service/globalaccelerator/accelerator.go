@@ -414,7 +414,7 @@ func waitAcceleratorDeployed(ctx context.Context, conn *globalaccelerator.Global
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*globalaccelerator.Accelerator); ok {
 		return output, err
@@ -403,7 +403,7 @@ func waitCustomRoutingAcceleratorDeployed(ctx context.Context, conn *globalaccel
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*globalaccelerator.CustomRoutingAccelerator); ok {
 		return output, err
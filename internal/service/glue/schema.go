@@ -78,8 +78,9 @@ func ResourceSchema() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(glue.DataFormat_Values(), false),
 			},
 			"schema_definition": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONOrYAMLDiffs,
 				ValidateFunc: validation.All(
 					validation.StringLenBetween(1, 170000),
 					validation.StringMatch(regexache.MustCompile(`.*\S.*`), ""),
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_iam_policy_evaluation", name="Policy Evaluation")
+func dataSourcePolicyEvaluation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			"action_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: `One or more names of actions, like "iam:CreateUser", that should be evaluated.`,
+			},
+			"caller_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				Description:  `ARN of the calling principal. If specified, the Principal and NotPrincipal elements of policy statements are evaluated, otherwise they are ignored.`,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The key name of the context entry, such as "aws:CurrentTime".`,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: `One or more values to assign to the context key.`,
+						},
+					},
+				},
+				Description: `Each block specifies one item of additional context entry to include in the evaluated requests. These are the additional properties used in the 'Condition' element of an IAM policy, and in policy variables.`,
+			},
+			"permissions_boundary_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Permissions boundary policies to use in the evaluation.`,
+			},
+			"policies_json": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Identity-based policies to use in the evaluation.`,
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+				Description: `ARNs of specific resources to use as the targets of the specified actions during evaluation. If not specified, "*" is assumed, which only matches statements that apply to all resources.`,
+			},
+
+			// Result Attributes
+			"all_allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `A summary of the results attribute which is true if all of the results have decision "allowed", and false otherwise.`,
+			},
+			"results": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the action whose evaluation this result is describing.`,
+						},
+						"allowed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `A summary of attribute "decision" which is true only if the decision is "allowed".`,
+						},
+						"decision": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The decision keyword, as returned by the policy simulator: "allowed", "explicitDeny", or "implicitDeny".`,
+						},
+						"matched_statements": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_policy_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Identifier of one of the policies used as input to the evaluation.`,
+									},
+									"statement_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The Sid of the matched statement, or its 1-based position in the policy if it has no Sid.`,
+									},
+								},
+							},
+							Description: `Detail about which specific policy statements determined this result.`,
+						},
+						"missing_context_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: `Set of context entry keys that were needed for one or more of the relevant policies but not included in the request.`,
+						},
+						"resource_arn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `ARN of the resource that the action was evaluated against.`,
+						},
+					},
+				},
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Do not use`,
+			},
+		},
+	}
+}
+
+func dataSourcePolicyEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	evaluator, err := iampolicy.NewEvaluator(flex.ExpandStringValueList(d.Get("policies_json").([]interface{})), flex.ExpandStringValueList(d.Get("permissions_boundary_policies_json").([]interface{})))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "evaluating IAM Policies: %s", err)
+	}
+
+	requestContext := make(map[string][]string)
+	for _, entryRaw := range d.Get("context").(*schema.Set).List() {
+		entryRaw := entryRaw.(map[string]interface{})
+		requestContext[entryRaw["key"].(string)] = flex.ExpandStringValueList(entryRaw["values"].([]interface{}))
+	}
+
+	resourceARNs := flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set))
+	if len(resourceARNs) == 0 {
+		resourceARNs = []string{"*"}
+	}
+
+	// "all" are allowed only if there is at least one result and no other
+	// results were denied.
+	allowedCount := 0
+	deniedCount := 0
+
+	var rawResults []interface{}
+	for _, actionName := range flex.ExpandStringValueSet(d.Get("action_names").(*schema.Set)) {
+		for _, resourceARN := range resourceARNs {
+			result, err := evaluator.Evaluate(&iampolicy.Request{
+				Action:    actionName,
+				Resource:  resourceARN,
+				CallerARN: d.Get("caller_arn").(string),
+				Context:   requestContext,
+			})
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "evaluating IAM Policies: %s", err)
+			}
+
+			if result.Allowed() {
+				allowedCount++
+			} else {
+				deniedCount++
+			}
+
+			rawMatchedStmts := make([]interface{}, len(result.MatchedStatements))
+			for i, stmt := range result.MatchedStatements {
+				rawMatchedStmts[i] = map[string]interface{}{
+					"source_policy_id": stmt.SourcePolicyID,
+					"statement_id":     stmt.StatementID,
+				}
+			}
+
+			rawResults = append(rawResults, map[string]interface{}{
+				"action_name":          actionName,
+				"allowed":              result.Allowed(),
+				"decision":             result.Decision,
+				"matched_statements":   rawMatchedStmts,
+				"missing_context_keys": result.MissingContextKeys,
+				"resource_arn":         resourceARN,
+			})
+		}
+	}
+	d.Set("results", rawResults)
+	d.Set("all_allowed", allowedCount > 0 && deniedCount == 0)
+
+	d.SetId("-")
+
+	return diags
+}
@@ -25,10 +25,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	sweep.AddTestSweepers(names.IAM, "aws_iam_group", &resource.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -36,13 +37,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	sweep.Register("aws_iam_instance_profile", sweepInstanceProfile,
+	sweep.Register(names.IAM, "aws_iam_instance_profile", sweepInstanceProfile,
 		"aws_iam_role",
 	)
 
-	sweep.Register("aws_iam_openid_connect_provider", sweepOpenIDConnectProvider)
+	sweep.Register(names.IAM, "aws_iam_openid_connect_provider", sweepOpenIDConnectProvider)
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.AddTestSweepers(names.IAM, "aws_iam_policy", &resource.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -54,7 +55,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddTestSweepers(names.IAM, "aws_iam_role", &resource.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
@@ -85,20 +86,20 @@ func RegisterSweepers() {
 		F: sweepRoles,
 	})
 
-	sweep.Register("aws_iam_saml_provider", sweepSAMLProvider)
+	sweep.Register(names.IAM, "aws_iam_saml_provider", sweepSAMLProvider)
 
-	sweep.Register("aws_iam_service_specific_credential", sweepServiceSpecificCredentials)
+	sweep.Register(names.IAM, "aws_iam_service_specific_credential", sweepServiceSpecificCredentials)
 
-	sweep.Register("aws_iam_signing_certificate", sweepSigningCertificates)
+	sweep.Register(names.IAM, "aws_iam_signing_certificate", sweepSigningCertificates)
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers(names.IAM, "aws_iam_server_certificate", &resource.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
-	sweep.Register("aws_iam_service_linked_role", sweepServiceLinkedRoles)
+	sweep.Register(names.IAM, "aws_iam_service_linked_role", sweepServiceLinkedRoles)
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers(names.IAM, "aws_iam_user", &resource.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -109,7 +110,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	sweep.Register("aws_iam_virtual_mfa_device", sweepVirtualMFADevice)
+	sweep.Register(names.IAM, "aws_iam_virtual_mfa_device", sweepVirtualMFADevice)
 }
 
 func sweepGroups(region string) error {
@@ -42,7 +42,7 @@ func waitRoleARNIsNotUniqueID(ctx context.Context, conn *iam.Client, id string,
 		ContinuousTargetOccurence: 5,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Role); ok {
 		return output, err
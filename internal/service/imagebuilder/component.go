@@ -46,12 +46,13 @@ func ResourceComponent() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 128),
 			},
 			"data": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ForceNew:     true,
-				ExactlyOneOf: []string{"data", "uri"},
-				ValidateFunc: validation.StringLenBetween(1, 16000),
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ExactlyOneOf:     []string{"data", "uri"},
+				ValidateFunc:     validation.StringLenBetween(1, 16000),
+				DiffSuppressFunc: verify.SuppressEquivalentJSONOrYAMLDiffs,
 			},
 			"date_created": {
 				Type:     schema.TypeString,
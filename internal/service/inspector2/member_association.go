@@ -176,7 +176,7 @@ func waitMemberAssociationCreated(ctx context.Context, conn *inspector2.Client,
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	return err
 }
 
@@ -188,7 +188,7 @@ func waitMemberAssociationDeleted(ctx context.Context, conn *inspector2.Client,
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	return err
 }
 
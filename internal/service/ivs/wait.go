@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ivs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitPlaybackKeyPairCreated(ctx context.Context, conn *ivs.IVS, id string, timeout time.Duration) (*ivs.PlaybackKeyPair, error) {
@@ -21,7 +22,7 @@ func waitPlaybackKeyPairCreated(ctx context.Context, conn *ivs.IVS, id string, t
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivs.PlaybackKeyPair); ok {
 		return out, err
 	}
@@ -37,7 +38,7 @@ func waitPlaybackKeyPairDeleted(ctx context.Context, conn *ivs.IVS, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivs.PlaybackKeyPair); ok {
 		return out, err
 	}
@@ -55,7 +56,7 @@ func waitRecordingConfigurationCreated(ctx context.Context, conn *ivs.IVS, id st
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivs.RecordingConfiguration); ok {
 		return out, err
 	}
@@ -71,7 +72,7 @@ func waitRecordingConfigurationDeleted(ctx context.Context, conn *ivs.IVS, id st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivs.RecordingConfiguration); ok {
 		return out, err
 	}
@@ -89,7 +90,7 @@ func waitChannelCreated(ctx context.Context, conn *ivs.IVS, id string, timeout t
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivs.Channel); ok {
 		return out, err
 	}
@@ -107,7 +108,7 @@ func waitChannelUpdated(ctx context.Context, conn *ivs.IVS, id string, timeout t
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivs.Channel); ok {
 		return out, err
 	}
@@ -123,7 +124,7 @@ func waitChannelDeleted(ctx context.Context, conn *ivs.IVS, id string, timeout t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivs.Channel); ok {
 		return out, err
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ivschat/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitLoggingConfigurationCreated(ctx context.Context, conn *ivschat.Client, id string, timeout time.Duration) (*ivschat.GetLoggingConfigurationOutput, error) {
@@ -23,7 +24,7 @@ func waitLoggingConfigurationCreated(ctx context.Context, conn *ivschat.Client,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivschat.GetLoggingConfigurationOutput); ok {
 		return out, err
 	}
@@ -41,7 +42,7 @@ func waitLoggingConfigurationUpdated(ctx context.Context, conn *ivschat.Client,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivschat.GetLoggingConfigurationOutput); ok {
 		return out, err
 	}
@@ -57,7 +58,7 @@ func waitLoggingConfigurationDeleted(ctx context.Context, conn *ivschat.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivschat.GetLoggingConfigurationOutput); ok {
 		return out, err
 	}
@@ -75,7 +76,7 @@ func waitRoomUpdated(ctx context.Context, conn *ivschat.Client, id string, timeo
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivschat.GetRoomOutput); ok {
 		return out, err
 	}
@@ -91,7 +92,7 @@ func waitRoomDeleted(ctx context.Context, conn *ivschat.Client, id string, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivschat.GetRoomOutput); ok {
 		return out, err
 	}
@@ -109,7 +110,7 @@ func waitRoomCreated(ctx context.Context, conn *ivschat.Client, id string, timeo
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*ivschat.GetRoomOutput); ok {
 		return out, err
 	}
@@ -556,7 +556,7 @@ func waitStreamCreated(ctx context.Context, conn *kinesis.Client, name string, t
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.StreamDescriptionSummary); ok {
 		return output, err
@@ -575,7 +575,7 @@ func waitStreamDeleted(ctx context.Context, conn *kinesis.Client, name string, t
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.StreamDescriptionSummary); ok {
 		return output, err
@@ -594,7 +594,7 @@ func waitStreamUpdated(ctx context.Context, conn *kinesis.Client, name string, t
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.StreamDescriptionSummary); ok {
 		return output, err
@@ -29,7 +29,7 @@ func waitApplicationDeleted(ctx context.Context, conn *kinesisanalytics.KinesisA
 		Timeout: applicationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalytics.ApplicationDetail); ok {
 		return v, err
@@ -47,7 +47,7 @@ func waitApplicationStarted(ctx context.Context, conn *kinesisanalytics.KinesisA
 		Timeout: applicationStartedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalytics.ApplicationDetail); ok {
 		return v, err
@@ -65,7 +65,7 @@ func waitApplicationStopped(ctx context.Context, conn *kinesisanalytics.KinesisA
 		Timeout: applicationStoppedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalytics.ApplicationDetail); ok {
 		return v, err
@@ -83,7 +83,7 @@ func waitApplicationUpdated(ctx context.Context, conn *kinesisanalytics.KinesisA
 		Timeout: applicationUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalytics.ApplicationDetail); ok {
 		return v, err
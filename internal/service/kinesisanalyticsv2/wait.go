@@ -22,7 +22,7 @@ func waitApplicationDeleted(ctx context.Context, conn *kinesisanalyticsv2.Kinesi
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalyticsv2.ApplicationDetail); ok {
 		return v, err
@@ -40,7 +40,7 @@ func waitApplicationStarted(ctx context.Context, conn *kinesisanalyticsv2.Kinesi
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalyticsv2.ApplicationDetail); ok {
 		return v, err
@@ -58,7 +58,7 @@ func waitApplicationStopped(ctx context.Context, conn *kinesisanalyticsv2.Kinesi
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalyticsv2.ApplicationDetail); ok {
 		return v, err
@@ -76,7 +76,7 @@ func waitApplicationUpdated(ctx context.Context, conn *kinesisanalyticsv2.Kinesi
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalyticsv2.ApplicationDetail); ok {
 		return v, err
@@ -142,7 +142,7 @@ func waitSnapshotCreated(ctx context.Context, conn *kinesisanalyticsv2.KinesisAn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalyticsv2.SnapshotDetails); ok {
 		return v, err
@@ -160,7 +160,7 @@ func waitSnapshotDeleted(ctx context.Context, conn *kinesisanalyticsv2.KinesisAn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalyticsv2.SnapshotDetails); ok {
 		return v, err
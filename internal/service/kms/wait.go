@@ -57,7 +57,7 @@ func WaitKeyDeleted(ctx context.Context, conn *kms.KMS, id string) (*kms.KeyMeta
 		Timeout: KeyDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kms.KeyMetadata); ok {
 		return output, err
@@ -96,7 +96,7 @@ func WaitKeyMaterialImported(ctx context.Context, conn *kms.KMS, id string) (*km
 		Timeout: KeyMaterialImportedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kms.KeyMetadata); ok {
 		return output, err
@@ -211,7 +211,7 @@ func WaitReplicaExternalKeyCreated(ctx context.Context, conn *kms.KMS, id string
 		Timeout: ReplicaExternalKeyCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kms.KeyMetadata); ok {
 		return output, err
@@ -228,7 +228,7 @@ func WaitReplicaKeyCreated(ctx context.Context, conn *kms.KMS, id string) (*kms.
 		Timeout: ReplicaKeyCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kms.KeyMetadata); ok {
 		return output, err
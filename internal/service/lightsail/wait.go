@@ -50,7 +50,7 @@ func waitOperation(ctx context.Context, conn *lightsail.Client, oid *string) err
 		MinTimeout: OperationMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if _, ok := outputRaw.(*lightsail.GetOperationOutput); ok {
 		return err
@@ -70,7 +70,7 @@ func waitDatabaseModified(ctx context.Context, conn *lightsail.Client, db *strin
 		MinTimeout: DatabaseMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lightsail.GetRelationalDatabaseOutput); ok {
 		return output, err
@@ -91,7 +91,7 @@ func waitDatabaseBackupRetentionModified(ctx context.Context, conn *lightsail.Cl
 		MinTimeout: DatabaseMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if _, ok := outputRaw.(*lightsail.GetRelationalDatabaseOutput); ok {
 		return err
@@ -110,7 +110,7 @@ func waitDatabasePubliclyAccessibleModified(ctx context.Context, conn *lightsail
 		MinTimeout: DatabaseMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if _, ok := outputRaw.(*lightsail.GetRelationalDatabaseOutput); ok {
 		return err
@@ -129,7 +129,7 @@ func waitContainerServiceCreated(ctx context.Context, conn *lightsail.Client, se
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ContainerService); ok {
 		if detail := output.StateDetail; detail != nil {
@@ -152,7 +152,7 @@ func waitContainerServiceDisabled(ctx context.Context, conn *lightsail.Client, s
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ContainerService); ok {
 		if detail := output.StateDetail; detail != nil {
@@ -175,7 +175,7 @@ func waitContainerServiceUpdated(ctx context.Context, conn *lightsail.Client, se
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ContainerService); ok {
 		if detail := output.StateDetail; detail != nil {
@@ -198,7 +198,7 @@ func waitContainerServiceDeleted(ctx context.Context, conn *lightsail.Client, se
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ContainerService); ok {
 		if detail := output.StateDetail; detail != nil {
@@ -221,7 +221,7 @@ func waitContainerServiceDeploymentVersionActive(ctx context.Context, conn *ligh
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ContainerServiceDeployment); ok {
 		if output.State == types.ContainerServiceDeploymentStateFailed {
@@ -244,7 +244,7 @@ func waitInstanceState(ctx context.Context, conn *lightsail.Client, id *string)
 		MinTimeout: OperationMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if out, ok := outputRaw.(*lightsail.GetInstanceStateOutput); ok {
 		return out, err
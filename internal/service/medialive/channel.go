@@ -1038,7 +1038,7 @@ func waitChannelCreated(ctx context.Context, conn *medialive.Client, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
 		return out, err
 	}
@@ -1056,7 +1056,7 @@ func waitChannelUpdated(ctx context.Context, conn *medialive.Client, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
 		return out, err
 	}
@@ -1072,7 +1072,7 @@ func waitChannelDeleted(ctx context.Context, conn *medialive.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
 		return out, err
 	}
@@ -1088,7 +1088,7 @@ func waitChannelStarted(ctx context.Context, conn *medialive.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
 		return out, err
 	}
@@ -1104,7 +1104,7 @@ func waitChannelStopped(ctx context.Context, conn *medialive.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
 		return out, err
 	}
@@ -282,7 +282,7 @@ func waitMultiplexCreated(ctx context.Context, conn *medialive.Client, id string
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
@@ -301,7 +301,7 @@ func waitMultiplexUpdated(ctx context.Context, conn *medialive.Client, id string
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
@@ -317,7 +317,7 @@ func waitMultiplexDeleted(ctx context.Context, conn *medialive.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
@@ -333,7 +333,7 @@ func waitMultiplexRunning(ctx context.Context, conn *medialive.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
@@ -349,7 +349,7 @@ func waitMultiplexStopped(ctx context.Context, conn *medialive.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
 		return out, err
 	}
@@ -321,7 +321,7 @@ func waitClusterEndpointAvailable(ctx context.Context, conn *neptune.Neptune, cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*neptune.DBClusterEndpoint); ok {
 		return output, err
@@ -341,7 +341,7 @@ func waitClusterEndpointDeleted(ctx context.Context, conn *neptune.Neptune, clus
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*neptune.DBClusterEndpoint); ok {
 		return output, err
@@ -666,7 +666,7 @@ func waitRuleGroupDeleted(ctx context.Context, conn *networkfirewall.NetworkFire
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkfirewall.RuleGroup); ok {
 		return output, err
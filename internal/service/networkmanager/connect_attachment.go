@@ -337,7 +337,7 @@ func waitConnectAttachmentCreated(ctx context.Context, conn *networkmanager.Netw
 		Refresh: statusConnectAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.ConnectAttachment); ok {
 		return output, err
@@ -355,7 +355,7 @@ func waitConnectAttachmentDeleted(ctx context.Context, conn *networkmanager.Netw
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.ConnectAttachment); ok {
 		return output, err
@@ -372,7 +372,7 @@ func waitConnectAttachmentAvailable(ctx context.Context, conn *networkmanager.Ne
 		Refresh: statusConnectAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.ConnectAttachment); ok {
 		return output, err
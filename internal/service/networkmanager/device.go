@@ -425,7 +425,7 @@ func waitDeviceCreated(ctx context.Context, conn *networkmanager.NetworkManager,
 		Refresh: statusDeviceState(ctx, conn, globalNetworkID, deviceID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Device); ok {
 		return output, err
@@ -442,7 +442,7 @@ func waitDeviceDeleted(ctx context.Context, conn *networkmanager.NetworkManager,
 		Refresh: statusDeviceState(ctx, conn, globalNetworkID, deviceID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Device); ok {
 		return output, err
@@ -459,7 +459,7 @@ func waitDeviceUpdated(ctx context.Context, conn *networkmanager.NetworkManager,
 		Refresh: statusDeviceState(ctx, conn, globalNetworkID, deviceID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Device); ok {
 		return output, err
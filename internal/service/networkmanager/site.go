@@ -347,7 +347,7 @@ func waitSiteCreated(ctx context.Context, conn *networkmanager.NetworkManager, g
 		Refresh: statusSiteState(ctx, conn, globalNetworkID, siteID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Site); ok {
 		return output, err
@@ -364,7 +364,7 @@ func waitSiteDeleted(ctx context.Context, conn *networkmanager.NetworkManager, g
 		Refresh: statusSiteState(ctx, conn, globalNetworkID, siteID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Site); ok {
 		return output, err
@@ -381,7 +381,7 @@ func waitSiteUpdated(ctx context.Context, conn *networkmanager.NetworkManager, g
 		Refresh: statusSiteState(ctx, conn, globalNetworkID, siteID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Site); ok {
 		return output, err
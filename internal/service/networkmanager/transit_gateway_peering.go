@@ -237,7 +237,7 @@ func waitTransitGatewayPeeringCreated(ctx context.Context, conn *networkmanager.
 		Refresh: StatusTransitGatewayPeeringState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.TransitGatewayPeering); ok {
 		return output, err
@@ -254,7 +254,7 @@ func waitTransitGatewayPeeringDeleted(ctx context.Context, conn *networkmanager.
 		Refresh: StatusTransitGatewayPeeringState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.TransitGatewayPeering); ok {
 		return output, err
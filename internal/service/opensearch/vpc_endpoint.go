@@ -299,7 +299,7 @@ func waitVPCEndpointCreated(ctx context.Context, conn *opensearchservice.OpenSea
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -312,7 +312,7 @@ func waitVPCEndpointUpdated(ctx context.Context, conn *opensearchservice.OpenSea
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -325,7 +325,7 @@ func waitVPCEndpointDeleted(ctx context.Context, conn *opensearchservice.OpenSea
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
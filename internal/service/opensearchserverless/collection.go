@@ -338,7 +338,7 @@ func waitCollectionCreated(ctx context.Context, conn *opensearchserverless.Clien
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CollectionDetail); ok {
 		return output, err
@@ -357,7 +357,7 @@ func waitCollectionDeleted(ctx context.Context, conn *opensearchserverless.Clien
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CollectionDetail); ok {
 		return output, err
@@ -406,7 +406,7 @@ func waitAccountCreated(ctx context.Context, conn *organizations.Organizations,
 		Timeout:      5 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*organizations.CreateAccountStatus); ok {
 		if state := aws.StringValue(output.State); state == organizations.CreateAccountStateFailed {
@@ -444,7 +444,7 @@ func waitAccountDeleted(ctx context.Context, conn *organizations.Organizations,
 		Timeout:      5 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*organizations.Account); ok {
 		return output, err
@@ -605,7 +605,7 @@ func waitDefaultRootPolicyTypeDisabled(ctx context.Context, conn *organizations.
 		Timeout: 5 * time.Minute,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -618,7 +618,7 @@ func waitDefaultRootPolicyTypeEnabled(ctx context.Context, conn *organizations.O
 		Timeout: 5 * time.Minute,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -215,7 +215,7 @@ func waitPrincipalAssociationCreated(ctx context.Context, conn *ram.RAM, resourc
 		NotFoundChecks: 20,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ram.ResourceShareAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
@@ -237,7 +237,7 @@ func waitPrincipalAssociationDeleted(ctx context.Context, conn *ram.RAM, resourc
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShareAssociation); ok {
 		return v, err
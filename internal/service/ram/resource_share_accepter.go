@@ -387,7 +387,7 @@ func waitResourceShareInvitationAccepted(ctx context.Context, conn *ram.RAM, arn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShareInvitation); ok {
 		return v, err
@@ -404,7 +404,7 @@ func waitResourceShareOwnedBySelfDisassociated(ctx context.Context, conn *ram.RA
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ram.ResourceShare); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
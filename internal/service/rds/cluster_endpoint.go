@@ -259,7 +259,7 @@ func waitClusterEndpointCreated(ctx context.Context, conn *rds.RDS, id string, t
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBClusterEndpoint); ok {
 		return output, err
@@ -278,7 +278,7 @@ func waitClusterEndpointDeleted(ctx context.Context, conn *rds.RDS, id string, t
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBClusterEndpoint); ok {
 		return output, err
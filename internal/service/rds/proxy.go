@@ -365,7 +365,7 @@ func waitDBProxyCreated(ctx context.Context, conn *rds.Client, name string, time
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBProxy); ok {
 		return output, err
@@ -382,7 +382,7 @@ func waitDBProxyDeleted(ctx context.Context, conn *rds.Client, name string, time
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBProxy); ok {
 		return output, err
@@ -399,7 +399,7 @@ func waitDBProxyUpdated(ctx context.Context, conn *rds.Client, name string, time
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBProxy); ok {
 		return output, err
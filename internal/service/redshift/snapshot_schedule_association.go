@@ -211,7 +211,7 @@ func WaitSnapshotScheduleAssociationCreated(ctx context.Context, conn *redshift.
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshift.ClusterAssociatedToSchedule); ok {
 		return output, err
@@ -232,7 +232,7 @@ func waitSnapshotScheduleAssociationDeleted(ctx context.Context, conn *redshift.
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshift.ClusterAssociatedToSchedule); ok {
 		return output, err
@@ -29,7 +29,7 @@ func waitClusterCreated(ctx context.Context, conn *redshift.Redshift, id string,
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshift.Cluster); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ClusterStatus)))
@@ -48,7 +48,7 @@ func waitClusterDeleted(ctx context.Context, conn *redshift.Redshift, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshift.Cluster); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ClusterStatus)))
@@ -67,7 +67,7 @@ func waitClusterUpdated(ctx context.Context, conn *redshift.Redshift, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshift.Cluster); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ClusterStatus)))
@@ -86,7 +86,7 @@ func waitClusterRelocationStatusResolved(ctx context.Context, conn *redshift.Red
 		Timeout: clusterRelocationStatusResolvedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshift.Cluster); ok {
 		return output, err
@@ -104,7 +104,7 @@ func waitClusterRebooted(ctx context.Context, conn *redshift.Redshift, id string
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshift.Cluster); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ClusterStatus)))
@@ -124,7 +124,7 @@ func waitClusterAquaApplied(ctx context.Context, conn *redshift.Redshift, id str
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshift.Cluster); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ClusterStatus)))
@@ -145,7 +145,7 @@ func waitEndpointAccessActive(ctx context.Context, conn *redshift.Redshift, id s
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshift.EndpointAccess); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.EndpointStatus)))
@@ -166,7 +166,7 @@ func waitEndpointAccessDeleted(ctx context.Context, conn *redshift.Redshift, id
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshift.EndpointAccess); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.EndpointStatus)))
@@ -187,7 +187,7 @@ func waitClusterSnapshotCreated(ctx context.Context, conn *redshift.Redshift, id
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshift.Snapshot); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status)))
@@ -208,7 +208,7 @@ func waitClusterSnapshotDeleted(ctx context.Context, conn *redshift.Redshift, id
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshift.Snapshot); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status)))
@@ -246,7 +246,7 @@ func waitStatementFinished(ctx context.Context, conn *redshiftdata.Client, id st
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftdata.DescribeStatementOutput); ok {
 		if status := output.Status; status == types.StatusStringFailed {
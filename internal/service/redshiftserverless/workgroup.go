@@ -537,7 +537,7 @@ func waitWorkgroupAvailable(ctx context.Context, conn *redshiftserverless.Redshi
 		Timeout: wait,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftserverless.Workgroup); ok {
 		return output, err
@@ -554,7 +554,7 @@ func waitWorkgroupDeleted(ctx context.Context, conn *redshiftserverless.Redshift
 		Timeout: wait,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshiftserverless.Workgroup); ok {
 		return output, err
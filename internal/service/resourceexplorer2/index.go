@@ -312,7 +312,7 @@ func waitIndexCreated(ctx context.Context, conn *resourceexplorer2.Client, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*resourceexplorer2.GetIndexOutput); ok {
 		return output, err
@@ -329,7 +329,7 @@ func waitIndexUpdated(ctx context.Context, conn *resourceexplorer2.Client, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*resourceexplorer2.GetIndexOutput); ok {
 		return output, err
@@ -346,7 +346,7 @@ func waitIndexDeleted(ctx context.Context, conn *resourceexplorer2.Client, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*resourceexplorer2.GetIndexOutput); ok {
 		return output, err
@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// changeBatchMaxChanges is the maximum number of changes in a single ChangeResourceRecordSets request.
+	changeBatchMaxChanges = 1000
+	// changeBatchMaxValues is the maximum number of resource record values in a single ChangeResourceRecordSets request.
+	changeBatchMaxValues = 1000
+)
+
+// recordChangeBatcher coalesces the resource record set changes made by all resources.
+var recordChangeBatcher = newChangeBatcher(ChangeResourceRecordSets, func(ctx context.Context, conn *route53.Route53, changeID string) error {
+	return WaitForRecordSetToSync(ctx, conn, CleanChangeID(changeID))
+})
+
+// changeSyncError is returned when a change batch was, or may have been, accepted but didn't finish propagating.
+type changeSyncError struct {
+	changeID string
+	err      error
+}
+
+func (e *changeSyncError) Error() string {
+	if e.changeID == "" {
+		return fmt.Sprintf("waiting for change to sync: %s", e.err)
+	}
+
+	return fmt.Sprintf("waiting for change (%s) to sync: %s", e.changeID, e.err)
+}
+
+func (e *changeSyncError) Unwrap() error {
+	return e.err
+}
+
+type changeFunc func(context.Context, *route53.Route53, *route53.ChangeResourceRecordSetsInput) (*route53.ChangeInfo, error)
+type changeWaitFunc func(context.Context, *route53.Route53, string) error
+
+// changeBatcher coalesces concurrent resource record set changes for the same hosted zone.
+// Changes to a hosted zone with no batch in flight are sent immediately. Changes submitted while a batch is in flight
+// are queued and sent in a single ChangeResourceRecordSets request, and the resulting change is waited on once,
+// when the batch in flight completes.
+type changeBatcher struct {
+	change changeFunc
+	wait   changeWaitFunc
+
+	mu    sync.Mutex
+	zones map[changeBatchKey]*changeZone
+}
+
+type changeBatchKey struct {
+	conn   *route53.Route53
+	zoneID string
+}
+
+// changeZone is the state of a hosted zone with a batch in flight.
+type changeZone struct {
+	// queue holds the batches waiting for the batch in flight to complete.
+	queue []*changeBatch
+}
+
+type changeBatch struct {
+	key      changeBatchKey
+	requests []*changeRequest
+	records  map[string]struct{}
+	size     int
+	values   int
+}
+
+type changeRequest struct {
+	ctx     context.Context
+	changes []*route53.Change
+	done    chan error
+}
+
+func newChangeBatcher(change changeFunc, wait changeWaitFunc) *changeBatcher {
+	return &changeBatcher{
+		change: change,
+		wait:   wait,
+		zones:  make(map[changeBatchKey]*changeZone),
+	}
+}
+
+// Submit sends changes to the hosted zone, coalescing them with other callers' changes while the zone has a batch
+// in flight, and blocks until the changes have been applied and are INSYNC.
+// The changes are applied atomically. If a batch containing changes from more than one caller is rejected,
+// the callers' changes are retried, one smaller batch at a time, until errors are attributed to the callers that caused them.
+// Errors waiting for an accepted batch to sync are of type *changeSyncError.
+// If ctx is done before the batch completes the changes may still be applied, so the error is also of type *changeSyncError.
+func (b *changeBatcher) Submit(ctx context.Context, conn *route53.Route53, zoneID string, changes []*route53.Change) error {
+	req := &changeRequest{
+		ctx:     ctx,
+		changes: changes,
+		done:    make(chan error, 1),
+	}
+
+	b.enqueue(changeBatchKey{conn: conn, zoneID: zoneID}, req)
+
+	select {
+	case err := <-req.done:
+		return err
+	case <-ctx.Done():
+		return &changeSyncError{err: ctx.Err()}
+	}
+}
+
+func (b *changeBatcher) enqueue(key changeBatchKey, req *changeRequest) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	zone, ok := b.zones[key]
+
+	if !ok {
+		b.zones[key] = &changeZone{}
+
+		batch := newChangeBatch(key)
+		batch.add(req)
+
+		go b.run(batch)
+
+		return
+	}
+
+	var batch *changeBatch
+	if n := len(zone.queue); n > 0 {
+		batch = zone.queue[n-1]
+	}
+
+	// Route 53 rejects a batch that changes the same resource record set more than once.
+	if batch == nil || batch.size+len(req.changes) > changeBatchMaxChanges || batch.values+changesValues(req.changes) > changeBatchMaxValues || batch.conflicts(req) {
+		batch = newChangeBatch(key)
+		zone.queue = append(zone.queue, batch)
+	}
+
+	batch.add(req)
+}
+
+// run sends the batch and then the hosted zone's queued batches, one at a time, until the queue is empty.
+func (b *changeBatcher) run(batch *changeBatch) {
+	for batch != nil {
+		b.flush(batch)
+		batch = b.next(batch.key)
+	}
+}
+
+// next removes and returns the hosted zone's next queued batch.
+// If there is none the hosted zone no longer has a batch in flight and nil is returned.
+func (b *changeBatcher) next(key changeBatchKey) *changeBatch {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	zone := b.zones[key]
+
+	if len(zone.queue) == 0 {
+		delete(b.zones, key)
+
+		return nil
+	}
+
+	batch := zone.queue[0]
+	zone.queue = zone.queue[1:]
+
+	return batch
+}
+
+func (b *changeBatcher) flush(batch *changeBatch) {
+	ctx, cancel := batch.context()
+	defer cancel()
+
+	tflog.Debug(ctx, "sending Route 53 change batch", map[string]interface{}{
+		"hosted_zone_id": batch.key.zoneID,
+		"requests":       len(batch.requests),
+		"changes":        batch.size,
+	})
+
+	b.applyRequests(ctx, batch.key, batch.requests)
+}
+
+// applyRequests applies the requests' changes in a single batch.
+// If the batch is rejected the requests are split in two and each half is retried in turn,
+// until the requests whose changes are rejected are isolated.
+func (b *changeBatcher) applyRequests(ctx context.Context, key changeBatchKey, requests []*changeRequest) {
+	var changes []*route53.Change
+	for _, req := range requests {
+		changes = append(changes, req.changes...)
+	}
+
+	err := b.apply(ctx, key, changes)
+
+	var syncErr *changeSyncError
+	if err == nil || errors.As(err, &syncErr) || len(requests) == 1 {
+		for _, req := range requests {
+			req.done <- err
+		}
+
+		return
+	}
+
+	tflog.Debug(ctx, "Route 53 change batch rejected, retrying in smaller batches", map[string]interface{}{
+		"hosted_zone_id": key.zoneID,
+		"requests":       len(requests),
+		"error":          err.Error(),
+	})
+
+	n := len(requests) / 2
+	b.applyRequests(ctx, key, requests[:n])
+	b.applyRequests(ctx, key, requests[n:])
+}
+
+func (b *changeBatcher) apply(ctx context.Context, key changeBatchKey, changes []*route53.Change) error {
+	input := &route53.ChangeResourceRecordSetsInput{
+		ChangeBatch: &route53.ChangeBatch{
+			Comment: aws.String("Managed by Terraform"),
+			Changes: changes,
+		},
+		HostedZoneId: aws.String(key.zoneID),
+	}
+
+	changeInfo, err := b.change(ctx, key.conn, input)
+
+	if err != nil {
+		return err
+	}
+
+	if changeInfo == nil {
+		return nil
+	}
+
+	changeID := aws.StringValue(changeInfo.Id)
+	if err := b.wait(ctx, key.conn, changeID); err != nil {
+		return &changeSyncError{changeID: changeID, err: err}
+	}
+
+	return nil
+}
+
+func newChangeBatch(key changeBatchKey) *changeBatch {
+	return &changeBatch{
+		key:     key,
+		records: make(map[string]struct{}),
+	}
+}
+
+// context returns the context in which the batch is applied.
+// The batch outlives the requests that make it up, so it isn't canceled with them.
+// Its deadline is the latest of the requests' deadlines, or none if any request has no deadline.
+func (batch *changeBatch) context() (context.Context, context.CancelFunc) {
+	ctx := context.WithoutCancel(batch.requests[0].ctx)
+
+	var deadline time.Time
+	for _, req := range batch.requests {
+		d, ok := req.ctx.Deadline()
+		if !ok {
+			return context.WithCancel(ctx)
+		}
+
+		if d.After(deadline) {
+			deadline = d
+		}
+	}
+
+	return context.WithDeadline(ctx, deadline)
+}
+
+func (batch *changeBatch) add(req *changeRequest) {
+	batch.requests = append(batch.requests, req)
+	batch.size += len(req.changes)
+	batch.values += changesValues(req.changes)
+
+	for _, change := range req.changes {
+		batch.records[changeRecordKey(change)] = struct{}{}
+	}
+}
+
+func (batch *changeBatch) conflicts(req *changeRequest) bool {
+	for _, change := range req.changes {
+		if _, ok := batch.records[changeRecordKey(change)]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// changeRecordKey identifies the resource record set that a change applies to.
+func changeRecordKey(change *route53.Change) string {
+	if change.ResourceRecordSet == nil {
+		return ""
+	}
+
+	return resourceRecordSetKey(change.ResourceRecordSet)
+}
+
+// changesValues returns the number of resource record values that count towards the per-request limit.
+// The values of an UPSERT change count twice, and an alias record counts as one value.
+func changesValues(changes []*route53.Change) int {
+	var n int
+
+	for _, change := range changes {
+		v := 1
+		if change.ResourceRecordSet != nil {
+			v = max(len(change.ResourceRecordSet.ResourceRecords), 1)
+		}
+
+		if aws.StringValue(change.Action) == route53.ChangeActionUpsert {
+			v *= 2
+		}
+
+		n += v
+	}
+
+	return n
+}
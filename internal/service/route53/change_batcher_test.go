@@ -0,0 +1,390 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+type fakeChangeAPI struct {
+	mu        sync.Mutex
+	batches   [][]*route53.Change
+	deadlines []time.Time
+	waits     []string
+	// hold, if not nil, blocks each ChangeResourceRecordSets call until it's closed.
+	hold chan struct{}
+	// reject returns an error if the change should cause its batch to be rejected.
+	reject  func(*route53.Change) error
+	waitErr error
+}
+
+func (f *fakeChangeAPI) change(ctx context.Context, _ *route53.Route53, input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeInfo, error) {
+	f.mu.Lock()
+	f.batches = append(f.batches, input.ChangeBatch.Changes)
+	deadline, _ := ctx.Deadline()
+	f.deadlines = append(f.deadlines, deadline)
+	n := len(f.batches)
+	f.mu.Unlock()
+
+	if f.hold != nil {
+		<-f.hold
+	}
+
+	if f.reject != nil {
+		for _, change := range input.ChangeBatch.Changes {
+			if err := f.reject(change); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &route53.ChangeInfo{Id: aws.String(fmt.Sprintf("/change/C%d", n))}, nil
+}
+
+func (f *fakeChangeAPI) wait(_ context.Context, _ *route53.Route53, changeID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.waits = append(f.waits, changeID)
+
+	return f.waitErr
+}
+
+func (f *fakeChangeAPI) calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.batches)
+}
+
+// queued returns the number of requests waiting for the hosted zone's batch in flight to complete.
+func (b *changeBatcher) queued(zoneID string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var n int
+	if zone, ok := b.zones[changeBatchKey{zoneID: zoneID}]; ok {
+		for _, batch := range zone.queue {
+			n += len(batch.requests)
+		}
+	}
+
+	return n
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+
+	for timeout := time.After(10 * time.Second); !condition(); {
+		select {
+		case <-timeout:
+			t.Fatal("timed out")
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func testChange(name string) []*route53.Change {
+	return []*route53.Change{
+		{
+			Action: aws.String(route53.ChangeActionCreate),
+			ResourceRecordSet: &route53.ResourceRecordSet{
+				Name: aws.String(name),
+				Type: aws.String(route53.RRTypeA),
+			},
+		},
+	}
+}
+
+func submitAll(ctx context.Context, b *changeBatcher, zoneID string, changes [][]*route53.Change) []error {
+	errs := make([]error, len(changes))
+
+	var wg sync.WaitGroup
+	for i, c := range changes {
+		i, c := i, c
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			errs[i] = b.Submit(ctx, nil, zoneID, c)
+		}()
+	}
+	wg.Wait()
+
+	return errs
+}
+
+// submitWhileInFlight submits a change and, while its batch is in flight, the specified changes.
+// It returns the errors for the changes submitted while the batch was in flight.
+func submitWhileInFlight(t *testing.T, b *changeBatcher, api *fakeChangeAPI, zoneID string, changes [][]*route53.Change) []error {
+	t.Helper()
+
+	ctx := context.Background()
+	api.hold = make(chan struct{})
+
+	first := make(chan error, 1)
+	go func() {
+		first <- b.Submit(ctx, nil, zoneID, testChange("first.example.com"))
+	}()
+
+	waitFor(t, func() bool { return api.calls() == 1 })
+
+	errs := make(chan []error, 1)
+	go func() {
+		errs <- submitAll(ctx, b, zoneID, changes)
+	}()
+
+	waitFor(t, func() bool { return b.queued(zoneID) == len(changes) })
+
+	close(api.hold)
+
+	<-first
+
+	return <-errs
+}
+
+func TestChangeBatcherIdleZone(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	api := &fakeChangeAPI{}
+	b := newChangeBatcher(api.change, api.wait)
+
+	// Changes to a zone with no batch in flight are sent immediately, each in its own batch.
+	for i := 0; i < 2; i++ {
+		if err := b.Submit(ctx, nil, "Z1", testChange(fmt.Sprintf("r%d.example.com", i))); err != nil {
+			t.Errorf("Submit %d: %s", i, err)
+		}
+	}
+
+	if got, want := len(api.batches), 2; got != want {
+		t.Errorf("ChangeResourceRecordSets calls = %d, want %d", got, want)
+	}
+}
+
+func TestChangeBatcherCoalesces(t *testing.T) {
+	t.Parallel()
+
+	api := &fakeChangeAPI{}
+	b := newChangeBatcher(api.change, api.wait)
+
+	var changes [][]*route53.Change
+	for i := 0; i < 20; i++ {
+		changes = append(changes, testChange(fmt.Sprintf("r%d.example.com", i)))
+	}
+
+	for i, err := range submitWhileInFlight(t, b, api, "Z1", changes) {
+		if err != nil {
+			t.Errorf("Submit %d: %s", i, err)
+		}
+	}
+
+	if got, want := len(api.batches), 2; got != want {
+		t.Fatalf("ChangeResourceRecordSets calls = %d, want %d", got, want)
+	}
+	if got, want := len(api.batches[1]), 20; got != want {
+		t.Errorf("changes in batch = %d, want %d", got, want)
+	}
+	if got, want := len(api.waits), 2; got != want {
+		t.Errorf("waits = %d, want %d", got, want)
+	}
+}
+
+func TestChangeBatcherZones(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	api := &fakeChangeAPI{
+		hold: make(chan struct{}),
+	}
+	b := newChangeBatcher(api.change, api.wait)
+
+	errs := make(chan error, 2)
+	for _, zoneID := range []string{"Z1", "Z2"} {
+		zoneID := zoneID
+
+		go func() {
+			errs <- b.Submit(ctx, nil, zoneID, testChange("a.example.com"))
+		}()
+	}
+
+	// A batch in flight for one zone doesn't hold up changes to another.
+	waitFor(t, func() bool { return api.calls() == 2 })
+
+	close(api.hold)
+
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err != nil {
+			t.Errorf("Submit: %s", err)
+		}
+	}
+}
+
+func TestChangeBatcherMaxChanges(t *testing.T) {
+	t.Parallel()
+
+	api := &fakeChangeAPI{}
+	b := newChangeBatcher(api.change, api.wait)
+
+	var changes [][]*route53.Change
+	for i := 0; i < changeBatchMaxChanges*2; i++ {
+		changes = append(changes, testChange(fmt.Sprintf("r%d.example.com", i)))
+	}
+
+	submitWhileInFlight(t, b, api, "Z1", changes)
+
+	if got, want := len(api.batches), 3; got != want {
+		t.Fatalf("ChangeResourceRecordSets calls = %d, want %d", got, want)
+	}
+	for i, batch := range api.batches[1:] {
+		if got, want := len(batch), changeBatchMaxChanges; got != want {
+			t.Errorf("changes in batch %d = %d, want %d", i+1, got, want)
+		}
+	}
+}
+
+func TestChangeBatcherConflictingRecords(t *testing.T) {
+	t.Parallel()
+
+	api := &fakeChangeAPI{}
+	b := newChangeBatcher(api.change, api.wait)
+
+	submitWhileInFlight(t, b, api, "Z1", [][]*route53.Change{testChange("a.example.com"), testChange("A.example.com.")})
+
+	if got, want := len(api.batches), 3; got != want {
+		t.Errorf("ChangeResourceRecordSets calls = %d, want %d", got, want)
+	}
+}
+
+func TestChangeBatcherErrorAttribution(t *testing.T) {
+	t.Parallel()
+
+	errInvalid := errors.New("InvalidChangeBatch")
+	api := &fakeChangeAPI{
+		reject: func(change *route53.Change) error {
+			if aws.StringValue(change.ResourceRecordSet.Name) == "bad.example.com" {
+				return errInvalid
+			}
+			return nil
+		},
+	}
+	b := newChangeBatcher(api.change, api.wait)
+
+	errs := submitWhileInFlight(t, b, api, "Z1", [][]*route53.Change{testChange("a.example.com"), testChange("bad.example.com"), testChange("b.example.com")})
+
+	for i, want := range []error{nil, errInvalid, nil} {
+		if got := errs[i]; !errors.Is(got, want) {
+			t.Errorf("Submit %d error = %v, want %v", i, got, want)
+		}
+	}
+
+	// The batch in flight, one rejected batch, then the requests are split in two until the rejected request is isolated.
+	// The number of calls depends on the position of the rejected request in the batch.
+	if got := len(api.batches); got != 4 && got != 6 {
+		t.Errorf("ChangeResourceRecordSets calls = %d, want 4 or 6", got)
+	}
+}
+
+func TestChangeBatcherSyncError(t *testing.T) {
+	t.Parallel()
+
+	api := &fakeChangeAPI{
+		waitErr: errors.New("timeout"),
+	}
+	b := newChangeBatcher(api.change, api.wait)
+
+	for i, err := range submitWhileInFlight(t, b, api, "Z1", [][]*route53.Change{testChange("a.example.com"), testChange("b.example.com")}) {
+		var syncErr *changeSyncError
+		if !errors.As(err, &syncErr) {
+			t.Errorf("Submit %d error = %v, want *changeSyncError", i, err)
+		}
+	}
+
+	// Accepted changes aren't retried.
+	if got, want := len(api.batches), 2; got != want {
+		t.Errorf("ChangeResourceRecordSets calls = %d, want %d", got, want)
+	}
+}
+
+func TestChangeBatcherContextDeadline(t *testing.T) {
+	t.Parallel()
+
+	api := &fakeChangeAPI{}
+	b := newChangeBatcher(api.change, api.wait)
+
+	now := time.Now()
+	ctx1, cancel1 := context.WithDeadline(context.Background(), now.Add(time.Hour))
+	defer cancel1()
+	ctx2, cancel2 := context.WithDeadline(context.Background(), now.Add(2*time.Hour))
+	defer cancel2()
+
+	api.hold = make(chan struct{})
+
+	first := make(chan error, 1)
+	go func() {
+		first <- b.Submit(ctx1, nil, "Z1", testChange("first.example.com"))
+	}()
+
+	waitFor(t, func() bool { return api.calls() == 1 })
+
+	errs := make(chan error, 2)
+	for i, ctx := range []context.Context{ctx1, ctx2} {
+		i, ctx := i, ctx
+
+		go func() {
+			errs <- b.Submit(ctx, nil, "Z1", testChange(fmt.Sprintf("r%d.example.com", i)))
+		}()
+	}
+
+	waitFor(t, func() bool { return b.queued("Z1") == 2 })
+
+	close(api.hold)
+
+	if err := <-first; err != nil {
+		t.Errorf("Submit first: %s", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err != nil {
+			t.Errorf("Submit: %s", err)
+		}
+	}
+
+	// A batch is applied until the latest of its requesters' deadlines.
+	for i, want := range []time.Time{now.Add(time.Hour), now.Add(2 * time.Hour)} {
+		if got := api.deadlines[i]; !got.Equal(want) {
+			t.Errorf("batch %d deadline = %s, want %s", i, got, want)
+		}
+	}
+}
+
+func TestChangeBatcherContextDone(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	api := &fakeChangeAPI{}
+	b := newChangeBatcher(api.change, api.wait)
+
+	cancel()
+
+	err := b.Submit(ctx, nil, "Z1", testChange("a.example.com"))
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Submit error = %v, want %v", err, context.Canceled)
+	}
+
+	// The batch may still be applied, so callers must treat the change as made.
+	var syncErr *changeSyncError
+	if !errors.As(err, &syncErr) {
+		t.Errorf("Submit error = %T, want %T", err, syncErr)
+	}
+}
@@ -5,9 +5,13 @@ package route53
 
 // Exports for use in tests only.
 var (
+	ChunkZoneRecordChanges       = chunkZoneRecordChanges
 	CIDRLocationParseResourceID  = cidrLocationParseResourceID
 	FindCIDRCollectionByID       = findCIDRCollectionByID
 	FindCIDRLocationByTwoPartKey = findCIDRLocationByTwoPartKey
+	FindZoneResourceRecordSets   = findZoneResourceRecordSets
+	ParseZoneFile                = parseZoneFile
 	ResourceCIDRCollection       = newResourceCIDRCollection
 	ResourceCIDRLocation         = newResourceCIDRLocation
+	ZoneRecordChanges            = zoneRecordChanges
 )
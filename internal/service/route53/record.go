@@ -5,6 +5,7 @@ package route53
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"math/rand"
@@ -356,30 +357,15 @@ func resourceRecordCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		action = route53.ChangeActionCreate
 	}
 
-	// Create the new records. We abuse StateChangeConf for this to
-	// retry for us since Route53 sometimes returns errors about another
-	// operation happening at the same time.
-	changeBatch := &route53.ChangeBatch{
-		Comment: aws.String("Managed by Terraform"),
-		Changes: []*route53.Change{
-			{
-				Action:            aws.String(action),
-				ResourceRecordSet: rec,
-			},
+	// Create the new records. Concurrent changes to the same hosted zone are
+	// sent in a single batch to avoid Route 53's request rate limit.
+	changes := []*route53.Change{
+		{
+			Action:            aws.String(action),
+			ResourceRecordSet: rec,
 		},
 	}
 
-	input := &route53.ChangeResourceRecordSetsInput{
-		ChangeBatch:  changeBatch,
-		HostedZoneId: aws.String(CleanZoneID(aws.StringValue(zoneRecord.HostedZone.Id))),
-	}
-
-	changeInfo, err := ChangeResourceRecordSets(ctx, conn, input)
-
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating Route 53 Record: %s", err)
-	}
-
 	vars := []string{
 		zoneID,
 		strings.ToLower(d.Get("name").(string)),
@@ -388,12 +374,22 @@ func resourceRecordCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	if v, ok := d.GetOk("set_identifier"); ok {
 		vars = append(vars, v.(string))
 	}
-	d.SetId(strings.Join(vars, "_"))
+	id := strings.Join(vars, "_")
+
+	err = recordChangeBatcher.Submit(ctx, conn, CleanZoneID(aws.StringValue(zoneRecord.HostedZone.Id)), changes)
 
-	if err := WaitForRecordSetToSync(ctx, conn, CleanChangeID(aws.StringValue(changeInfo.Id))); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Record (%s) create: %s", d.Id(), err)
+	var syncErr *changeSyncError
+	if errors.As(err, &syncErr) {
+		d.SetId(id)
+		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Record (%s) create: %s", d.Id(), syncErr.err)
 	}
 
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Route 53 Record: %s", err)
+	}
+
+	d.SetId(id)
+
 	return append(diags, resourceRecordRead(ctx, d, meta)...)
 }
 
@@ -659,36 +655,20 @@ func resourceRecordUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	// Build the to be created record
 	rec := expandResourceRecordSet(d, aws.StringValue(zoneRecord.HostedZone.Name))
 
-	// Delete the old and create the new records in a single batch. We abuse
-	// StateChangeConf for this to retry for us since Route53 sometimes returns
-	// errors about another operation happening at the same time.
-	changeBatch := &route53.ChangeBatch{
-		Comment: aws.String("Managed by Terraform"),
-		Changes: []*route53.Change{
-			{
-				Action:            aws.String(route53.ChangeActionDelete),
-				ResourceRecordSet: oldRec,
-			},
-			{
-				Action:            aws.String(route53.ChangeActionCreate),
-				ResourceRecordSet: rec,
-			},
+	// Delete the old and create the new records atomically, in the same batch.
+	changes := []*route53.Change{
+		{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: oldRec,
+		},
+		{
+			Action:            aws.String(route53.ChangeActionCreate),
+			ResourceRecordSet: rec,
 		},
-	}
-
-	input := &route53.ChangeResourceRecordSetsInput{
-		HostedZoneId: aws.String(CleanZoneID(aws.StringValue(zoneRecord.HostedZone.Id))),
-		ChangeBatch:  changeBatch,
 	}
 
 	log.Printf("[DEBUG] Updating resource records for zone: %s, name: %s", zone, aws.StringValue(rec.Name))
 
-	changeInfo, err := ChangeResourceRecordSets(ctx, conn, input)
-
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating Route 53 resource record sets: %s", err)
-	}
-
 	// Generate an ID
 	vars := []string{
 		zone,
@@ -698,13 +678,22 @@ func resourceRecordUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	if v, ok := d.GetOk("set_identifier"); ok {
 		vars = append(vars, v.(string))
 	}
+	id := strings.Join(vars, "_")
 
-	d.SetId(strings.Join(vars, "_"))
+	err = recordChangeBatcher.Submit(ctx, conn, CleanZoneID(aws.StringValue(zoneRecord.HostedZone.Id)), changes)
 
-	if err := WaitForRecordSetToSync(ctx, conn, CleanChangeID(aws.StringValue(changeInfo.Id))); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Record (%s) update: %s", d.Id(), err)
+	var syncErr *changeSyncError
+	if errors.As(err, &syncErr) {
+		d.SetId(id)
+		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Record (%s) update: %s", d.Id(), syncErr.err)
 	}
 
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Route 53 resource record sets: %s", err)
+	}
+
+	d.SetId(id)
+
 	return append(diags, resourceRecordRead(ctx, d, meta)...)
 }
 
@@ -732,35 +721,26 @@ func resourceRecordDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "reading Route 53 Record (%s): %s", d.Id(), err)
 	}
 
-	// Change batch for deleting
-	changeBatch := &route53.ChangeBatch{
-		Comment: aws.String("Deleted by Terraform"),
-		Changes: []*route53.Change{
-			{
-				Action:            aws.String(route53.ChangeActionDelete),
-				ResourceRecordSet: rec,
-			},
+	changes := []*route53.Change{
+		{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: rec,
 		},
 	}
-	input := &route53.ChangeResourceRecordSetsInput{
-		ChangeBatch:  changeBatch,
-		HostedZoneId: aws.String(zoneID),
-	}
 
-	respRaw, err := DeleteRecordSet(ctx, conn, input)
+	err = recordChangeBatcher.Submit(ctx, conn, zoneID, changes)
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Record (%s): %s", d.Id(), err)
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeInvalidChangeBatch) {
+		return diags
 	}
 
-	changeInfo := respRaw.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo
-	if changeInfo == nil {
-		log.Printf("[INFO] No ChangeInfo Found. Waiting for Sync not required")
-		return diags
+	var syncErr *changeSyncError
+	if errors.As(err, &syncErr) {
+		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Record (%s) delete: %s", d.Id(), syncErr.err)
 	}
 
-	if err := WaitForRecordSetToSync(ctx, conn, CleanChangeID(aws.StringValue(changeInfo.Id))); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Record (%s) delete: %s", d.Id(), err)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Record (%s): %s", d.Id(), err)
 	}
 
 	return diags
@@ -857,7 +837,7 @@ func WaitForRecordSetToSync(ctx context.Context, conn *route53.Route53, requestI
 			return resourceGoWait(ctx, conn, changeRequest)
 		},
 	}
-	_, err := wait.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, &wait)
 	return err
 }
 
@@ -870,7 +850,13 @@ func DeleteRecordSet(ctx context.Context, conn *route53.Route53, input *route53.
 	return out, err
 }
 
-func expandResourceRecordSet(d *schema.ResourceData, zoneName string) *route53.ResourceRecordSet {
+// resourceRecordSetData is the configuration of a resource record set, either a *schema.ResourceData or a nested record block.
+type resourceRecordSetData interface {
+	Get(string) interface{}
+	GetOk(string) (interface{}, bool)
+}
+
+func expandResourceRecordSet(d resourceRecordSetData, zoneName string) *route53.ResourceRecordSet {
 	// get expanded name
 	en := ExpandRecordName(d.Get("name").(string), zoneName)
 
@@ -1041,6 +1027,15 @@ func NormalizeAliasName(alias interface{}) string {
 	return CleanRecordName(strings.TrimSuffix(output, "."))
 }
 
+// resourceRecordSetKey identifies a resource record set within a hosted zone by its name, type and set identifier.
+func resourceRecordSetKey(apiObject *route53.ResourceRecordSet) string {
+	return strings.Join([]string{
+		strings.ToLower(CleanRecordName(strings.TrimSuffix(aws.StringValue(apiObject.Name), "."))),
+		strings.ToUpper(aws.StringValue(apiObject.Type)),
+		aws.StringValue(apiObject.SetIdentifier),
+	}, "_")
+}
+
 func ParseRecordID(id string) [4]string {
 	var recZone, recType, recName, recSet string
 	parts := strings.Split(id, "_")
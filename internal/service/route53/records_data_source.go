@@ -0,0 +1,410 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_route53_records", name="Records")
+func DataSourceRecords() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"delegated_subdomains": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name_servers": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"private_zone": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"zone_id"},
+			},
+			"resource_record_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"zone_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"cidr_routing_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"collection_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"location_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"failover_routing_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"geolocation_routing_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"continent": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"country": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"subdivision": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"geoproximity_routing_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"aws_region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"bias": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"coordinates": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"latitude": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"longitude": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"local_zone_group": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"health_check_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latency_routing_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"multivalue_answer_routing_policy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"set_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"weighted_routing_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"weight": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"set_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+			},
+			"vpc_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"zone_id"},
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"zone_id", "zone_name"},
+			},
+			"zone_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	var hostedZone *route53.HostedZone
+	if v, ok := d.GetOk("zone_id"); ok {
+		output, err := FindHostedZoneByID(ctx, conn, CleanZoneID(v.(string)))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", v.(string), err)
+		}
+
+		hostedZone = output.HostedZone
+	} else {
+		name := d.Get("zone_name").(string)
+		output, err := findHostedZoneByName(ctx, conn, name, d.Get("private_zone").(bool), d.Get("vpc_id").(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", name, err)
+		}
+
+		hostedZone = output
+	}
+
+	zoneID := CleanZoneID(aws.StringValue(hostedZone.Id))
+	zoneName := NormalizeZoneName(aws.StringValue(hostedZone.Name))
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexache.MustCompile(v.(string))
+	}
+	setIdentifier := d.Get("set_identifier").(string)
+	recordType := d.Get("type").(string)
+
+	filter := func(v *route53.ResourceRecordSet) bool {
+		if nameRegex != nil && !nameRegex.MatchString(recordSetName(v)) {
+			return false
+		}
+
+		if setIdentifier != "" && aws.StringValue(v.SetIdentifier) != setIdentifier {
+			return false
+		}
+
+		if recordType != "" && aws.StringValue(v.Type) != recordType {
+			return false
+		}
+
+		return true
+	}
+
+	recordSets, err := findZoneResourceRecordSets(ctx, conn, zoneID, zoneName, false)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Route 53 Hosted Zone (%s) records: %s", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+	if err := d.Set("delegated_subdomains", flattenDelegatedSubdomains(recordSets, zoneName)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting delegated_subdomains: %s", err)
+	}
+	d.Set("private_zone", hostedZone.Config != nil && aws.BoolValue(hostedZone.Config.PrivateZone))
+	if err := d.Set("resource_record_sets", tfslices.ApplyToAll(tfslices.Filter(recordSets, filter), flattenZoneRecord)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resource_record_sets: %s", err)
+	}
+	d.Set("zone_id", zoneID)
+	d.Set("zone_name", zoneName)
+
+	return diags
+}
+
+// findHostedZoneByName returns the hosted zone with the specified name.
+// Public and private hosted zones may have the same name, so a private hosted zone is only returned
+// if privateZone is true or a VPC ID is specified. If a VPC ID is specified the zone must be associated with it.
+func findHostedZoneByName(ctx context.Context, conn *route53.Route53, name string, privateZone bool, vpcID string) (*route53.HostedZone, error) {
+	name = NormalizeZoneName(name)
+	privateZone = privateZone || vpcID != ""
+
+	input := &route53.ListHostedZonesByNameInput{
+		DNSName: aws.String(name),
+	}
+
+	// ListHostedZonesByName returns zones in DNS name order starting at the specified name.
+	var candidates []*route53.HostedZone
+	for done := false; !done; {
+		output, err := conn.ListHostedZonesByNameWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range output.HostedZones {
+			if NormalizeZoneName(aws.StringValue(v.Name)) != name {
+				done = true
+				break
+			}
+
+			if v.Config != nil && aws.BoolValue(v.Config.PrivateZone) == privateZone {
+				candidates = append(candidates, v)
+			}
+		}
+
+		if !aws.BoolValue(output.IsTruncated) {
+			break
+		}
+
+		input.DNSName = output.NextDNSName
+		input.HostedZoneId = output.NextHostedZoneId
+	}
+
+	if vpcID != "" {
+		var matches []*route53.HostedZone
+
+		for _, v := range candidates {
+			output, err := FindHostedZoneByID(ctx, conn, CleanZoneID(aws.StringValue(v.Id)))
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, vpc := range output.VPCs {
+				if aws.StringValue(vpc.VPCId) == vpcID {
+					matches = append(matches, v)
+					break
+				}
+			}
+		}
+
+		candidates = matches
+	}
+
+	return tfresource.AssertSinglePtrResult(candidates)
+}
+
+// recordSetName returns a resource record set's name, fully qualified, in lower case and without a trailing period.
+func recordSetName(apiObject *route53.ResourceRecordSet) string {
+	return strings.ToLower(CleanRecordName(strings.TrimSuffix(aws.StringValue(apiObject.Name), ".")))
+}
+
+// flattenDelegatedSubdomains returns the subdomains delegated to other name servers by NS record sets below the zone apex.
+// Route 53 doesn't answer queries for other records at or below a delegated subdomain.
+func flattenDelegatedSubdomains(apiObjects []*route53.ResourceRecordSet, zoneName string) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if aws.StringValue(apiObject.Type) != route53.RRTypeNs || isApexSOAOrNS(apiObject, zoneName) {
+			continue
+		}
+
+		nameServers := FlattenResourceRecords(apiObject.ResourceRecords, route53.RRTypeNs)
+		sort.Strings(nameServers)
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":         recordSetName(apiObject),
+			"name_servers": nameServers,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53RecordsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	zoneName := acctest.RandomDomain()
+	dataSourceName := "data.aws_route53_records.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "zone_name", zoneName.String()),
+					resource.TestCheckResourceAttr(dataSourceName, "private_zone", "false"),
+					// SOA, apex NS, www A, 2 weighted api A, alias, delegation NS.
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "7"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resource_record_sets.*", map[string]string{
+						"name":      "www." + zoneName.String(),
+						"type":      "A",
+						"ttl":       "300",
+						"records.#": "1",
+						"records.0": "192.0.2.1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resource_record_sets.*", map[string]string{
+						"name":                             "api." + zoneName.String(),
+						"set_identifier":                   "blue",
+						"weighted_routing_policy.#":        "1",
+						"weighted_routing_policy.0.weight": "90",
+						"geolocation_routing_policy.#":     "0",
+						"multivalue_answer_routing_policy": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resource_record_sets.*", map[string]string{
+						"name":                           "alias." + zoneName.String(),
+						"alias.#":                        "1",
+						"alias.0.name":                   "www." + zoneName.String(),
+						"alias.0.evaluate_target_health": "false",
+					}),
+					resource.TestCheckResourceAttr(dataSourceName, "delegated_subdomains.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "delegated_subdomains.0.name", "sub."+zoneName.String()),
+					resource.TestCheckResourceAttr(dataSourceName, "delegated_subdomains.0.name_servers.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsDataSource_filters(t *testing.T) {
+	ctx := acctest.Context(t)
+	zoneName := acctest.RandomDomain()
+	dataSourceName := "data.aws_route53_records.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig_filters(zoneName.String(), `type = "A"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "4"),
+				),
+			},
+			{
+				Config: testAccRecordsDataSourceConfig_filters(zoneName.String(), `name_regex = "^api\\."`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "2"),
+				),
+			},
+			{
+				Config: testAccRecordsDataSourceConfig_filters(zoneName.String(), `set_identifier = "green"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.weighted_routing_policy.0.weight", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsDataSource_privateZone(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	zoneName := acctest.RandomDomain()
+	dataSourceName := "data.aws_route53_records.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig_privateZone(rName, zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "zone_id", "aws_route53_zone.private", "zone_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "private_zone", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.records.0", "10.0.0.1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecordsDataSourceConfig_zone(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "www" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.1"]
+}
+
+resource "aws_route53_record" "blue" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = "api"
+  type           = "A"
+  ttl            = 60
+  records        = ["192.0.2.10"]
+  set_identifier = "blue"
+
+  weighted_routing_policy {
+    weight = 90
+  }
+}
+
+resource "aws_route53_record" "green" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = "api"
+  type           = "A"
+  ttl            = 60
+  records        = ["192.0.2.20"]
+  set_identifier = "green"
+
+  weighted_routing_policy {
+    weight = 10
+  }
+}
+
+resource "aws_route53_record" "alias" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "alias"
+  type    = "A"
+
+  alias {
+    name                   = aws_route53_record.www.fqdn
+    zone_id                = aws_route53_zone.test.zone_id
+    evaluate_target_health = false
+  }
+}
+
+resource "aws_route53_record" "delegation" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "sub"
+  type    = "NS"
+  ttl     = 300
+  records = ["ns1.example.com.", "ns2.example.com."]
+}
+`, zoneName)
+}
+
+func testAccRecordsDataSourceConfig_basic(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsDataSourceConfig_zone(zoneName), `
+data "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  depends_on = [
+    aws_route53_record.www,
+    aws_route53_record.blue,
+    aws_route53_record.green,
+    aws_route53_record.alias,
+    aws_route53_record.delegation,
+  ]
+}
+`)
+}
+
+func testAccRecordsDataSourceConfig_filters(zoneName, filter string) string {
+	return acctest.ConfigCompose(testAccRecordsDataSourceConfig_zone(zoneName), fmt.Sprintf(`
+data "aws_route53_records" "test" {
+  zone_name = aws_route53_zone.test.name
+  %[1]s
+
+  depends_on = [
+    aws_route53_record.www,
+    aws_route53_record.blue,
+    aws_route53_record.green,
+    aws_route53_record.alias,
+    aws_route53_record.delegation,
+  ]
+}
+`, filter))
+}
+
+func testAccRecordsDataSourceConfig_privateZone(rName, zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route53_zone" "public" {
+  name = %[2]q
+}
+
+resource "aws_route53_zone" "private" {
+  name = %[2]q
+
+  vpc {
+    vpc_id = aws_vpc.test.id
+  }
+}
+
+resource "aws_route53_record" "public" {
+  zone_id = aws_route53_zone.public.zone_id
+  name    = "www"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.1"]
+}
+
+resource "aws_route53_record" "private" {
+  zone_id = aws_route53_zone.private.zone_id
+  name    = "www"
+  type    = "A"
+  ttl     = 300
+  records = ["10.0.0.1"]
+}
+
+data "aws_route53_records" "test" {
+  zone_name = aws_route53_zone.private.name
+  vpc_id    = aws_vpc.test.id
+  type      = "A"
+
+  depends_on = [aws_route53_record.public, aws_route53_record.private]
+}
+`, rName, zoneName)
+}
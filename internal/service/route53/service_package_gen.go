@@ -33,6 +33,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceDelegationSet,
 			TypeName: "aws_route53_delegation_set",
 		},
+		{
+			Factory:  DataSourceRecords,
+			TypeName: "aws_route53_records",
+			Name:     "Records",
+		},
 		{
 			Factory:  DataSourceTrafficPolicyDocument,
 			TypeName: "aws_route53_traffic_policy_document",
@@ -100,6 +105,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceZoneAssociation,
 			TypeName: "aws_route53_zone_association",
 		},
+		{
+			Factory:  ResourceZoneRecords,
+			TypeName: "aws_route53_zone_records",
+			Name:     "Zone Records",
+		},
 	}
 }
 
@@ -0,0 +1,357 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+// zoneFileLine is a logical line of an RFC 1035 zone file, with parenthesized continuation lines joined.
+type zoneFileLine struct {
+	number       int  // Line number of the line's first token.
+	ownerOmitted bool // The line starts with whitespace, so the owner is the previous record's owner.
+	tokens       []zoneFileToken
+}
+
+type zoneFileToken struct {
+	value  string
+	quoted bool
+}
+
+// parseZoneFile parses the resource records in RFC 1035 zone file text into resource record sets, in order of first appearance.
+// Relative names are relative to origin, which is overridden by $ORIGIN. $TTL sets the default TTL.
+// $INCLUDE and $GENERATE aren't supported.
+// Record names are returned fully qualified, in lower case and without a trailing period.
+func parseZoneFile(text, origin string) ([]*route53.ResourceRecordSet, error) {
+	lines, err := scanZoneFile(text)
+	if err != nil {
+		return nil, err
+	}
+
+	origin = strings.ToLower(strings.TrimSuffix(origin, "."))
+
+	var (
+		defaultTTL, lastTTL *int64
+		owner               string
+		recordSets          []*route53.ResourceRecordSet
+	)
+	index := make(map[string]*route53.ResourceRecordSet)
+
+	for _, line := range lines {
+		tokens := line.tokens
+
+		if v := tokens[0]; !v.quoted && strings.HasPrefix(v.value, "$") {
+			directive := strings.ToUpper(v.value)
+
+			switch directive {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $ORIGIN requires a domain name", line.number)
+				}
+				origin = zoneFileName(tokens[1].value, origin)
+
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $TTL requires a TTL", line.number)
+				}
+				ttl, ok := parseZoneFileTTL(tokens[1].value)
+				if !ok {
+					return nil, fmt.Errorf("line %d: invalid TTL: %s", line.number, tokens[1].value)
+				}
+				defaultTTL = aws.Int64(ttl)
+
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive: %s", line.number, v.value)
+			}
+
+			continue
+		}
+
+		if !line.ownerOmitted {
+			owner = zoneFileName(tokens[0].value, origin)
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: no owner name", line.number)
+		}
+
+		// The TTL and class are both optional and may appear in either order.
+		var ttl *int64
+		for i := 0; i < 2 && len(tokens) > 0; i++ {
+			if v, ok := parseZoneFileTTL(tokens[0].value); ok && ttl == nil {
+				ttl = aws.Int64(v)
+				tokens = tokens[1:]
+				continue
+			}
+
+			switch strings.ToUpper(tokens[0].value) {
+			case "IN":
+				tokens = tokens[1:]
+			case "CH", "CS", "HS":
+				return nil, fmt.Errorf("line %d: unsupported class: %s", line.number, tokens[0].value)
+			}
+		}
+
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: no record type", line.number)
+		}
+
+		recordType := strings.ToUpper(tokens[0].value)
+		if !validRecordType(recordType) {
+			return nil, fmt.Errorf("line %d: unsupported record type: %s", line.number, tokens[0].value)
+		}
+		tokens = tokens[1:]
+
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: %s record has no data", line.number, recordType)
+		}
+
+		switch {
+		case ttl != nil:
+			lastTTL = ttl
+		case defaultTTL != nil:
+			ttl = defaultTTL
+		case lastTTL != nil:
+			ttl = lastTTL
+		default:
+			return nil, fmt.Errorf("line %d: no TTL and no $TTL directive", line.number)
+		}
+
+		key := owner + "_" + recordType
+		recordSet, ok := index[key]
+		if !ok {
+			recordSet = &route53.ResourceRecordSet{
+				Name: aws.String(owner),
+				TTL:  ttl,
+				Type: aws.String(recordType),
+			}
+			index[key] = recordSet
+			recordSets = append(recordSets, recordSet)
+		} else if aws.Int64Value(recordSet.TTL) != aws.Int64Value(ttl) {
+			return nil, fmt.Errorf("line %d: %s %s record has a different TTL from the other records in the set", line.number, owner, recordType)
+		}
+
+		recordSet.ResourceRecords = append(recordSet.ResourceRecords, &route53.ResourceRecord{
+			Value: aws.String(zoneFileRecordValue(recordType, tokens, origin)),
+		})
+	}
+
+	return recordSets, nil
+}
+
+// scanZoneFile splits zone file text into logical lines of tokens, removing comments.
+func scanZoneFile(text string) ([]zoneFileLine, error) {
+	var (
+		lines              []zoneFileLine
+		line               zoneFileLine
+		token              strings.Builder
+		inToken            bool
+		depth              int
+		number, openedLine = 1, 0
+		startOfLine        = true
+	)
+
+	endToken := func(quoted bool) {
+		if inToken || quoted {
+			if len(line.tokens) == 0 {
+				line.number = number
+			}
+			line.tokens = append(line.tokens, zoneFileToken{value: token.String(), quoted: quoted})
+		}
+		token.Reset()
+		inToken = false
+	}
+	endLine := func() {
+		if len(line.tokens) > 0 {
+			lines = append(lines, line)
+		}
+		line = zoneFileLine{}
+	}
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+
+		if startOfLine && depth == 0 {
+			line.ownerOmitted = c == ' ' || c == '\t'
+		}
+		startOfLine = false
+
+		switch c {
+		case '\n':
+			endToken(false)
+			if depth == 0 {
+				endLine()
+			}
+			number++
+			startOfLine = true
+
+		case ' ', '\t', '\r':
+			endToken(false)
+
+		case ';':
+			endToken(false)
+			for i+1 < len(text) && text[i+1] != '\n' {
+				i++
+			}
+
+		case '(':
+			endToken(false)
+			if depth == 0 {
+				openedLine = number
+			}
+			depth++
+
+		case ')':
+			endToken(false)
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", number)
+			}
+			depth--
+
+		case '"':
+			endToken(false)
+			start := number
+			for i++; ; i++ {
+				if i >= len(text) {
+					return nil, fmt.Errorf("line %d: unterminated quoted string", start)
+				}
+				if text[i] == '"' {
+					break
+				}
+				if text[i] == '\\' && i+1 < len(text) {
+					token.WriteByte(text[i])
+					i++
+				}
+				if text[i] == '\n' {
+					number++
+				}
+				token.WriteByte(text[i])
+			}
+			endToken(true)
+
+		case '\\':
+			// An escaped character, e.g. `\.` or `\052`, is kept as-is.
+			inToken = true
+			token.WriteByte(c)
+			if i+1 < len(text) {
+				i++
+				token.WriteByte(text[i])
+			}
+
+		default:
+			inToken = true
+			token.WriteByte(c)
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", openedLine)
+	}
+
+	endToken(false)
+	endLine()
+
+	return lines, nil
+}
+
+// zoneFileName returns the fully qualified form of a zone file domain name.
+func zoneFileName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(strings.TrimSuffix(name, "."))
+	case origin == "":
+		return strings.ToLower(name)
+	default:
+		return strings.ToLower(name + "." + origin)
+	}
+}
+
+// parseZoneFileTTL parses a TTL in seconds or in BIND's `1w2d3h4m5s` format.
+func parseZoneFileTTL(s string) (int64, bool) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return v, v >= 0
+	}
+
+	if s == "" {
+		return 0, false
+	}
+
+	var ttl, n int64
+	var digits bool
+	for _, c := range strings.ToLower(s) {
+		if c >= '0' && c <= '9' {
+			n = n*10 + int64(c-'0')
+			digits = true
+			continue
+		}
+
+		if !digits {
+			return 0, false
+		}
+
+		switch c {
+		case 'w':
+			ttl += n * 7 * 24 * 60 * 60
+		case 'd':
+			ttl += n * 24 * 60 * 60
+		case 'h':
+			ttl += n * 60 * 60
+		case 'm':
+			ttl += n * 60
+		case 's':
+			ttl += n
+		default:
+			return 0, false
+		}
+
+		n, digits = 0, false
+	}
+
+	if digits {
+		return 0, false
+	}
+
+	return ttl, true
+}
+
+// zoneFileRecordValue returns the Route 53 resource record value for zone file record data.
+// Domain names in the data are made fully qualified.
+func zoneFileRecordValue(recordType string, tokens []zoneFileToken, origin string) string {
+	values := make([]string, len(tokens))
+	for i, token := range tokens {
+		values[i] = token.value
+	}
+
+	// Index of the domain name in the record data.
+	nameIndex := -1
+	switch recordType {
+	case route53.RRTypeCname, route53.RRTypeNs, route53.RRTypePtr:
+		nameIndex = 0
+	case route53.RRTypeMx:
+		nameIndex = 1
+	case route53.RRTypeSrv:
+		nameIndex = 3
+	case route53.RRTypeSoa:
+		for i := 0; i < 2 && i < len(values); i++ {
+			values[i] = FQDN(zoneFileName(values[i], origin))
+		}
+	case route53.RRTypeTxt, route53.RRTypeSpf:
+		for i, token := range tokens {
+			values[i] = `"` + token.value + `"`
+		}
+	}
+
+	// The root domain name, e.g. in a null MX record, is kept as-is.
+	if nameIndex >= 0 && nameIndex < len(values) && !tokens[nameIndex].quoted && values[nameIndex] != "." {
+		values[nameIndex] = FQDN(zoneFileName(values[nameIndex], origin))
+	}
+
+	return strings.Join(values, " ")
+}
@@ -0,0 +1,696 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_route53_zone_records", name="Zone Records")
+func ResourceZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceZoneRecordsCreate,
+		ReadWithoutTimeout:   resourceZoneRecordsRead,
+		UpdateWithoutTimeout: resourceZoneRecordsUpdate,
+		DeleteWithoutTimeout: resourceZoneRecordsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("exclude_soa_ns", true)
+				d.Set("zone_id", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: resourceZoneRecordsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"exclude_soa_ns": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"record": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"zone_file"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"zone_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 32),
+									},
+								},
+							},
+						},
+						"cidr_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"collection_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"location_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"failover_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(route53.ResourceRecordSetFailover_Values(), false),
+									},
+								},
+							},
+						},
+						"geolocation_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"continent": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"country": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"subdivision": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"geoproximity_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"aws_region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"bias": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(-99, 99),
+									},
+									"coordinates": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"latitude": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"longitude": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"local_zone_group": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"health_check_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"latency_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"region": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"multivalue_answer_routing_policy": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"records": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"set_identifier": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+						},
+						"weighted_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"weight": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"zone_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"record"},
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"zone_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceZoneRecordsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+
+	if err := updateZoneRecords(ctx, d, meta, zoneID); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Route 53 Zone Records (%s): %s", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+
+	return append(diags, resourceZoneRecordsRead(ctx, d, meta)...)
+}
+
+func resourceZoneRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zone, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Zone Records (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Zone Records (%s): %s", d.Id(), err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	recordSets, err := findZoneResourceRecordSets(ctx, conn, d.Id(), zoneName, d.Get("exclude_soa_ns").(bool))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Zone Records (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("record", tfslices.ApplyToAll(recordSets, flattenZoneRecord)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting record: %s", err)
+	}
+	d.Set("zone_name", NormalizeZoneName(zoneName))
+
+	return diags
+}
+
+func resourceZoneRecordsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := updateZoneRecords(ctx, d, meta, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Route 53 Zone Records (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceZoneRecordsRead(ctx, d, meta)...)
+}
+
+func resourceZoneRecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zone, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Zone Records (%s): %s", d.Id(), err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	actual, err := findZoneResourceRecordSets(ctx, conn, d.Id(), zoneName, true)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Zone Records (%s): %s", d.Id(), err)
+	}
+
+	// Only delete the records that are managed by this resource.
+	managed := make(map[string]struct{})
+	for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+		managed[resourceRecordSetKey(expandResourceRecordSet(zoneRecordData(tfMapRaw.(map[string]interface{})), zoneName))] = struct{}{}
+	}
+	actual = tfslices.Filter(actual, func(v *route53.ResourceRecordSet) bool {
+		_, ok := managed[resourceRecordSetKey(v)]
+		return ok
+	})
+
+	log.Printf("[DEBUG] Deleting Route 53 Zone Records: %s", d.Id())
+	err = applyZoneRecordChanges(ctx, conn, d.Id(), zoneRecordChanges(actual, nil, zoneName))
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Zone Records (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceZoneRecordsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("zone_id") {
+		return nil
+	}
+
+	zoneFile, zoneFileConfigured := d.GetOk("zone_file")
+
+	if zoneFileConfigured && !d.NewValueKnown("zone_file") {
+		return d.SetNewComputed("record")
+	}
+
+	if !zoneFileConfigured && !d.NewValueKnown("record") {
+		return nil
+	}
+
+	// With neither argument configured the resource manages an empty set of records,
+	// rather than keeping the previous computed value.
+	if !zoneFileConfigured {
+		if v := d.GetRawConfig().GetAttr("record"); v.IsNull() || (v.IsKnown() && v.LengthInt() == 0) {
+			return d.SetNew("record", []interface{}{})
+		}
+	}
+
+	// Relative record names are resolved against the hosted zone's name, which is read from state so that planning doesn't
+	// call the Route 53 API. Until the resource has been created the records are only known after apply.
+	zoneName := d.Get("zone_name").(string)
+
+	if zoneName == "" {
+		return d.SetNewComputed("record")
+	}
+
+	var desired []*route53.ResourceRecordSet
+
+	if zoneFileConfigured {
+		var err error
+		desired, err = expandZoneFile(zoneFile.(string), zoneName, d.Get("exclude_soa_ns").(bool))
+
+		if err != nil {
+			return err
+		}
+	} else {
+		desired = expandZoneRecords(d.Get("record").(*schema.Set).List(), zoneName)
+	}
+
+	// Normalize the desired records so that they compare equal to the records read from Route 53.
+	return d.SetNew("record", tfslices.ApplyToAll(desired, flattenZoneRecord))
+}
+
+// updateZoneRecords makes the records in the hosted zone match the configured records.
+func updateZoneRecords(ctx context.Context, d *schema.ResourceData, meta interface{}, zoneID string) error {
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zone, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return fmt.Errorf("reading Route 53 Hosted Zone (%s): %w", zoneID, err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	excludeSOANS := d.Get("exclude_soa_ns").(bool)
+	var desired []*route53.ResourceRecordSet
+
+	if v, ok := d.GetOk("zone_file"); ok {
+		desired, err = expandZoneFile(v.(string), zoneName, excludeSOANS)
+
+		if err != nil {
+			return err
+		}
+	} else {
+		desired = expandZoneRecords(d.Get("record").(*schema.Set).List(), zoneName)
+	}
+
+	actual, err := findZoneResourceRecordSets(ctx, conn, zoneID, zoneName, excludeSOANS)
+
+	if err != nil {
+		return fmt.Errorf("listing resource record sets: %w", err)
+	}
+
+	return applyZoneRecordChanges(ctx, conn, zoneID, zoneRecordChanges(actual, desired, zoneName))
+}
+
+// applyZoneRecordChanges applies changes to a hosted zone in as few change batches as possible.
+func applyZoneRecordChanges(ctx context.Context, conn *route53.Route53, zoneID string, changes []*route53.Change) error {
+	for _, batch := range chunkZoneRecordChanges(changes) {
+		if err := recordChangeBatcher.Submit(ctx, conn, zoneID, batch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// chunkZoneRecordChanges splits changes into batches that are within Route 53's per-request limits.
+func chunkZoneRecordChanges(changes []*route53.Change) [][]*route53.Change {
+	var (
+		batches [][]*route53.Change
+		batch   []*route53.Change
+		values  int
+	)
+
+	for _, change := range changes {
+		n := changesValues([]*route53.Change{change})
+
+		if len(batch) > 0 && (len(batch) == changeBatchMaxChanges || values+n > changeBatchMaxValues) {
+			batches = append(batches, batch)
+			batch, values = nil, 0
+		}
+
+		batch = append(batch, change)
+		values += n
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+// zoneRecordChanges returns the changes that make a hosted zone's actual resource record sets match the desired ones.
+// Unwanted record sets are deleted first, then missing record sets are created and changed ones are updated.
+// The zone's apex SOA and NS record sets are never created or deleted, only updated.
+func zoneRecordChanges(actual, desired []*route53.ResourceRecordSet, zoneName string) []*route53.Change {
+	actualByKey := make(map[string]*route53.ResourceRecordSet, len(actual))
+	for _, v := range actual {
+		actualByKey[resourceRecordSetKey(v)] = v
+	}
+	desiredByKey := make(map[string]*route53.ResourceRecordSet, len(desired))
+	for _, v := range desired {
+		desiredByKey[resourceRecordSetKey(v)] = v
+	}
+
+	var deletes, creates, upserts []*route53.Change
+
+	for _, v := range actual {
+		if _, ok := desiredByKey[resourceRecordSetKey(v)]; ok || isApexSOAOrNS(v, zoneName) {
+			continue
+		}
+
+		deletes = append(deletes, &route53.Change{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: v,
+		})
+	}
+
+	for _, v := range desired {
+		old, ok := actualByKey[resourceRecordSetKey(v)]
+
+		switch {
+		case !ok && !isApexSOAOrNS(v, zoneName):
+			creates = append(creates, &route53.Change{
+				Action:            aws.String(route53.ChangeActionCreate),
+				ResourceRecordSet: v,
+			})
+		case !ok, !reflect.DeepEqual(flattenZoneRecord(old), flattenZoneRecord(v)):
+			upserts = append(upserts, &route53.Change{
+				Action:            aws.String(route53.ChangeActionUpsert),
+				ResourceRecordSet: v,
+			})
+		}
+	}
+
+	return append(append(deletes, creates...), upserts...)
+}
+
+// findZoneResourceRecordSets returns all the resource record sets in a hosted zone,
+// optionally excluding the zone's apex SOA and NS record sets.
+func findZoneResourceRecordSets(ctx context.Context, conn *route53.Route53, zoneID, zoneName string, excludeSOANS bool) ([]*route53.ResourceRecordSet, error) {
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	return findResourceRecordSets(ctx, conn, input, func(v *route53.ResourceRecordSet) bool {
+		return !excludeSOANS || !isApexSOAOrNS(v, zoneName)
+	})
+}
+
+func findResourceRecordSets(ctx context.Context, conn *route53.Route53, input *route53.ListResourceRecordSetsInput, filter tfslices.Predicate[*route53.ResourceRecordSet]) ([]*route53.ResourceRecordSet, error) {
+	var output []*route53.ResourceRecordSet
+
+	err := conn.ListResourceRecordSetsPagesWithContext(ctx, input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceRecordSets {
+			if v != nil && filter(v) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// expandZoneFile returns the resource record sets in RFC 1035 zone file text.
+func expandZoneFile(zoneFile, zoneName string, excludeSOANS bool) ([]*route53.ResourceRecordSet, error) {
+	recordSets, err := parseZoneFile(zoneFile, zoneName)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing zone_file: %w", err)
+	}
+
+	if excludeSOANS {
+		recordSets = tfslices.Filter(recordSets, func(v *route53.ResourceRecordSet) bool {
+			return !isApexSOAOrNS(v, zoneName)
+		})
+	}
+
+	return recordSets, nil
+}
+
+func expandZoneRecords(tfList []interface{}, zoneName string) []*route53.ResourceRecordSet {
+	apiObjects := make([]*route53.ResourceRecordSet, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandResourceRecordSet(zoneRecordData(tfMap), zoneName))
+	}
+
+	return apiObjects
+}
+
+func flattenZoneRecord(apiObject *route53.ResourceRecordSet) map[string]interface{} {
+	recordType := aws.StringValue(apiObject.Type)
+	records := FlattenResourceRecords(apiObject.ResourceRecords, recordType)
+	sort.Strings(records)
+
+	tfMap := map[string]interface{}{
+		"health_check_id":                  aws.StringValue(apiObject.HealthCheckId),
+		"multivalue_answer_routing_policy": aws.BoolValue(apiObject.MultiValueAnswer),
+		"name":                             recordSetName(apiObject),
+		"records":                          records,
+		"set_identifier":                   aws.StringValue(apiObject.SetIdentifier),
+		"ttl":                              int(aws.Int64Value(apiObject.TTL)),
+		"type":                             recordType,
+	}
+
+	if v := apiObject.AliasTarget; v != nil {
+		tfMap["alias"] = []interface{}{map[string]interface{}{
+			"evaluate_target_health": aws.BoolValue(v.EvaluateTargetHealth),
+			"name":                   NormalizeAliasName(aws.StringValue(v.DNSName)),
+			"zone_id":                aws.StringValue(v.HostedZoneId),
+		}}
+	}
+
+	if v := apiObject.CidrRoutingConfig; v != nil {
+		tfMap["cidr_routing_policy"] = []interface{}{map[string]interface{}{
+			"collection_id": aws.StringValue(v.CollectionId),
+			"location_name": aws.StringValue(v.LocationName),
+		}}
+	}
+
+	if v := apiObject.Failover; v != nil {
+		tfMap["failover_routing_policy"] = []interface{}{map[string]interface{}{
+			"type": aws.StringValue(v),
+		}}
+	}
+
+	if v := apiObject.GeoLocation; v != nil {
+		tfMap["geolocation_routing_policy"] = []interface{}{map[string]interface{}{
+			"continent":   aws.StringValue(v.ContinentCode),
+			"country":     aws.StringValue(v.CountryCode),
+			"subdivision": aws.StringValue(v.SubdivisionCode),
+		}}
+	}
+
+	if v := apiObject.GeoProximityLocation; v != nil {
+		tfMap["geoproximity_routing_policy"] = []interface{}{map[string]interface{}{
+			"aws_region":       aws.StringValue(v.AWSRegion),
+			"bias":             int(aws.Int64Value(v.Bias)),
+			"coordinates":      flattenCoordinate(v.Coordinates),
+			"local_zone_group": aws.StringValue(v.LocalZoneGroup),
+		}}
+	}
+
+	if v := apiObject.Region; v != nil {
+		tfMap["latency_routing_policy"] = []interface{}{map[string]interface{}{
+			"region": aws.StringValue(v),
+		}}
+	}
+
+	if v := apiObject.Weight; v != nil {
+		tfMap["weighted_routing_policy"] = []interface{}{map[string]interface{}{
+			"weight": int(aws.Int64Value(v)),
+		}}
+	}
+
+	return tfMap
+}
+
+// zoneRecordData adapts a record block to resourceRecordSetData.
+type zoneRecordData map[string]interface{}
+
+func (m zoneRecordData) Get(key string) interface{} {
+	return m[key]
+}
+
+func (m zoneRecordData) GetOk(key string) (interface{}, bool) {
+	v := m[key]
+
+	switch v := v.(type) {
+	case nil:
+		return v, false
+	case bool:
+		return v, v
+	case int:
+		return v, v != 0
+	case string:
+		return v, v != ""
+	case []interface{}:
+		return v, len(v) > 0
+	case *schema.Set:
+		return v, v.Len() > 0
+	default:
+		return v, true
+	}
+}
+
+// isApexSOAOrNS returns whether a resource record set is the hosted zone's SOA or NS record set.
+// Route 53 creates these record sets with the hosted zone and they can't be deleted.
+func isApexSOAOrNS(apiObject *route53.ResourceRecordSet, zoneName string) bool {
+	switch aws.StringValue(apiObject.Type) {
+	case route53.RRTypeSoa, route53.RRTypeNs:
+		return strings.EqualFold(CleanRecordName(strings.TrimSuffix(aws.StringValue(apiObject.Name), ".")), strings.TrimSuffix(zoneName, "."))
+	default:
+		return false
+	}
+}
@@ -0,0 +1,535 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		text     string
+		want     []*route53.ResourceRecordSet
+		wantErr  bool
+	}{
+		{
+			testName: "empty",
+			text:     "; Nothing here\n",
+		},
+		{
+			testName: "BIND zone",
+			text: `
+$ORIGIN example.com.
+$TTL 1h
+@       IN  SOA   ns1 hostmaster (
+                  2024010101 ; serial
+                  1d 2h 4w 1h )
+        IN  NS    ns1
+        IN  NS    ns2.example.net.
+        IN  MX    10 mail
+www     300 IN A  192.0.2.1
+        IN  300 A 192.0.2.2
+mail        A     192.0.2.3
+WWW     IN  AAAA  2001:db8::1
+txt         TXT   "v=spf1 -all" "second \"string\""
+alias       CNAME www
+_sip._tcp   SRV   0 5 5060 sip.example.net.
+`,
+			want: []*route53.ResourceRecordSet{
+				{
+					Name:            aws.String("example.com"),
+					Type:            aws.String("SOA"),
+					TTL:             aws.Int64(3600),
+					ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("ns1.example.com. hostmaster.example.com. 2024010101 1d 2h 4w 1h")}},
+				},
+				{
+					Name: aws.String("example.com"),
+					Type: aws.String("NS"),
+					TTL:  aws.Int64(3600),
+					ResourceRecords: []*route53.ResourceRecord{
+						{Value: aws.String("ns1.example.com.")},
+						{Value: aws.String("ns2.example.net.")},
+					},
+				},
+				{
+					Name:            aws.String("example.com"),
+					Type:            aws.String("MX"),
+					TTL:             aws.Int64(3600),
+					ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("10 mail.example.com.")}},
+				},
+				{
+					Name: aws.String("www.example.com"),
+					Type: aws.String("A"),
+					TTL:  aws.Int64(300),
+					ResourceRecords: []*route53.ResourceRecord{
+						{Value: aws.String("192.0.2.1")},
+						{Value: aws.String("192.0.2.2")},
+					},
+				},
+				{
+					Name:            aws.String("mail.example.com"),
+					Type:            aws.String("A"),
+					TTL:             aws.Int64(3600),
+					ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("192.0.2.3")}},
+				},
+				{
+					Name:            aws.String("www.example.com"),
+					Type:            aws.String("AAAA"),
+					TTL:             aws.Int64(3600),
+					ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("2001:db8::1")}},
+				},
+				{
+					Name:            aws.String("txt.example.com"),
+					Type:            aws.String("TXT"),
+					TTL:             aws.Int64(3600),
+					ResourceRecords: []*route53.ResourceRecord{{Value: aws.String(`"v=spf1 -all" "second \"string\""`)}},
+				},
+				{
+					Name:            aws.String("alias.example.com"),
+					Type:            aws.String("CNAME"),
+					TTL:             aws.Int64(3600),
+					ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("www.example.com.")}},
+				},
+				{
+					Name:            aws.String("_sip._tcp.example.com"),
+					Type:            aws.String("SRV"),
+					TTL:             aws.Int64(3600),
+					ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("0 5 5060 sip.example.net.")}},
+				},
+			},
+		},
+		{
+			testName: "default origin and last TTL",
+			text: `
+www 60 A 192.0.2.1
+api    A 192.0.2.2
+`,
+			want: []*route53.ResourceRecordSet{
+				{
+					Name:            aws.String("www.example.org"),
+					Type:            aws.String("A"),
+					TTL:             aws.Int64(60),
+					ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("192.0.2.1")}},
+				},
+				{
+					Name:            aws.String("api.example.org"),
+					Type:            aws.String("A"),
+					TTL:             aws.Int64(60),
+					ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("192.0.2.2")}},
+				},
+			},
+		},
+		{
+			testName: "no TTL",
+			text:     "www A 192.0.2.1",
+			wantErr:  true,
+		},
+		{
+			testName: "different TTLs",
+			text:     "www 60 A 192.0.2.1\nwww 120 A 192.0.2.2",
+			wantErr:  true,
+		},
+		{
+			testName: "unsupported record type",
+			text:     "www 60 WKS 192.0.2.1 TCP smtp",
+			wantErr:  true,
+		},
+		{
+			testName: "unsupported directive",
+			text:     "$INCLUDE other.zone",
+			wantErr:  true,
+		},
+		{
+			testName: "unbalanced parentheses",
+			text:     "@ 60 SOA ns1 hostmaster ( 1 2 3 4 5",
+			wantErr:  true,
+		},
+		{
+			testName: "unterminated string",
+			text:     `www 60 TXT "abc`,
+			wantErr:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfroute53.ParseZoneFile(testCase.text, "example.org.")
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("ParseZoneFile err = %v, want error = %t", err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestZoneRecordChanges(t *testing.T) {
+	t.Parallel()
+
+	recordSet := func(name, recordType string, ttl int64, values ...string) *route53.ResourceRecordSet {
+		v := &route53.ResourceRecordSet{
+			Name: aws.String(name),
+			Type: aws.String(recordType),
+			TTL:  aws.Int64(ttl),
+		}
+		for _, value := range values {
+			v.ResourceRecords = append(v.ResourceRecords, &route53.ResourceRecord{Value: aws.String(value)})
+		}
+		return v
+	}
+
+	actual := []*route53.ResourceRecordSet{
+		recordSet("example.com.", "SOA", 900, "ns-1.awsdns-1.com. awsdns-hostmaster.amazon.com. 1 7200 900 1209600 86400"),
+		recordSet("example.com.", "NS", 172800, "ns-1.awsdns-1.com."),
+		recordSet("same.example.com.", "A", 300, "192.0.2.2", "192.0.2.1"),
+		recordSet("changed.example.com.", "A", 300, "192.0.2.1"),
+		recordSet("\\052.example.com.", "CNAME", 300, "www.example.com"),
+		recordSet("unwanted.example.com.", "A", 300, "192.0.2.1"),
+		recordSet("txt.example.com.", "TXT", 300, `"hello"`),
+	}
+	desired := []*route53.ResourceRecordSet{
+		recordSet("same.example.com", "A", 300, "192.0.2.1", "192.0.2.2"),
+		recordSet("changed.example.com", "A", 60, "192.0.2.1"),
+		recordSet("*.example.com", "CNAME", 300, "www.example.com"),
+		recordSet("new.example.com", "A", 300, "192.0.2.1"),
+		recordSet("txt.example.com", "TXT", 300, `"hello"`),
+	}
+
+	changes := tfroute53.ZoneRecordChanges(actual, desired, "example.com.")
+
+	var got []string
+	for _, change := range changes {
+		got = append(got, aws.StringValue(change.Action)+" "+aws.StringValue(change.ResourceRecordSet.Name))
+	}
+	want := []string{
+		"DELETE unwanted.example.com.",
+		"CREATE new.example.com",
+		"UPSERT changed.example.com",
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestChunkZoneRecordChanges(t *testing.T) {
+	t.Parallel()
+
+	change := func(action string, values int) *route53.Change {
+		v := &route53.Change{
+			Action:            aws.String(action),
+			ResourceRecordSet: &route53.ResourceRecordSet{},
+		}
+		for i := 0; i < values; i++ {
+			v.ResourceRecordSet.ResourceRecords = append(v.ResourceRecordSet.ResourceRecords, &route53.ResourceRecord{})
+		}
+		return v
+	}
+
+	var changes []*route53.Change
+	for i := 0; i < 1500; i++ {
+		changes = append(changes, change(route53.ChangeActionCreate, 1))
+	}
+	for i := 0; i < 300; i++ {
+		changes = append(changes, change(route53.ChangeActionUpsert, 2))
+	}
+
+	var got []int
+	for _, batch := range tfroute53.ChunkZoneRecordChanges(changes) {
+		got = append(got, len(batch))
+	}
+	// 1000 creates; 500 creates and 125 upserts (4 values each); 175 upserts.
+	want := []int{1000, 625, 175}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestAccRoute53ZoneRecords_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_zone_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZoneRecordsCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "exclude_soa_ns", "true"),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "www." + zoneName.String(),
+						"type":      "A",
+						"ttl":       "300",
+						"records.#": "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "txt." + zoneName.String(),
+						"type":      "TXT",
+						"records.#": "1",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_name", "aws_route53_zone.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccZoneRecordsConfig_updated(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZoneRecordsCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "www." + zoneName.String(),
+						"type":      "A",
+						"ttl":       "60",
+						"records.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name": "api." + zoneName.String(),
+						"type": "CNAME",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZoneRecords_zoneFile(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_zone_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsConfig_zoneFile(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZoneRecordsCount(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "www." + zoneName.String(),
+						"type":      "A",
+						"ttl":       "3600",
+						"records.#": "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name": zoneName.String(),
+						"type": "MX",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name": "alias." + zoneName.String(),
+						"type": "CNAME",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZoneRecords_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	var zone route53.GetHostedZoneOutput
+	resourceName := "aws_route53_zone_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZoneExists(ctx, "aws_route53_zone.test", &zone),
+					testAccCreateRandomRecordsInZoneID(ctx, &zone, 2),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccZoneRecordsConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZoneRecordsCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckZoneRecordsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_route53_zone_records" {
+				continue
+			}
+
+			output, err := tfroute53.FindZoneResourceRecordSets(ctx, conn, rs.Primary.ID, "", false)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(withoutSOAAndNS(output)) > 0 {
+				return fmt.Errorf("Route 53 Zone Records %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+// withoutSOAAndNS removes the SOA and NS record sets that Route 53 creates with a hosted zone.
+func withoutSOAAndNS(recordSets []*route53.ResourceRecordSet) []*route53.ResourceRecordSet {
+	var output []*route53.ResourceRecordSet
+
+	for _, v := range recordSets {
+		if t := aws.StringValue(v.Type); t == route53.RRTypeSoa || t == route53.RRTypeNs {
+			continue
+		}
+		output = append(output, v)
+	}
+
+	return output
+}
+
+func testAccCheckZoneRecordsCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn(ctx)
+
+		output, err := tfroute53.FindZoneResourceRecordSets(ctx, conn, rs.Primary.ID, "", false)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(withoutSOAAndNS(output)); got != want {
+			return fmt.Errorf("Route 53 Zone Records %s: %d record sets, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccZoneRecordsConfig_basic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.1", "192.0.2.2"]
+  }
+
+  record {
+    name    = "txt.%[1]s"
+    type    = "TXT"
+    ttl     = 300
+    records = ["v=spf1 -all"]
+  }
+}
+`, zoneName)
+}
+
+func testAccZoneRecordsConfig_updated(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www"
+    type    = "A"
+    ttl     = 60
+    records = ["192.0.2.1"]
+  }
+
+  record {
+    name    = "api"
+    type    = "CNAME"
+    ttl     = 300
+    records = ["www.%[1]s"]
+  }
+}
+`, zoneName)
+}
+
+func testAccZoneRecordsConfig_zoneFile(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  zone_file = <<-EOT
+    $TTL 1h
+    @     IN MX    10 mail.%[1]s.
+    www   IN A     192.0.2.1
+          IN A     192.0.2.2
+    alias IN CNAME www ; Relative to the zone
+  EOT
+}
+`, zoneName)
+}
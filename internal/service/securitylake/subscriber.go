@@ -457,7 +457,7 @@ func waitSubscriberCreated(ctx context.Context, conn *securitylake.Client, id st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
 		return output, err
@@ -474,7 +474,7 @@ func waitSubscriberUpdated(ctx context.Context, conn *securitylake.Client, id st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
 		return output, err
@@ -491,7 +491,7 @@ func waitSubscriberDeleted(ctx context.Context, conn *securitylake.Client, id st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
 		return output, err
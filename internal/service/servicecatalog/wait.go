@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -84,7 +85,7 @@ func waitProductReady(ctx context.Context, conn *servicecatalog.ServiceCatalog,
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeProductAsAdminOutput); ok {
 		return output, err
@@ -101,7 +102,7 @@ func waitProductDeleted(ctx context.Context, conn *servicecatalog.ServiceCatalog
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeProductAsAdminOutput); ok {
 		return output, err
@@ -122,7 +123,7 @@ func WaitTagOptionReady(ctx context.Context, conn *servicecatalog.ServiceCatalog
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.TagOptionDetail); ok {
 		return output, err
@@ -139,7 +140,7 @@ func WaitTagOptionDeleted(ctx context.Context, conn *servicecatalog.ServiceCatal
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
 		return nil
@@ -162,7 +163,7 @@ func WaitPortfolioShareReady(ctx context.Context, conn *servicecatalog.ServiceCa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.PortfolioShareDetail); ok {
 		return output, err
@@ -185,7 +186,7 @@ func WaitPortfolioShareCreatedWithToken(ctx context.Context, conn *servicecatalo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribePortfolioShareStatusOutput); ok {
 		return output, err
@@ -202,7 +203,7 @@ func WaitPortfolioShareDeleted(ctx context.Context, conn *servicecatalog.Service
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.PortfolioShareDetail); ok {
 		return output, err
@@ -219,7 +220,7 @@ func WaitPortfolioShareDeletedWithToken(ctx context.Context, conn *servicecatalo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribePortfolioShareStatusOutput); ok {
 		return output, err
@@ -236,7 +237,7 @@ func WaitOrganizationsAccessStable(ctx context.Context, conn *servicecatalog.Ser
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.GetAWSOrganizationsAccessStatusOutput); ok {
 		return aws.StringValue(output.AccessStatus), err
@@ -256,7 +257,7 @@ func WaitConstraintReady(ctx context.Context, conn *servicecatalog.ServiceCatalo
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeConstraintOutput); ok {
 		return output, err
@@ -273,7 +274,7 @@ func WaitConstraintDeleted(ctx context.Context, conn *servicecatalog.ServiceCata
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -289,7 +290,7 @@ func WaitProductPortfolioAssociationReady(ctx context.Context, conn *servicecata
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.PortfolioDetail); ok {
 		return output, err
@@ -306,7 +307,7 @@ func WaitProductPortfolioAssociationDeleted(ctx context.Context, conn *serviceca
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -319,7 +320,7 @@ func WaitServiceActionReady(ctx context.Context, conn *servicecatalog.ServiceCat
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.ServiceActionDetail); ok {
 		return output, err
@@ -336,7 +337,7 @@ func WaitServiceActionDeleted(ctx context.Context, conn *servicecatalog.ServiceC
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
 		return nil
@@ -353,7 +354,7 @@ func WaitBudgetResourceAssociationReady(ctx context.Context, conn *servicecatalo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.BudgetDetail); ok {
 		return output, err
@@ -370,7 +371,7 @@ func WaitBudgetResourceAssociationDeleted(ctx context.Context, conn *servicecata
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -383,7 +384,7 @@ func WaitTagOptionResourceAssociationReady(ctx context.Context, conn *servicecat
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.ResourceDetail); ok {
 		return output, err
@@ -400,7 +401,7 @@ func WaitTagOptionResourceAssociationDeleted(ctx context.Context, conn *servicec
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -416,7 +417,7 @@ func WaitProvisioningArtifactReady(ctx context.Context, conn *servicecatalog.Ser
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeProvisioningArtifactOutput); ok {
 		return output, err
@@ -433,7 +434,7 @@ func WaitProvisioningArtifactDeleted(ctx context.Context, conn *servicecatalog.S
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
 		return nil
@@ -453,7 +454,7 @@ func WaitLaunchPathsReady(ctx context.Context, conn *servicecatalog.ServiceCatal
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*servicecatalog.LaunchPathSummary); ok {
 		return output, err
@@ -473,7 +474,7 @@ func WaitProvisionedProductReady(ctx context.Context, conn *servicecatalog.Servi
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeProvisionedProductOutput); ok {
 		if detail := output.ProvisionedProductDetail; detail != nil {
@@ -501,7 +502,7 @@ func WaitProvisionedProductTerminated(ctx context.Context, conn *servicecatalog.
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -514,7 +515,7 @@ func WaitPortfolioConstraintsReady(ctx context.Context, conn *servicecatalog.Ser
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*servicecatalog.ConstraintDetail); ok {
 		return output, err
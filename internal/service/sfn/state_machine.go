@@ -55,9 +55,10 @@ func ResourceStateMachine() *schema.Resource {
 				Computed: true,
 			},
 			"definition": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(0, 1024*1024), // 1048576
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringLenBetween(0, 1024*1024), // 1048576
+				DiffSuppressFunc: verify.SuppressEquivalentJSONOrYAMLDiffs,
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -399,7 +400,7 @@ func waitStateMachineDeleted(ctx context.Context, conn *sfn.SFN, stateMachineArn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sfn.DescribeStateMachineOutput); ok {
 		return output, err
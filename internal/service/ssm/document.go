@@ -85,8 +85,9 @@ func ResourceDocument() *schema.Resource {
 				},
 			},
 			"content": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONOrYAMLDiffs,
 			},
 			"created_date": {
 				Type:     schema.TypeString,
@@ -603,7 +604,7 @@ func waitDocumentActive(ctx context.Context, conn *ssm.SSM, name string) (*ssm.D
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ssm.DocumentDescription); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusInformation)))
@@ -625,7 +626,7 @@ func waitDocumentDeleted(ctx context.Context, conn *ssm.SSM, name string) (*ssm.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*ssm.DocumentDescription); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusInformation)))
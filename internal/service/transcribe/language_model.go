@@ -219,7 +219,7 @@ func waitLanguageModelCreated(ctx context.Context, conn *transcribe.Client, id s
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*types.LanguageModel); ok {
 		return out, err
 	}
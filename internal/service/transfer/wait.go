@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -23,7 +24,7 @@ func waitServerCreated(ctx context.Context, conn *transfer.Transfer, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*transfer.DescribedServer); ok {
 		return output, err
@@ -40,7 +41,7 @@ func waitServerDeleted(ctx context.Context, conn *transfer.Transfer, id string)
 		Timeout: serverDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*transfer.DescribedServer); ok {
 		return output, err
@@ -57,7 +58,7 @@ func waitServerStarted(ctx context.Context, conn *transfer.Transfer, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*transfer.DescribedServer); ok {
 		return output, err
@@ -74,7 +75,7 @@ func waitServerStopped(ctx context.Context, conn *transfer.Transfer, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*transfer.DescribedServer); ok {
 		return output, err
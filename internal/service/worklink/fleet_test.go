@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfworklink "github.com/hashicorp/terraform-provider-aws/internal/service/worklink"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -311,7 +312,7 @@ func testAccCheckFleetDisappears(ctx context.Context, resourceName string) resou
 			MinTimeout: 3 * time.Second,
 		}
 
-		_, err := stateConf.WaitForStateContext(ctx)
+		_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 		return err
 	}
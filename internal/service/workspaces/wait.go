@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/workspaces/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -44,7 +45,7 @@ func WaitDirectoryRegistered(ctx context.Context, conn *workspaces.Client, direc
 		Timeout: DirectoryRegisteredTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*types.WorkspaceDirectory); ok {
 		return v, err
@@ -65,7 +66,7 @@ func WaitDirectoryDeregistered(ctx context.Context, conn *workspaces.Client, dir
 		Timeout: DirectoryDeregisteredTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*types.WorkspaceDirectory); ok {
 		return v, err
@@ -85,7 +86,7 @@ func WaitWorkspaceAvailable(ctx context.Context, conn *workspaces.Client, worksp
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*types.Workspace); ok {
 		return v, err
@@ -119,7 +120,7 @@ func WaitWorkspaceTerminated(ctx context.Context, conn *workspaces.Client, works
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*types.Workspace); ok {
 		return v, err
@@ -144,7 +145,7 @@ func WaitWorkspaceUpdated(ctx context.Context, conn *workspaces.Client, workspac
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*types.Workspace); ok {
 		return v, err
@@ -0,0 +1,450 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Engine runs registered sweepers in dependency order.
+// A sweeper runs once all of the sweepers that it depends on have completed successfully,
+// so independent sweepers run concurrently.
+type Engine struct {
+	// Concurrency is the maximum number of concurrent deletions per service.
+	// Deletions are not limited if zero.
+	Concurrency int
+	// Sweepers restricts the sweep to the named sweepers and the sweepers that they depend on.
+	// All registered sweepers are run if empty.
+	Sweepers []string
+	// StateFile is the path of a local file used to checkpoint progress.
+	// Sweepers recorded as completed in the state file are not run again.
+	// The state file is removed once all regions have been swept successfully.
+	// The state file is not used for dry runs.
+	StateFile string
+	// DryRun lists the resources that would be deleted without deleting them.
+	// Only sweepers registered via Register support dry runs, and Sweepers must be set.
+	DryRun bool
+	// Filters select the resources that are deleted. A resource is deleted only if every filter selects it.
+	// Only sweepers registered via Register support filters, and Sweepers must be set.
+	Filters []Filter
+	// Report, if set, records the outcome of each sweeper and resource.
+	Report *Report
+
+	newClient func(context.Context, string) (*conns.AWSClient, error)
+	sweepers  map[string]*registeredSweeper
+}
+
+// Graph returns the dependency graph of the sweepers that the Engine runs.
+// Dependencies on sweepers that are not registered are ignored.
+func (e *Engine) Graph(ctx context.Context) (*depgraph.Graph, error) {
+	sweepers := e.registeredSweepers()
+	names := tfmaps.Keys(sweepers)
+	slices.Sort(names)
+
+	g := depgraph.New()
+
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	for _, name := range names {
+		for _, dependency := range sweepers[name].dependencies {
+			if !g.HasNode(dependency) {
+				tflog.Warn(ctx, "Ignoring dependency on unregistered sweeper", map[string]any{
+					"sweeper":    name,
+					"dependency": dependency,
+				})
+				continue
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(e.Sweepers) == 0 {
+		return g, nil
+	}
+
+	keep := make(map[string]bool)
+	for _, name := range e.Sweepers {
+		if !g.HasNode(name) {
+			return nil, fmt.Errorf("sweeper %q is not registered", name)
+		}
+
+		dependencies, err := g.DependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+
+		keep[name] = true
+		for _, dependency := range dependencies {
+			keep[dependency] = true
+		}
+	}
+
+	for _, name := range names {
+		if !keep[name] {
+			g.RemoveNode(name)
+		}
+	}
+
+	return g, nil
+}
+
+// Run sweeps the specified regions one after another.
+func (e *Engine) Run(ctx context.Context, regions ...string) error {
+	g, err := e.Graph(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := e.validateSweepers(); err != nil {
+		return err
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return err
+	}
+
+	stateFile := e.StateFile
+	if e.DryRun {
+		stateFile = ""
+	}
+
+	state, err := readSweepState(stateFile)
+	if err != nil {
+		return err
+	}
+
+	var errs *multierror.Error
+
+	for _, region := range regions {
+		if err := e.runRegion(ctx, region, g, order, state); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("sweeping region (%s): %w", region, err))
+		}
+	}
+
+	if err := errs.ErrorOrNil(); err != nil {
+		return err
+	}
+
+	return state.remove()
+}
+
+// validateSweepers returns an error if a dry run or filters are specified and either no sweepers are named in Sweepers
+// or a sweeper named in Sweepers doesn't support dry runs or filters.
+// Sweepers that are run only because a named sweeper depends on them are skipped instead.
+func (e *Engine) validateSweepers() error {
+	if !e.DryRun && len(e.Filters) == 0 {
+		return nil
+	}
+
+	if len(e.Sweepers) == 0 {
+		return errors.New("dry runs and filters require the sweepers to be named")
+	}
+
+	sweepers := e.registeredSweepers()
+
+	var unsupported []string
+	for _, name := range e.Sweepers {
+		if sweepers[name].legacyF != nil {
+			unsupported = append(unsupported, name)
+		}
+	}
+
+	if len(unsupported) > 0 {
+		return fmt.Errorf("sweepers registered via AddTestSweepers do not support dry runs or filters: %s", strings.Join(unsupported, ", "))
+	}
+
+	return nil
+}
+
+func (e *Engine) runRegion(ctx context.Context, region string, graph *depgraph.Graph, order []string, state *sweepState) error {
+	ctx = tflog.SetField(ctx, loggingKeySweeperRegion, region)
+	sweepers := e.registeredSweepers()
+
+	done := make(map[string]chan struct{}, len(order))
+	pools := make(map[string]chan struct{})
+	for _, name := range order {
+		done[name] = make(chan struct{})
+
+		if service := sweepers[name].poolName(); e.Concurrency > 0 && pools[service] == nil {
+			pools[service] = make(chan struct{}, e.Concurrency)
+		}
+	}
+
+	var mu sync.Mutex
+	failed := make(map[string]bool)
+
+	var g multierror.Group
+
+	for _, name := range order {
+		name := name
+		sweeper := sweepers[name]
+
+		g.Go(func() error {
+			defer close(done[name])
+
+			ctx := logWithResourceType(ctx, name)
+
+			dependencies, err := graph.DirectDependenciesOf(name)
+			if err != nil {
+				return err
+			}
+
+			for _, dependency := range dependencies {
+				<-done[dependency]
+			}
+
+			mu.Lock()
+			for _, dependency := range dependencies {
+				if failed[dependency] {
+					failed[name] = true
+				}
+			}
+			skip := failed[name]
+			mu.Unlock()
+
+			if skip {
+				tflog.Warn(ctx, "Skipping sweeper, a dependency failed")
+				e.Report.addSweeper(region, name, SweeperStatusSkipped, "a dependency failed")
+				return nil
+			}
+
+			if state.isCompleted(region, name) {
+				tflog.Info(ctx, "Skipping sweeper, already completed")
+				e.Report.addSweeper(region, name, SweeperStatusSkipped, "already completed according to the state file")
+				return nil
+			}
+
+			if sweeper.legacyF != nil && (e.DryRun || len(e.Filters) > 0) {
+				tflog.Warn(ctx, "Skipping sweeper, it does not support dry runs or filters")
+				e.Report.addSweeper(region, name, SweeperStatusSkipped, "sweeper does not support dry runs or filters")
+				return nil
+			}
+
+			skipReason, err := e.sweep(ctx, region, sweeper, pools[sweeper.poolName()])
+			if err != nil {
+				mu.Lock()
+				failed[name] = true
+				mu.Unlock()
+
+				e.Report.addSweeper(region, name, SweeperStatusFailed, err.Error())
+				return err
+			}
+
+			if skipReason != "" {
+				e.Report.addSweeper(region, name, SweeperStatusSkipped, skipReason)
+			} else {
+				e.Report.addSweeper(region, name, SweeperStatusCompleted, "")
+			}
+
+			return state.complete(region, name)
+		})
+	}
+
+	return g.Wait().ErrorOrNil()
+}
+
+// sweep runs the specified sweeper.
+// If the sweeper is skipped, for example because the service is not available in the region, the reason is returned.
+func (e *Engine) sweep(ctx context.Context, region string, sweeper *registeredSweeper, pool chan struct{}) (string, error) {
+	if sweeper.legacyF != nil {
+		// Sweepers registered via AddTestSweepers delete their resources themselves
+		// and each occupy one of the service's workers while running.
+		if err := acquire(ctx, pool); err != nil {
+			return "", err
+		}
+		defer release(pool)
+
+		return "", sweeper.legacyF(region)
+	}
+
+	newClient := e.newClient
+	if newClient == nil {
+		newClient = SharedRegionalSweepClient
+	}
+
+	client, err := newClient(ctx, region)
+	if err != nil {
+		return "", fmt.Errorf("getting client: %w", err)
+	}
+
+	sweepables, err := sweeper.f(ctx, client)
+
+	if SkipSweepError(err) {
+		tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+			"error": err.Error(),
+		})
+		return err.Error(), nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("listing %q (%s): %w", sweeper.name, region, err)
+	}
+
+	sweepables = e.selectSweepables(ctx, region, sweeper.name, sweepables)
+
+	if err := SweepOrchestrator(withConcurrencyLimiter(ctx, pool), sweepables); err != nil {
+		return "", fmt.Errorf("sweeping %q (%s): %w", sweeper.name, region, err)
+	}
+
+	return "", nil
+}
+
+// selectSweepables returns the sweepables that are to be deleted, reporting those that are skipped.
+// No sweepables are returned for dry runs.
+func (e *Engine) selectSweepables(ctx context.Context, region, name string, sweepables []Sweepable) []Sweepable {
+	if !e.DryRun && len(e.Filters) == 0 && e.Report == nil {
+		return sweepables
+	}
+
+	var selected []Sweepable
+
+	for _, sweepable := range sweepables {
+		info, reason := e.selectSweepable(ctx, sweepable)
+
+		if reason != "" {
+			e.Report.addResource(region, name, info, ResourceActionSkipped, reason)
+			continue
+		}
+
+		if e.DryRun {
+			e.Report.addResource(region, name, info, ResourceActionWouldDelete, "")
+			continue
+		}
+
+		selected = append(selected, &reportingSweepable{
+			Sweepable: sweepable,
+			info:      info,
+			name:      name,
+			region:    region,
+			report:    e.Report,
+		})
+	}
+
+	return selected
+}
+
+// selectSweepable returns information about the sweepable's resource and, if the resource is skipped, the reason why.
+func (e *Engine) selectSweepable(ctx context.Context, sweepable Sweepable) (*ResourceInfo, string) {
+	describer, ok := sweepable.(Describer)
+	if !ok {
+		if len(e.Filters) > 0 {
+			return &ResourceInfo{}, "resource cannot be described"
+		}
+
+		return &ResourceInfo{}, ""
+	}
+
+	attributes, err := describer.Describe(ctx, len(e.Filters) > 0)
+	if err != nil {
+		return &ResourceInfo{}, fmt.Sprintf("describing resource: %s", err)
+	}
+
+	info := newResourceInfo(attributes)
+
+	if info.ID == "" {
+		return info, "resource no longer exists"
+	}
+
+	for _, filter := range e.Filters {
+		if reason := filter(info); reason != "" {
+			return info, reason
+		}
+	}
+
+	return info, ""
+}
+
+// reportingSweepable reports the outcome of deleting a resource.
+type reportingSweepable struct {
+	Sweepable
+	info   *ResourceInfo
+	name   string
+	region string
+	report *Report
+}
+
+func (s *reportingSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	err := s.Sweepable.Delete(ctx, timeout, optFns...)
+
+	if err != nil {
+		s.report.addResource(s.region, s.name, s.info, ResourceActionFailed, err.Error())
+	} else {
+		s.report.addResource(s.region, s.name, s.info, ResourceActionDeleted, "")
+	}
+
+	return err
+}
+
+func (e *Engine) registeredSweepers() map[string]*registeredSweeper {
+	if e.sweepers != nil {
+		return e.sweepers
+	}
+
+	return registeredSweepers
+}
+
+// poolName returns the name of the worker pool that the sweeper's deletions are run in.
+func (s *registeredSweeper) poolName() string {
+	if s.service != "" {
+		return s.service
+	}
+
+	return s.name
+}
+
+type concurrencyLimiterKey struct{}
+
+// withConcurrencyLimiter returns a context that limits SweepOrchestrator's concurrency
+// to the capacity of the specified channel, which may be shared between calls.
+// A nil channel does not limit concurrency.
+func withConcurrencyLimiter(ctx context.Context, limiter chan struct{}) context.Context {
+	return context.WithValue(ctx, concurrencyLimiterKey{}, limiter)
+}
+
+func concurrencyLimiter(ctx context.Context) chan struct{} {
+	if v, ok := ctx.Value(concurrencyLimiterKey{}).(chan struct{}); ok {
+		return v
+	}
+
+	return nil
+}
+
+func acquire(ctx context.Context, limiter chan struct{}) error {
+	if limiter == nil {
+		return nil
+	}
+
+	select {
+	case limiter <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func release(limiter chan struct{}) {
+	if limiter == nil {
+		return
+	}
+
+	<-limiter
+}
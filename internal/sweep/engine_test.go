@@ -0,0 +1,519 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepLog struct {
+	mu    sync.Mutex
+	calls []string
+	fail  map[string]bool
+}
+
+func (l *testSweepLog) sweeper(name string, dependencies ...string) *registeredSweeper {
+	return &registeredSweeper{
+		name:         name,
+		dependencies: dependencies,
+		legacyF: func(region string) error {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+
+			l.calls = append(l.calls, region+"/"+name)
+			if l.fail[name] {
+				return errors.New("failed")
+			}
+
+			return nil
+		},
+	}
+}
+
+func (l *testSweepLog) sweepers(sweepers ...*registeredSweeper) map[string]*registeredSweeper {
+	m := make(map[string]*registeredSweeper)
+	for _, v := range sweepers {
+		m[v.name] = v
+	}
+
+	return m
+}
+
+func TestEngineGraph(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := &testSweepLog{}
+	sweepers := l.sweepers(
+		l.sweeper("aws_vpc", "aws_subnet", "aws_not_registered"),
+		l.sweeper("aws_subnet", "aws_instance"),
+		l.sweeper("aws_instance"),
+		l.sweeper("aws_sqs_queue"),
+	)
+
+	testCases := []struct {
+		name     string
+		sweepers []string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "all",
+			expected: []string{"aws_sqs_queue", "aws_instance", "aws_subnet", "aws_vpc"},
+		},
+		{
+			name:     "with dependencies",
+			sweepers: []string{"aws_subnet"},
+			expected: []string{"aws_instance", "aws_subnet"},
+		},
+		{
+			name:     "not registered",
+			sweepers: []string{"aws_not_registered"},
+			wantErr:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := &Engine{Sweepers: testCase.sweepers, sweepers: sweepers}
+			g, err := e.Graph(ctx)
+
+			if got, expected := err != nil, testCase.wantErr; got != expected {
+				t.Fatalf("incorrect error. Expected: %t, got: %t (%v)", expected, got, err)
+			}
+			if err != nil {
+				return
+			}
+
+			order, err := g.OverallOrder()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(order, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestEngineRunDependencyCycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := &testSweepLog{}
+	e := &Engine{
+		sweepers: l.sweepers(
+			l.sweeper("a", "b"),
+			l.sweeper("b", "a"),
+		),
+	}
+
+	if err := e.Run(ctx, "us-west-2"); err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if got, expected := len(l.calls), 0; got != expected {
+		t.Fatalf("incorrect number of sweeper calls. Expected: %d, got: %d", expected, got)
+	}
+}
+
+func TestEngineRunOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := &testSweepLog{}
+	e := &Engine{
+		sweepers: l.sweepers(
+			l.sweeper("aws_vpc", "aws_subnet"),
+			l.sweeper("aws_subnet", "aws_instance"),
+			l.sweeper("aws_instance"),
+		),
+	}
+
+	if err := e.Run(ctx, "us-west-2", "us-east-1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{
+		"us-west-2/aws_instance",
+		"us-west-2/aws_subnet",
+		"us-west-2/aws_vpc",
+		"us-east-1/aws_instance",
+		"us-east-1/aws_subnet",
+		"us-east-1/aws_vpc",
+	}
+	if diff := cmp.Diff(l.calls, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestEngineRunResume(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	stateFile := filepath.Join(t.TempDir(), "sweep.json")
+	l := &testSweepLog{
+		fail: map[string]bool{"aws_subnet": true},
+	}
+	e := &Engine{
+		StateFile: stateFile,
+		sweepers: l.sweepers(
+			l.sweeper("aws_vpc", "aws_subnet"),
+			l.sweeper("aws_subnet"),
+			l.sweeper("aws_sqs_queue"),
+		),
+	}
+
+	if err := e.Run(ctx, "us-west-2"); err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	// aws_vpc is not swept as its dependency failed.
+	calls := slices.Clone(l.calls)
+	slices.Sort(calls)
+	if diff := cmp.Diff(calls, []string{"us-west-2/aws_sqs_queue", "us-west-2/aws_subnet"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	b, err := os.ReadFile(stateFile)
+	if err != nil {
+		t.Fatalf("reading state file: %s", err)
+	}
+
+	var state sweepState
+	if err := json.Unmarshal(b, &state); err != nil {
+		t.Fatalf("parsing state file: %s", err)
+	}
+
+	if diff := cmp.Diff(state.Completed, map[string][]string{"us-west-2": {"aws_sqs_queue"}}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// Resume.
+	l.calls = nil
+	l.fail = nil
+
+	if err := e.Run(ctx, "us-west-2"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(l.calls, []string{"us-west-2/aws_subnet", "us-west-2/aws_vpc"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if _, err := os.Stat(stateFile); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected state file to be removed, got: %v", err)
+	}
+}
+
+type testSweepable struct {
+	active, maxActive *atomic.Int32
+}
+
+func (s testSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	n := s.active.Add(1)
+	defer s.active.Add(-1)
+
+	for {
+		m := s.maxActive.Load()
+		if n <= m || s.maxActive.CompareAndSwap(m, n) {
+			break
+		}
+	}
+
+	time.Sleep(10 * time.Millisecond)
+
+	return nil
+}
+
+func TestSweepOrchestratorConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var active, maxActive atomic.Int32
+
+	var sweepables []Sweepable
+	for i := 0; i < 20; i++ {
+		sweepables = append(sweepables, testSweepable{active: &active, maxActive: &maxActive})
+	}
+
+	ctx = withConcurrencyLimiter(ctx, make(chan struct{}, 3))
+
+	if err := SweepOrchestrator(ctx, sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := maxActive.Load(), int32(3); got > expected {
+		t.Fatalf("too many concurrent deletions. Expected at most: %d, got: %d", expected, got)
+	}
+}
+
+func TestSweepOrchestratorNoConcurrencyLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var active, maxActive atomic.Int32
+
+	var sweepables []Sweepable
+	for i := 0; i < 20; i++ {
+		sweepables = append(sweepables, testSweepable{active: &active, maxActive: &maxActive})
+	}
+
+	if err := SweepOrchestrator(ctx, sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := maxActive.Load(), int32(1); got <= expected {
+		t.Fatalf("deletions were not concurrent. Expected more than: %d, got: %d", expected, got)
+	}
+}
+
+type testDescribedSweepable struct {
+	attributes map[string]string
+	deleted    *atomic.Int32
+}
+
+func (s testDescribedSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	s.deleted.Add(1)
+
+	return nil
+}
+
+func (s testDescribedSweepable) Describe(ctx context.Context, refresh bool) (map[string]string, error) {
+	return s.attributes, nil
+}
+
+func TestEngineRunFilters(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var deleted atomic.Int32
+	l := &testSweepLog{}
+	sweepers := l.sweepers(
+		l.sweeper("aws_vpc"),
+		&registeredSweeper{
+			name:         "aws_sqs_queue",
+			dependencies: []string{"aws_vpc"},
+			f: func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error) {
+				return []Sweepable{
+					testDescribedSweepable{
+						attributes: map[string]string{"id": "tf-acc-test-1", "tags.%": "1", "tags.Owner": "ci"},
+						deleted:    &deleted,
+					},
+					testDescribedSweepable{
+						attributes: map[string]string{"id": "tf-acc-test-2"},
+						deleted:    &deleted,
+					},
+					testDescribedSweepable{
+						attributes: map[string]string{"id": "production", "tags.%": "1", "tags.Owner": "ci"},
+						deleted:    &deleted,
+					},
+				}, nil
+			},
+		},
+	)
+	newClient := func(context.Context, string) (*conns.AWSClient, error) {
+		return &conns.AWSClient{}, nil
+	}
+	filters := []Filter{NamePrefixFilter("tf-acc-test"), TagFilter("Owner", "ci")}
+
+	report := &Report{DryRun: true}
+	e := &Engine{
+		DryRun:    true,
+		Filters:   filters,
+		Report:    report,
+		Sweepers:  []string{"aws_sqs_queue"},
+		newClient: newClient,
+		sweepers:  sweepers,
+	}
+
+	if err := e.Run(ctx, "us-west-2"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := deleted.Load(), int32(0); got != expected {
+		t.Fatalf("incorrect number of deletions. Expected: %d, got: %d", expected, got)
+	}
+	if got, expected := len(l.calls), 0; got != expected {
+		t.Fatalf("incorrect number of sweeper calls. Expected: %d, got: %d", expected, got)
+	}
+
+	var b strings.Builder
+	if err := report.WriteJSON(&b); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedReport := `{
+  "dry_run": true,
+  "sweepers": [
+    {
+      "region": "us-west-2",
+      "sweeper": "aws_sqs_queue",
+      "status": "completed"
+    },
+    {
+      "region": "us-west-2",
+      "sweeper": "aws_vpc",
+      "status": "skipped",
+      "reason": "sweeper does not support dry runs or filters"
+    }
+  ],
+  "resources": [
+    {
+      "region": "us-west-2",
+      "sweeper": "aws_sqs_queue",
+      "id": "production",
+      "tags": {
+        "Owner": "ci"
+      },
+      "action": "skipped",
+      "reason": "name \"production\" does not start with any of: tf-acc-test"
+    },
+    {
+      "region": "us-west-2",
+      "sweeper": "aws_sqs_queue",
+      "id": "tf-acc-test-1",
+      "tags": {
+        "Owner": "ci"
+      },
+      "action": "would_delete"
+    },
+    {
+      "region": "us-west-2",
+      "sweeper": "aws_sqs_queue",
+      "id": "tf-acc-test-2",
+      "action": "skipped",
+      "reason": "tags are not known"
+    }
+  ]
+}
+`
+	if diff := cmp.Diff(b.String(), expectedReport); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	report = &Report{}
+	e = &Engine{
+		Filters:   filters,
+		Report:    report,
+		Sweepers:  []string{"aws_sqs_queue"},
+		newClient: newClient,
+		sweepers:  sweepers,
+	}
+
+	if err := e.Run(ctx, "us-west-2"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := deleted.Load(), int32(1); got != expected {
+		t.Fatalf("incorrect number of deletions. Expected: %d, got: %d", expected, got)
+	}
+
+	var actions []string
+	for _, v := range report.Resources {
+		actions = append(actions, v.ID+":"+v.Action)
+	}
+	slices.Sort(actions)
+
+	if diff := cmp.Diff(actions, []string{"production:skipped", "tf-acc-test-1:deleted", "tf-acc-test-2:skipped"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestEngineRunUnsupportedSweepers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := &testSweepLog{}
+	sweepers := l.sweepers(
+		l.sweeper("aws_vpc"),
+		l.sweeper("aws_subnet"),
+		&registeredSweeper{
+			name:         "aws_sqs_queue",
+			dependencies: []string{"aws_subnet"},
+			f: func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error) {
+				return nil, nil
+			},
+		},
+	)
+	newClient := func(context.Context, string) (*conns.AWSClient, error) {
+		return &conns.AWSClient{}, nil
+	}
+
+	// Sweepers must be named.
+	e := &Engine{
+		DryRun:    true,
+		newClient: newClient,
+		sweepers:  sweepers,
+	}
+
+	err := e.Run(ctx, "us-west-2")
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+	if got, expected := err.Error(), "dry runs and filters require the sweepers to be named"; got != expected {
+		t.Errorf("incorrect error. Expected: %q, got: %q", expected, got)
+	}
+
+	e = &Engine{
+		DryRun:    true,
+		Sweepers:  []string{"aws_vpc", "aws_sqs_queue"},
+		newClient: newClient,
+		sweepers:  sweepers,
+	}
+
+	err = e.Run(ctx, "us-west-2")
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+	if got, expected := err.Error(), "do not support dry runs or filters: aws_vpc"; !strings.HasSuffix(got, expected) {
+		t.Errorf("incorrect error. Expected suffix: %q, got: %q", expected, got)
+	}
+
+	// Dependencies that don't support dry runs are skipped.
+	report := &Report{DryRun: true}
+	e = &Engine{
+		DryRun:    true,
+		Report:    report,
+		Sweepers:  []string{"aws_sqs_queue"},
+		newClient: newClient,
+		sweepers:  sweepers,
+	}
+
+	if err := e.Run(ctx, "us-west-2"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(l.calls), 0; got != expected {
+		t.Fatalf("incorrect number of sweeper calls. Expected: %d, got: %d", expected, got)
+	}
+
+	var statuses []string
+	for _, v := range report.Sweepers {
+		statuses = append(statuses, v.Sweeper+":"+v.Status)
+	}
+	slices.Sort(statuses)
+
+	if diff := cmp.Diff(statuses, []string{"aws_sqs_queue:completed", "aws_subnet:skipped"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Describer is implemented by Sweepables that can describe the resource that they delete.
+type Describer interface {
+	// Describe returns the resource's attributes in flatmap format.
+	// If refresh is true the resource is read first so that all of its attributes are available.
+	Describe(ctx context.Context, refresh bool) (map[string]string, error)
+}
+
+// ResourceInfo describes a resource that is to be swept.
+// Tags is nil if the resource's tags are not known.
+type ResourceInfo struct {
+	ID        string            `json:"id,omitempty"`
+	Name      string            `json:"name,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`
+	CreatedAt *time.Time        `json:"created_at,omitempty"`
+}
+
+// createdAtAttributes are the names of attributes that commonly contain a resource's creation time.
+var createdAtAttributes = []string{
+	"created_date",
+	"created_at",
+	"creation_time",
+	"created_time",
+	"creation_date",
+	"create_time",
+	"create_date",
+}
+
+func newResourceInfo(attributes map[string]string) *ResourceInfo {
+	info := &ResourceInfo{
+		ID:   attributes["id"],
+		Name: attributes["name"],
+	}
+
+	if _, ok := attributes["tags.%"]; ok {
+		info.Tags = make(map[string]string)
+
+		for k, v := range attributes {
+			if k, ok := strings.CutPrefix(k, "tags."); ok && k != "%" {
+				info.Tags[k] = v
+			}
+		}
+	}
+
+	for _, k := range createdAtAttributes {
+		if t, err := time.Parse(time.RFC3339, attributes[k]); err == nil {
+			info.CreatedAt = &t
+			break
+		}
+	}
+
+	return info
+}
+
+// Filter selects the resources that are swept.
+// It returns an empty string if the resource is selected, or the reason that the resource is skipped.
+type Filter func(info *ResourceInfo) string
+
+// NamePrefixFilter selects resources whose name starts with one of the specified prefixes.
+// A resource's ID is used if its name is not known.
+func NamePrefixFilter(prefixes ...string) Filter {
+	return func(info *ResourceInfo) string {
+		name := info.Name
+		if name == "" {
+			name = info.ID
+		}
+
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(name, prefix) {
+				return ""
+			}
+		}
+
+		return fmt.Sprintf("name %q does not start with any of: %s", name, strings.Join(prefixes, ", "))
+	}
+}
+
+// TagFilter selects resources that have the specified tag.
+// If value is empty any value is selected.
+func TagFilter(key, value string) Filter {
+	return func(info *ResourceInfo) string {
+		if info.Tags == nil {
+			return "tags are not known"
+		}
+
+		v, ok := info.Tags[key]
+
+		if !ok {
+			return fmt.Sprintf("tag %q is not present", key)
+		}
+
+		if value != "" && v != value {
+			return fmt.Sprintf("tag %q value %q is not %q", key, v, value)
+		}
+
+		return ""
+	}
+}
+
+// MaxAgeFilter selects resources that were created more than maxAge before now.
+// Resources whose creation time is not known are skipped.
+func MaxAgeFilter(maxAge time.Duration, now time.Time) Filter {
+	return func(info *ResourceInfo) string {
+		if info.CreatedAt == nil {
+			return "creation time is not known"
+		}
+
+		if age := now.Sub(*info.CreatedAt); age <= maxAge {
+			return fmt.Sprintf("age %s is not more than %s", age.Truncate(time.Second), maxAge)
+		}
+
+		return ""
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewResourceInfo(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		attributes map[string]string
+		expected   *ResourceInfo
+	}{
+		{
+			name:       "empty",
+			attributes: map[string]string{},
+			expected:   &ResourceInfo{},
+		},
+		{
+			name: "id only",
+			attributes: map[string]string{
+				"id": "vpc-12345678",
+			},
+			expected: &ResourceInfo{
+				ID: "vpc-12345678",
+			},
+		},
+		{
+			name: "all",
+			attributes: map[string]string{
+				"id":          "arn:aws:sqs:us-west-2:123456789012:tf-acc-test",
+				"name":        "tf-acc-test",
+				"tags.%":      "2",
+				"tags.Name":   "tf-acc-test",
+				"tags.Owner":  "ci",
+				"create_date": "2024-01-02T03:04:05Z",
+			},
+			expected: &ResourceInfo{
+				ID:   "arn:aws:sqs:us-west-2:123456789012:tf-acc-test",
+				Name: "tf-acc-test",
+				Tags: map[string]string{
+					"Name":  "tf-acc-test",
+					"Owner": "ci",
+				},
+				CreatedAt: &createdAt,
+			},
+		},
+		{
+			name: "no tags",
+			attributes: map[string]string{
+				"id":            "tf-acc-test",
+				"tags.%":        "0",
+				"creation_date": "not a timestamp",
+			},
+			expected: &ResourceInfo{
+				ID:   "tf-acc-test",
+				Tags: map[string]string{},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := newResourceInfo(testCase.attributes)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFilters(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)
+	old := now.Add(-48 * time.Hour)
+	recent := now.Add(-1 * time.Hour)
+
+	testCases := []struct {
+		name     string
+		filter   Filter
+		info     *ResourceInfo
+		expected string
+	}{
+		{
+			name:   "name prefix match",
+			filter: NamePrefixFilter("tf-acc-test", "tf-test"),
+			info:   &ResourceInfo{ID: "i-12345678", Name: "tf-test-1"},
+		},
+		{
+			name:   "name prefix match ID",
+			filter: NamePrefixFilter("tf-acc-test"),
+			info:   &ResourceInfo{ID: "tf-acc-test-1"},
+		},
+		{
+			name:     "name prefix no match",
+			filter:   NamePrefixFilter("tf-acc-test"),
+			info:     &ResourceInfo{ID: "i-12345678", Name: "production"},
+			expected: `name "production" does not start with any of: tf-acc-test`,
+		},
+		{
+			name:   "tag any value",
+			filter: TagFilter("Owner", ""),
+			info:   &ResourceInfo{Tags: map[string]string{"Owner": "ci"}},
+		},
+		{
+			name:   "tag value",
+			filter: TagFilter("Owner", "ci"),
+			info:   &ResourceInfo{Tags: map[string]string{"Owner": "ci"}},
+		},
+		{
+			name:     "tag value mismatch",
+			filter:   TagFilter("Owner", "ci"),
+			info:     &ResourceInfo{Tags: map[string]string{"Owner": "ops"}},
+			expected: `tag "Owner" value "ops" is not "ci"`,
+		},
+		{
+			name:     "tag not present",
+			filter:   TagFilter("Owner", ""),
+			info:     &ResourceInfo{Tags: map[string]string{}},
+			expected: `tag "Owner" is not present`,
+		},
+		{
+			name:     "tags not known",
+			filter:   TagFilter("Owner", ""),
+			info:     &ResourceInfo{},
+			expected: "tags are not known",
+		},
+		{
+			name:   "max age old",
+			filter: MaxAgeFilter(24*time.Hour, now),
+			info:   &ResourceInfo{CreatedAt: &old},
+		},
+		{
+			name:     "max age recent",
+			filter:   MaxAgeFilter(24*time.Hour, now),
+			info:     &ResourceInfo{CreatedAt: &recent},
+			expected: "age 1h0m0s is not more than 24h0m0s",
+		},
+		{
+			name:     "max age not known",
+			filter:   MaxAgeFilter(24*time.Hour, now),
+			info:     &ResourceInfo{},
+			expected: "creation time is not known",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := testCase.filter(testCase.info), testCase.expected; got != expected {
+				t.Errorf("got %q, want %q", got, expected)
+			}
+		})
+	}
+}
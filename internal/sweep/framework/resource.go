@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -118,6 +119,18 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Describe returns the attributes that the sweep resource was created with.
+// Framework resources are not read, so refresh is ignored.
+func (sr *sweepResource) Describe(ctx context.Context, refresh bool) (map[string]string, error) {
+	attributes := make(map[string]string, len(sr.attributes))
+
+	for _, attr := range sr.attributes {
+		attributes[attr.path] = fmt.Sprint(attr.value)
+	}
+
+	return attributes, nil
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
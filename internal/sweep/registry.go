@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// registeredSweeper is a sweeper registered via Register or AddTestSweepers.
+type registeredSweeper struct {
+	name         string
+	dependencies []string
+	service      string
+	// f is set for sweepers registered via Register.
+	f SweeperFn
+	// legacyF is set for sweepers registered via AddTestSweepers.
+	legacyF func(region string) error
+}
+
+// registeredSweepers contains all registered sweepers, keyed by name.
+var registeredSweepers = make(map[string]*registeredSweeper)
+
+// AddTestSweepers registers a sweeper for the specified service package with the acceptance test framework
+// and with the sweeper Engine.
+// Use it in place of resource.AddTestSweepers.
+func AddTestSweepers(service, name string, s *resource.Sweeper) {
+	registeredSweepers[name] = &registeredSweeper{
+		name:         name,
+		dependencies: s.Dependencies,
+		service:      service,
+		legacyF:      s.F,
+	}
+
+	resource.AddTestSweepers(name, s)
+}
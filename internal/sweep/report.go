@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"cmp"
+	"encoding/json"
+	"io"
+	"slices"
+	"sync"
+)
+
+const (
+	SweeperStatusCompleted = "completed"
+	SweeperStatusFailed    = "failed"
+	SweeperStatusSkipped   = "skipped"
+)
+
+const (
+	ResourceActionDeleted     = "deleted"
+	ResourceActionFailed      = "failed"
+	ResourceActionSkipped     = "skipped"
+	ResourceActionWouldDelete = "would_delete"
+)
+
+// Report is a machine-readable record of what a sweep did, or would have done.
+type Report struct {
+	DryRun    bool             `json:"dry_run"`
+	Sweepers  []SweeperReport  `json:"sweepers"`
+	Resources []ResourceReport `json:"resources"`
+
+	mu sync.Mutex
+}
+
+// SweeperReport records the outcome of running a sweeper in a region.
+type SweeperReport struct {
+	Region  string `json:"region"`
+	Sweeper string `json:"sweeper"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// ResourceReport records what happened to an individual resource.
+type ResourceReport struct {
+	Region  string `json:"region"`
+	Sweeper string `json:"sweeper"`
+	ResourceInfo
+	Action string `json:"action"`
+	Reason string `json:"reason,omitempty"`
+}
+
+func (r *Report) addSweeper(region, sweeper, status, reason string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.Sweepers = append(r.Sweepers, SweeperReport{
+		Region:  region,
+		Sweeper: sweeper,
+		Status:  status,
+		Reason:  reason,
+	})
+}
+
+func (r *Report) addResource(region, sweeper string, info *ResourceInfo, action, reason string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.Resources = append(r.Resources, ResourceReport{
+		Region:       region,
+		Sweeper:      sweeper,
+		ResourceInfo: *info,
+		Action:       action,
+		Reason:       reason,
+	})
+}
+
+// WriteJSON writes the report as JSON, ordered by region, sweeper and resource ID.
+func (r *Report) WriteJSON(w io.Writer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.Sweepers == nil {
+		r.Sweepers = []SweeperReport{}
+	}
+	if r.Resources == nil {
+		r.Resources = []ResourceReport{}
+	}
+
+	slices.SortStableFunc(r.Sweepers, func(a, b SweeperReport) int {
+		if v := cmp.Compare(a.Region, b.Region); v != 0 {
+			return v
+		}
+		return cmp.Compare(a.Sweeper, b.Sweeper)
+	})
+	slices.SortStableFunc(r.Resources, func(a, b ResourceReport) int {
+		if v := cmp.Compare(a.Region, b.Region); v != 0 {
+			return v
+		}
+		if v := cmp.Compare(a.Sweeper, b.Sweeper); v != 0 {
+			return v
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
@@ -89,6 +89,24 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Describe returns the resource's attributes in flatmap format, reading the resource first if refresh is true.
+func (sr *sweepResource) Describe(ctx context.Context, refresh bool) (map[string]string, error) {
+	if refresh {
+		ctx = tflog.SetField(ctx, "id", sr.d.Id())
+
+		if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+			return nil, err
+		}
+	}
+
+	state := sr.d.State()
+	if state == nil {
+		return map[string]string{}, nil
+	}
+
+	return state.Attributes, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
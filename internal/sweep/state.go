@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+)
+
+// sweepState records the sweepers that have completed in each region.
+type sweepState struct {
+	Completed map[string][]string `json:"completed"`
+
+	mu   sync.Mutex
+	path string
+}
+
+// readSweepState reads the sweep state from the specified file.
+// The state is empty if the file doesn't exist, and isn't persisted if path is empty.
+func readSweepState(path string) (*sweepState, error) {
+	state := &sweepState{
+		Completed: make(map[string][]string),
+		path:      path,
+	}
+
+	if path == "" {
+		return state, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading sweep state (%s): %w", path, err)
+	}
+
+	if err := json.Unmarshal(b, state); err != nil {
+		return nil, fmt.Errorf("parsing sweep state (%s): %w", path, err)
+	}
+
+	if state.Completed == nil {
+		state.Completed = make(map[string][]string)
+	}
+
+	return state, nil
+}
+
+func (s *sweepState) isCompleted(region, name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Contains(s.Completed[region], name)
+}
+
+// complete records that the named sweeper has completed in the specified region and checkpoints the state.
+func (s *sweepState) complete(region, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !slices.Contains(s.Completed[region], name) {
+		s.Completed[region] = append(s.Completed[region], name)
+		slices.Sort(s.Completed[region])
+	}
+
+	return s.write()
+}
+
+// write persists the state. The file is replaced atomically so that an interrupted write doesn't lose progress.
+func (s *sweepState) write() error {
+	if s.path == "" {
+		return nil
+	}
+
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("writing sweep state (%s): %w", s.path, err)
+	}
+
+	_, err = f.Write(b)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), s.path)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return fmt.Errorf("writing sweep state (%s): %w", s.path, err)
+	}
+
+	return nil
+}
+
+// remove deletes the persisted state.
+func (s *sweepState) remove() error {
+	if s.path == "" {
+		return nil
+	}
+
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("removing sweep state (%s): %w", s.path, err)
+	}
+
+	return nil
+}
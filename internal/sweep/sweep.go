@@ -105,17 +105,26 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestrator deletes the specified resources concurrently.
+// The number of resources deleted at a time is not limited, unless the Engine that is running the sweeper sets a limit.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	limiter := concurrencyLimiter(ctx)
+
 	var g multierror.Group
 
 	for _, sweepable := range sweepables {
 		sweepable := sweepable
 
 		g.Go(func() error {
+			if err := acquire(ctx, limiter); err != nil {
+				return err
+			}
+			defer release(limiter)
+
 			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
 		})
 	}
@@ -138,9 +147,19 @@ func PartitionDNSSuffix(region string) string {
 
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
 
-func Register(name string, f SweeperFn, dependencies ...string) {
+// Register registers a sweeper for the specified service package with the acceptance test framework
+// and with the sweeper Engine.
+func Register(service, name string, f SweeperFn, dependencies ...string) {
+	registeredSweepers[name] = &registeredSweeper{
+		name:         name,
+		dependencies: dependencies,
+		service:      service,
+		f:            f,
+	}
+
 	resource.AddTestSweepers(name, &resource.Sweeper{
-		Name: name,
+		Name:         name,
+		Dependencies: dependencies,
 		F: func(region string) error {
 			ctx := Context(region)
 			ctx = logWithResourceType(ctx, name)
@@ -149,24 +168,29 @@ func Register(name string, f SweeperFn, dependencies ...string) {
 			if err != nil {
 				return fmt.Errorf("getting client: %w", err)
 			}
-			sweepResources, err := f(ctx, client)
-
-			if SkipSweepError(err) {
-				tflog.Warn(ctx, "Skipping sweeper", map[string]any{
-					"error": err.Error(),
-				})
-				return nil
-			}
-			if err != nil {
-				return fmt.Errorf("listing %q (%s): %w", name, region, err)
-			}
-
-			err = SweepOrchestrator(ctx, sweepResources)
-			if err != nil {
-				return fmt.Errorf("sweeping %q (%s): %w", name, region, err)
-			}
 
-			return nil
+			return sweepRegistered(ctx, client, name, region, f)
 		},
 	})
 }
+
+func sweepRegistered(ctx context.Context, client *conns.AWSClient, name, region string, f SweeperFn) error {
+	sweepResources, err := f(ctx, client)
+
+	if SkipSweepError(err) {
+		tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+			"error": err.Error(),
+		})
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("listing %q (%s): %w", name, region, err)
+	}
+
+	err = SweepOrchestrator(ctx, sweepResources)
+	if err != nil {
+		return fmt.Errorf("sweeping %q (%s): %w", name, region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	testing "github.com/mitchellh/go-testing-interface"
+	"golang.org/x/sync/semaphore"
+)
+
+// Semaphore is a weighted semaphore which can be used to limit concurrent executions.
+// Waiters are served in first-in, first-out order so that a large request is not starved by smaller ones.
+type Semaphore struct {
+	weighted *semaphore.Weighted
+	size     int64
+	held     atomic.Int64
+}
+
+// NewSemaphore returns a new weighted semaphore with the specified maximum combined weight.
+func NewSemaphore(n int64) *Semaphore {
+	return &Semaphore{
+		weighted: semaphore.NewWeighted(n),
+		size:     n,
+	}
+}
+
+// Size returns the maximum combined weight of the semaphore.
+func (s *Semaphore) Size() int64 {
+	return s.size
+}
+
+// Acquire acquires the semaphore with a weight of n, blocking until resources are available or ctx is done.
+// On failure, Acquire returns ctx.Err() and leaves the semaphore unchanged.
+func (s *Semaphore) Acquire(ctx context.Context, n int64) error {
+	if n > s.size {
+		return fmt.Errorf("semaphore weight (%d) exceeds size (%d)", n, s.size)
+	}
+
+	if err := s.weighted.Acquire(ctx, n); err != nil {
+		return err
+	}
+	s.held.Add(n)
+
+	return nil
+}
+
+// Release releases the semaphore with a weight of n.
+func (s *Semaphore) Release(n int64) {
+	s.held.Add(-n)
+	s.weighted.Release(n)
+}
+
+// Wait acquires the semaphore with a weight of 1, blocking until it is available.
+func (s *Semaphore) Wait() {
+	if err := s.Acquire(context.Background(), 1); err != nil {
+		log.Printf("[WARN] Waiting for semaphore: %s", err)
+	}
+}
+
+// Notify releases the semaphore with a weight of 1.
+// Unlike Release, Notify does not panic if the semaphore is not held, which can happen if a Wait was never issued.
+func (s *Semaphore) Notify() {
+	for {
+		held := s.held.Load()
+		if held <= 0 {
+			log.Println("[WARN] Notifying semaphore without Wait")
+			return
+		}
+
+		if s.held.CompareAndSwap(held, held-1) {
+			s.weighted.Release(1)
+			return
+		}
+	}
+}
+
+// Semaphores is a set of named, weighted semaphores.
+type Semaphores struct {
+	store map[string]*Semaphore
+}
+
+// NewSemaphores returns a new set of named semaphores with the specified sizes.
+func NewSemaphores(sizes map[string]int64) *Semaphores {
+	store := make(map[string]*Semaphore, len(sizes))
+	for k, v := range sizes {
+		store[k] = NewSemaphore(v)
+	}
+
+	return &Semaphores{
+		store: store,
+	}
+}
+
+// Get returns the named semaphore, or nil if there is no semaphore with that name.
+func (s *Semaphores) Get(name string) *Semaphore {
+	if s == nil {
+		return nil
+	}
+
+	return s.store[name]
+}
+
+var semaphoreKV = &struct {
+	lock  sync.Locker
+	store map[string]*Semaphore
+}{
+	lock:  &sync.Mutex{},
+	store: make(map[string]*Semaphore),
+}
+
+// GetSemaphore returns a named semaphore shared by all callers, with a default size
+// that can be overridden using an environment variable.
+func GetSemaphore(key, envvar string, defaultLimit int) *Semaphore {
+	semaphoreKV.lock.Lock()
+	defer semaphoreKV.lock.Unlock()
+
+	s, ok := semaphoreKV.store[key]
+	if !ok {
+		limit := defaultLimit
+		if v := os.Getenv(envvar); v != "" {
+			if v, err := strconv.Atoi(v); err == nil {
+				limit = v
+			}
+		}
+
+		s = NewSemaphore(int64(limit))
+		semaphoreKV.store[key] = s
+	}
+
+	return s
+}
+
+// TestAccPreCheckSyncronize waits for a semaphore and skips the test if the semaphore has no capacity.
+func TestAccPreCheckSyncronize(t testing.T, semaphore *Semaphore, resource string) {
+	if semaphore.Size() == 0 {
+		t.Skipf("concurrency for %s testing set to 0", resource)
+	}
+
+	semaphore.Wait()
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSemaphoreLimitsConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := NewSemaphore(2)
+
+	var current, max int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := s.Acquire(ctx, 1); err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			defer s.Release(1)
+
+			n := atomic.AddInt32(&current, 1)
+			for {
+				m := atomic.LoadInt32(&max)
+				if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&current, -1)
+		}()
+	}
+	wg.Wait()
+
+	if max > 2 {
+		t.Errorf("maximum concurrency %d, want at most 2", max)
+	}
+}
+
+func TestSemaphoreWeighted(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := NewSemaphore(3)
+
+	if err := s.Acquire(ctx, 2); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+
+	if err := s.Acquire(ctxTimeout, 2); err == nil {
+		t.Fatal("expected error acquiring beyond capacity")
+	}
+
+	if err := s.Acquire(ctx, 1); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	s.Release(3)
+
+	if err := s.Acquire(ctx, 3); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := s.Acquire(ctx, 4); err == nil {
+		t.Fatal("expected error acquiring more than size")
+	}
+}
+
+func TestSemaphores(t *testing.T) {
+	t.Parallel()
+
+	s := NewSemaphores(map[string]int64{"route53": 2})
+
+	if got := s.Get("route53"); got == nil || got.Size() != 2 {
+		t.Errorf("Get(route53) = %v, want size 2", got)
+	}
+
+	if got := s.Get("lambda"); got != nil {
+		t.Errorf("Get(lambda) = %v, want nil", got)
+	}
+
+	var nilSemaphores *Semaphores
+	if got := nilSemaphores.Get("route53"); got != nil {
+		t.Errorf("nil Get(route53) = %v, want nil", got)
+	}
+}
+
+func TestSemaphoreNotifyWithoutWait(t *testing.T) {
+	t.Parallel()
+
+	s := NewSemaphore(1)
+
+	s.Notify()
+	s.Wait()
+	s.Notify()
+	s.Notify()
+
+	if err := s.Acquire(context.Background(), 1); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestGetSemaphore(t *testing.T) {
+	t.Setenv("TF_TEST_SEMAPHORE_LIMIT", "3")
+
+	s := GetSemaphore("test", "TF_TEST_SEMAPHORE_LIMIT", 1)
+	if got, want := s.Size(), int64(3); got != want {
+		t.Errorf("Size() = %d, want %d", got, want)
+	}
+
+	if GetSemaphore("test", "TF_TEST_SEMAPHORE_LIMIT", 1) != s {
+		t.Error("expected the same semaphore for the same key")
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// KeyNormalizationConfig contains options for canonicalizing resource tag keys.
+// Create one with NewKeyNormalizationConfig.
+type KeyNormalizationConfig struct {
+	ignoreCase bool
+	// canonicalKeys maps each known spelling of a tag key, lower-cased if case is ignored, to its canonical key.
+	canonicalKeys map[string]string
+}
+
+// NewKeyNormalizationConfig returns a KeyNormalizationConfig for the specified
+// aliases, a map of canonical tag key to alternative spellings of that key.
+// If ignoreCase is true, tag keys that differ from a canonical key or alias only in case are also canonicalized.
+func NewKeyNormalizationConfig(aliases map[string][]string, ignoreCase bool) (*KeyNormalizationConfig, error) {
+	nc := &KeyNormalizationConfig{
+		ignoreCase:    ignoreCase,
+		canonicalKeys: make(map[string]string),
+	}
+
+	keys := make([]string, 0, len(aliases))
+	for k := range aliases {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := nc.add(key, key); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, key := range keys {
+		for _, alias := range aliases[key] {
+			if err := nc.add(alias, key); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return nc, nil
+}
+
+func (nc *KeyNormalizationConfig) add(key, canonicalKey string) error {
+	lookupKey := nc.lookupKey(key)
+
+	if v, ok := nc.canonicalKeys[lookupKey]; ok && v != canonicalKey {
+		return fmt.Errorf("tag key %q is an alias of both %q and %q", key, v, canonicalKey)
+	}
+
+	nc.canonicalKeys[lookupKey] = canonicalKey
+
+	return nil
+}
+
+// AddCanonicalKeys registers the specified tag keys as canonical keys, unless they are already known.
+// When case is ignored this makes tag keys that differ from them only in case canonicalize to them.
+func (nc *KeyNormalizationConfig) AddCanonicalKeys(keys ...string) {
+	if nc == nil {
+		return
+	}
+
+	for _, key := range keys {
+		if _, ok := nc.canonicalKeys[nc.lookupKey(key)]; !ok {
+			nc.canonicalKeys[nc.lookupKey(key)] = key
+		}
+	}
+}
+
+// CanonicalKey returns the canonical spelling of the specified tag key.
+// Keys without a known canonical spelling are returned unchanged.
+func (nc *KeyNormalizationConfig) CanonicalKey(key string) string {
+	if nc == nil {
+		return key
+	}
+
+	if v, ok := nc.canonicalKeys[nc.lookupKey(key)]; ok {
+		return v
+	}
+
+	return key
+}
+
+func (nc *KeyNormalizationConfig) lookupKey(key string) string {
+	if nc.ignoreCase {
+		return strings.ToLower(key)
+	}
+
+	return key
+}
+
+// NormalizeKeys returns tags with all keys replaced by their canonical spelling.
+// Several keys may have the same canonical spelling only if their tags have the same value;
+// otherwise an error is returned.
+func (tags KeyValueTags) NormalizeKeys(config *KeyNormalizationConfig) (KeyValueTags, error) {
+	if config == nil {
+		return tags, nil
+	}
+
+	keys := tags.Keys()
+	sort.Strings(keys)
+
+	result := make(KeyValueTags, len(tags))
+	spellings := make(map[string]string, len(tags))
+
+	for _, k := range keys {
+		canonicalKey := config.CanonicalKey(k)
+
+		if other, ok := spellings[canonicalKey]; ok {
+			if tags[k].ValueString() != tags[other].ValueString() {
+				return nil, fmt.Errorf("tag keys %q and %q are both spellings of %q but have different values", other, k, canonicalKey)
+			}
+
+			continue
+		}
+
+		spellings[canonicalKey] = k
+		result[canonicalKey] = tags[k]
+	}
+
+	return result, nil
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNewKeyNormalizationConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		aliases    map[string][]string
+		ignoreCase bool
+		wantErr    bool
+	}{
+		{
+			name:    "empty",
+			aliases: map[string][]string{},
+		},
+		{
+			name: "valid",
+			aliases: map[string][]string{
+				"CostCenter": {"cost-center", "costcenter"},
+				"Owner":      {"owner"},
+			},
+		},
+		{
+			name: "alias of two keys",
+			aliases: map[string][]string{
+				"CostCenter": {"cc"},
+				"CostCode":   {"cc"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "alias differs only in case",
+			aliases: map[string][]string{
+				"CostCenter": {"costcenter"},
+				"Owner":      {"COSTCENTER"},
+			},
+			ignoreCase: true,
+			wantErr:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewKeyNormalizationConfig(testCase.aliases, testCase.ignoreCase)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("error = %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsNormalizeKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	aliases := map[string][]string{
+		"CostCenter": {"cost-center", "costcenter"},
+	}
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		ignoreCase    bool
+		canonicalKeys []string
+		want          map[string]string
+		wantErr       bool
+	}{
+		{
+			name: "no aliases used",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "alias",
+			tags: New(ctx, map[string]string{
+				"cost-center": "value1",
+				"key1":        "value1",
+			}),
+			want: map[string]string{
+				"CostCenter": "value1",
+				"key1":       "value1",
+			},
+		},
+		{
+			name: "case sensitive",
+			tags: New(ctx, map[string]string{
+				"COSTCENTER": "value1",
+			}),
+			want: map[string]string{
+				"COSTCENTER": "value1",
+			},
+		},
+		{
+			name: "case insensitive",
+			tags: New(ctx, map[string]string{
+				"Cost-Center": "value1",
+			}),
+			ignoreCase: true,
+			want: map[string]string{
+				"CostCenter": "value1",
+			},
+		},
+		{
+			name: "case insensitive canonical keys",
+			tags: New(ctx, map[string]string{
+				"owner": "value1",
+			}),
+			ignoreCase:    true,
+			canonicalKeys: []string{"Owner"},
+			want: map[string]string{
+				"Owner": "value1",
+			},
+		},
+		{
+			name: "aliases with same value",
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "value1",
+				"cost-center": "value1",
+				"costcenter":  "value1",
+			}),
+			want: map[string]string{
+				"CostCenter": "value1",
+			},
+		},
+		{
+			name: "aliases with different values",
+			tags: New(ctx, map[string]string{
+				"cost-center": "value2",
+				"costcenter":  "value3",
+			}),
+			wantErr: true,
+		},
+		{
+			name: "case insensitive keys with different values",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "value1",
+				"costCenter": "value2",
+			}),
+			ignoreCase: true,
+			wantErr:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := testKeyNormalizationConfig(t, aliases, testCase.ignoreCase)
+			config.AddCanonicalKeys(testCase.canonicalKeys...)
+
+			got, err := testCase.tags.NormalizeKeys(config)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("error = %v, want error %t", err, want)
+			}
+
+			if err == nil {
+				testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+			}
+		})
+	}
+}
+
+func TestRestoreKeySpellings(t *testing.T) {
+	t.Parallel()
+
+	config := testKeyNormalizationConfig(t, map[string][]string{
+		"CostCenter": {"cost-center"},
+	}, true)
+	result := map[string]string{
+		"CostCenter": "value1",
+		"OWNER":      "value2",
+	}
+
+	restoreKeySpellings(result, config, []string{"cost-center", "Owner", "key1"})
+
+	testKeyValueTagsVerifyMap(t, result, map[string]string{
+		"cost-center": "value1",
+		"OWNER":       "value2",
+	})
+}
+
+func testKeyNormalizationConfig(t *testing.T, aliases map[string][]string, ignoreCase bool) *KeyNormalizationConfig {
+	t.Helper()
+
+	config, err := NewKeyNormalizationConfig(aliases, ignoreCase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return config
+}
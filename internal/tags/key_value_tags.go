@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/maps"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -32,7 +33,9 @@ const (
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	// KeyNormalization canonicalizes resource tag keys before they are merged with Tags.
+	KeyNormalization *KeyNormalizationConfig
+	Tags             KeyValueTags
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -56,11 +59,32 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// NormalizeTagKeys returns the result of NormalizeKeys() on the given
+// KeyValueTags with the DefaultConfig's KeyNormalization.
+func (dc *DefaultConfig) NormalizeTagKeys(tags KeyValueTags) (KeyValueTags, error) {
+	if dc == nil {
+		return tags, nil
+	}
+
+	return tags.NormalizeKeys(dc.KeyNormalization)
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
+// The keys of the KeyValueTags provided are canonicalized first, unless
+// several of them have the same canonical spelling and different values.
+// Such conflicts are reported when planning, see NormalizeTagKeys().
 func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
-	if dc == nil || dc.Tags == nil {
+	if dc == nil {
+		return tags
+	}
+
+	if v, err := tags.NormalizeKeys(dc.KeyNormalization); err == nil {
+		tags = v
+	}
+
+	if dc.Tags == nil {
 		return tags
 	}
 
@@ -767,10 +791,14 @@ func (tags KeyValueTags) ResolveDuplicates(ctx context.Context, defaultConfig *D
 		}
 	}
 
+	if defaultConfig != nil {
+		restoreKeySpellings(result, defaultConfig.KeyNormalization, maps.Keys(configTags))
+	}
+
 	for k, v := range configTags {
 		if _, ok := result[k]; !ok {
 			if defaultConfig != nil {
-				if val, ok := defaultConfig.Tags[k]; ok && val.ValueString() == v.value {
+				if val, ok := defaultConfig.Tags[defaultConfig.KeyNormalization.CanonicalKey(k)]; ok && val.ValueString() == v.value {
 					// config does not exist during a refresh.
 					// set duplicate values from other sources for refresh diff calculation
 					if !configExists {
@@ -805,6 +833,10 @@ func (tags KeyValueTags) ResolveDuplicatesFramework(ctx context.Context, default
 		result[k] = v.ValueString()
 	}
 
+	if defaultConfig != nil {
+		restoreKeySpellings(result, defaultConfig.KeyNormalization, maps.Keys(tagsAll.Elements()))
+	}
+
 	for k, v := range tagsAll.Elements() {
 		if _, ok := result[k]; !ok {
 			if defaultConfig != nil {
@@ -817,7 +849,7 @@ func (tags KeyValueTags) ResolveDuplicatesFramework(ctx context.Context, default
 					)
 				}
 
-				if val, ok := defaultConfig.Tags[k]; ok && val.ValueString() == s {
+				if val, ok := defaultConfig.Tags[defaultConfig.KeyNormalization.CanonicalKey(k)]; ok && val.ValueString() == s {
 					result[k] = s
 				}
 			}
@@ -827,6 +859,33 @@ func (tags KeyValueTags) ResolveDuplicatesFramework(ctx context.Context, default
 	return New(ctx, result).IgnoreConfig(ignoreConfig)
 }
 
+// restoreKeySpellings renames tags in result whose key is the canonical spelling
+// of one of the specified keys to that key, so that tags read from AWS keep the
+// spelling used in configuration.
+func restoreKeySpellings(result map[string]string, config *KeyNormalizationConfig, keys []string) {
+	if config == nil {
+		return
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		canonicalKey := config.CanonicalKey(k)
+		if canonicalKey == k {
+			continue
+		}
+
+		if _, ok := result[k]; ok {
+			continue
+		}
+
+		if v, ok := result[canonicalKey]; ok {
+			result[k] = v
+			delete(result, canonicalKey)
+		}
+	}
+}
+
 // ToSnakeCase converts a string to snake case.
 //
 // For example, AWS Go SDK field names are in PascalCase,
@@ -190,6 +190,45 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 				"key6": "value6",
 			},
 		},
+		{
+			name: "keys normalized",
+			tags: New(ctx, map[string]string{
+				"cost-center": "value2",
+				"key3":        "value3",
+			}),
+			defaultConfig: &DefaultConfig{
+				KeyNormalization: testKeyNormalizationConfig(t, map[string][]string{
+					"CostCenter": {"cost-center"},
+				}, false),
+				Tags: New(ctx, map[string]string{
+					"CostCenter": "value1",
+				}),
+			},
+			want: map[string]string{
+				"CostCenter": "value2",
+				"key3":       "value3",
+			},
+		},
+		{
+			name: "conflicting keys not normalized",
+			tags: New(ctx, map[string]string{
+				"cost-center": "value2",
+				"costcenter":  "value3",
+			}),
+			defaultConfig: &DefaultConfig{
+				KeyNormalization: testKeyNormalizationConfig(t, map[string][]string{
+					"CostCenter": {"cost-center", "costcenter"},
+				}, false),
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"cost-center": "value2",
+				"costcenter":  "value3",
+				"key1":        "value1",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// PolicyConfig contains the rules that resource tags must satisfy.
+type PolicyConfig struct {
+	RequiredKeys []string
+	Rules        []PolicyRule
+}
+
+// PolicyRule constrains the value of a single tag key.
+// The rule only applies if the tag is present.
+type PolicyRule struct {
+	Key           string
+	AllowedValues []string
+	Pattern       *regexp.Regexp
+}
+
+// Validate returns an error describing every tag policy violation in the
+// specified tags, or nil if the tags satisfy the policy.
+// Tags whose value is not yet known (nil) satisfy required key checks
+// and are not checked against rules.
+func (pc *PolicyConfig) Validate(tags KeyValueTags) error {
+	if pc == nil {
+		return nil
+	}
+
+	var errs []error
+
+	for _, key := range pc.RequiredKeys {
+		if !tags.KeyExists(key) {
+			errs = append(errs, fmt.Errorf("required tag %q is missing", key))
+			continue
+		}
+
+		if v := tags.KeyValue(key); v != nil && *v == "" {
+			errs = append(errs, fmt.Errorf("required tag %q is empty", key))
+		}
+	}
+
+	for _, rule := range pc.Rules {
+		v := tags.KeyValue(rule.Key)
+		if v == nil {
+			continue
+		}
+
+		if len(rule.AllowedValues) > 0 && !slices.Contains(rule.AllowedValues, *v) {
+			errs = append(errs, fmt.Errorf("tag %q value %q is not one of: %s", rule.Key, *v, strings.Join(rule.AllowedValues, ", ")))
+		}
+
+		if rule.Pattern != nil && !rule.Pattern.MatchString(*v) {
+			errs = append(errs, fmt.Errorf("tag %q value %q does not match %q", rule.Key, *v, rule.Pattern.String()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+)
+
+func TestPolicyConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policyConfig := &PolicyConfig{
+		RequiredKeys: []string{"CostCenter", "Owner"},
+		Rules: []PolicyRule{
+			{
+				Key:           "Environment",
+				AllowedValues: []string{"dev", "prod"},
+			},
+			{
+				Key:     "CostCenter",
+				Pattern: regexache.MustCompile(`^[0-9]{4}$`),
+			},
+		},
+	}
+	testCases := []struct {
+		name         string
+		policyConfig *PolicyConfig
+		tags         KeyValueTags
+		wantErr      string
+	}{
+		{
+			name:         "nil config",
+			policyConfig: nil,
+			tags:         New(ctx, map[string]string{}),
+		},
+		{
+			name:         "compliant",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "prod",
+				"Owner":       "team",
+			}),
+		},
+		{
+			name:         "rule for absent key",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "1234",
+				"Owner":      "team",
+			}),
+		},
+		{
+			name:         "missing required key",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "1234",
+			}),
+			wantErr: `required tag "Owner" is missing`,
+		},
+		{
+			name:         "empty required key",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "1234",
+				"Owner":      "",
+			}),
+			wantErr: `required tag "Owner" is empty`,
+		},
+		{
+			name:         "value not allowed",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "staging",
+				"Owner":       "team",
+			}),
+			wantErr: `tag "Environment" value "staging" is not one of: dev, prod`,
+		},
+		{
+			name:         "value does not match pattern",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "cc-1234",
+				"Owner":      "team",
+			}),
+			wantErr: `tag "CostCenter" value "cc-1234" does not match "^[0-9]{4}$"`,
+		},
+		{
+			name:         "multiple violations",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"Environment": "staging",
+			}),
+			wantErr: `required tag "CostCenter" is missing` + "\n" +
+				`required tag "Owner" is missing` + "\n" +
+				`tag "Environment" value "staging" is not one of: dev, prod`,
+		},
+		{
+			name:         "unknown values",
+			policyConfig: policyConfig,
+			tags: KeyValueTags{
+				"CostCenter":  &TagData{},
+				"Environment": nil,
+				"Owner":       &TagData{},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.policyConfig.Validate(testCase.tags)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err == nil {
+				t.Errorf("expected error %q, got none", testCase.wantErr)
+			} else if got := err.Error(); got != testCase.wantErr {
+				t.Errorf("got error %q, want %q", got, testCase.wantErr)
+			}
+		})
+	}
+}
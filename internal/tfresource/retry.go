@@ -15,6 +15,7 @@ import (
 	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/polling"
 )
 
 // Retryable is a function that is used to decide if a function's error is retryable or not.
@@ -288,6 +289,27 @@ func (o Options) Apply(c *retry.StateChangeConf) {
 	}
 }
 
+// WaitForStateContext waits for the specified StateChangeConf's target state.
+// Use it in place of (*retry.StateChangeConf).WaitForStateContext so that the waits between polls
+// are removed when polling delays are disabled.
+func WaitForStateContext(ctx context.Context, c *retry.StateChangeConf) (interface{}, error) {
+	applyPollingDelays(c)
+
+	return c.WaitForStateContext(ctx)
+}
+
+// applyPollingDelays removes the waits between polls from the specified StateChangeConf if polling delays are disabled.
+func applyPollingDelays(c *retry.StateChangeConf) {
+	if !polling.DelaysDisabled() {
+		return
+	}
+
+	c.Delay = 0
+	c.MinTimeout = 0
+	// A zero PollInterval means "use exponential backoff".
+	c.PollInterval = time.Nanosecond
+}
+
 type OptionsFunc func(*Options)
 
 func WithDelay(delay time.Duration) OptionsFunc {
@@ -369,7 +391,7 @@ func Retry(ctx context.Context, timeout time.Duration, f retry.RetryFunc, optFns
 
 	options.Apply(c)
 
-	_, waitErr := c.WaitForStateContext(ctx)
+	_, waitErr := WaitForStateContext(ctx, c)
 
 	// Need to acquire the lock here to be able to avoid race using resultErr as
 	// the return value
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/polling"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -397,6 +398,33 @@ func TestRetryContext_error(t *testing.T) {
 	}
 }
 
+func TestRetryPollingDelaysDisabled(t *testing.T) { //nolint:paralleltest // Modifies package-level state.
+	ctx := acctest.Context(t)
+
+	polling.SetDelaysDisabled(true)
+	t.Cleanup(func() {
+		polling.SetDelaysDisabled(false)
+	})
+
+	var retryCount int32
+	f := func() *retry.RetryError {
+		if atomic.AddInt32(&retryCount, 1) < 10 {
+			return retry.RetryableError(errors.New("retry"))
+		}
+
+		return nil
+	}
+
+	start := time.Now()
+	if err := tfresource.Retry(ctx, 1*time.Minute, f, tfresource.WithDelay(30*time.Second), tfresource.WithPollInterval(30*time.Second)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("elapsed time = %s, want less than 5s", elapsed)
+	}
+}
+
 func TestOptionsApply(t *testing.T) {
 	t.Parallel()
 
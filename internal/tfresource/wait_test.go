@@ -9,7 +9,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/polling"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -70,3 +72,38 @@ func TestWaitUntil(t *testing.T) { //nolint:tparallel
 		})
 	}
 }
+
+func TestWaitForStateContextPollingDelaysDisabled(t *testing.T) { //nolint:paralleltest // Modifies package-level state.
+	ctx := acctest.Context(t)
+
+	polling.SetDelaysDisabled(true)
+	t.Cleanup(func() {
+		polling.SetDelaysDisabled(false)
+	})
+
+	var refreshCount int32
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			if atomic.AddInt32(&refreshCount, 1) < 5 {
+				return struct{}{}, "pending", nil
+			}
+
+			return struct{}{}, "done", nil
+		},
+		Timeout:      1 * time.Minute,
+		Delay:        30 * time.Second,
+		MinTimeout:   10 * time.Second,
+		PollInterval: 30 * time.Second,
+	}
+
+	start := time.Now()
+	if _, err := tfresource.WaitForStateContext(ctx, stateConf); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("elapsed time = %s, want less than 5s", elapsed)
+	}
+}
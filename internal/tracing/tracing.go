@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// instrumentationName is the instrumentation scope name of all spans
+	// emitted by the provider.
+	instrumentationName = "github.com/hashicorp/terraform-provider-aws"
+
+	serviceName = "terraform-provider-aws"
+)
+
+// Span attribute keys.
+const (
+	AttrAWSOperation        = attribute.Key("aws.operation")
+	AttrAWSRegion           = attribute.Key("aws.region")
+	AttrAWSRequestID        = attribute.Key("aws.request_id")
+	AttrAWSRetryCount       = attribute.Key("aws.retry_count")
+	AttrAWSService          = attribute.Key("aws.service")
+	AttrAWSThrottled        = attribute.Key("aws.throttled")
+	AttrAWSThrottleCount    = attribute.Key("aws.throttle_count")
+	AttrTerraformOperation  = attribute.Key("terraform.operation")
+	AttrTerraformDataSource = attribute.Key("terraform.data_source")
+	AttrTerraformResource   = attribute.Key("terraform.resource_type")
+	AttrTerraformService    = attribute.Key("terraform.service_package")
+)
+
+// Config configures the export of traces.
+// Exactly one of Endpoint or JSONFile must be set.
+type Config struct {
+	// Endpoint is an OTLP/HTTP traces endpoint, e.g. http://localhost:4318/v1/traces.
+	Endpoint string
+	// JSONFile is the path of a file to which spans are appended in the JSON format of the
+	// OpenTelemetry Go SDK's stdouttrace exporter. This is not the OTLP JSON encoding.
+	JSONFile string
+}
+
+var (
+	lock     sync.Mutex
+	provider *sdktrace.TracerProvider
+)
+
+// Configure installs a global tracer provider that exports spans as
+// specified. Tracing can be configured only once per provider process;
+// subsequent calls are ignored.
+func Configure(ctx context.Context, c *Config) error {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if provider != nil {
+		return nil
+	}
+
+	exporter, err := newExporter(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	// Spans are exported in batches in the background.
+	// Shutdown exports any remaining spans when the provider process exits.
+	provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", serviceName),
+			attribute.String("service.version", version.ProviderVersion),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+
+	tflog.Info(ctx, "Exporting traces", map[string]any{
+		"tf_aws.tracing.endpoint":  c.Endpoint,
+		"tf_aws.tracing.json_file": c.JSONFile,
+	})
+
+	return nil
+}
+
+func newExporter(ctx context.Context, c *Config) (sdktrace.SpanExporter, error) {
+	switch {
+	case c.Endpoint != "" && c.JSONFile != "":
+		return nil, errors.New("tracing: only one of endpoint or json_file can be specified")
+	case c.Endpoint != "":
+		return otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(c.Endpoint))
+	case c.JSONFile != "":
+		f, err := os.OpenFile(c.JSONFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("opening trace file: %w", err)
+		}
+
+		return stdouttrace.New(stdouttrace.WithWriter(f))
+	default:
+		return nil, errors.New("tracing: one of endpoint or json_file must be specified")
+	}
+}
+
+// Shutdown exports any spans that have not yet been exported and stops tracing.
+// It should be called before the provider process exits.
+func Shutdown(ctx context.Context) error {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if provider == nil {
+		return nil
+	}
+
+	err := provider.Shutdown(ctx)
+	provider = nil
+
+	return err
+}
+
+// Enabled returns whether tracing has been configured.
+func Enabled() bool {
+	lock.Lock()
+	defer lock.Unlock()
+
+	return provider != nil
+}
+
+// Tracer returns the provider's tracer.
+// If tracing has not been configured the returned tracer records nothing.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName, trace.WithInstrumentationVersion(version.ProviderVersion))
+}
+
+// EndSpan ends the specified span, recording any error.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
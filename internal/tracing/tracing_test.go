@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNewExporterFile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+
+	exporter, err := newExporter(ctx, &Config{JSONFile: path})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter))
+	tracer := provider.Tracer("test")
+
+	ctx, parent := tracer.Start(ctx, "aws_test.Create")
+	_, child := tracer.Start(ctx, "EC2.RunInstances", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		AttrAWSService.String("EC2"),
+	))
+	EndSpan(child, errors.New("boom"))
+	EndSpan(parent, nil)
+
+	if err := provider.Shutdown(ctx); err != nil {
+		t.Fatalf("shutting down: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading trace file: %s", err)
+	}
+
+	lines := bytes.Split(bytes.TrimSpace(b), []byte("\n"))
+	if got, want := len(lines), 2; got != want {
+		t.Fatalf("lines = %d, want %d", got, want)
+	}
+
+	type span struct {
+		Name        string
+		SpanContext struct{ SpanID string }
+		Parent      struct{ SpanID string }
+		Status      struct{ Code, Description string }
+	}
+
+	var spans []span
+	for _, line := range lines {
+		var v span
+		if err := json.Unmarshal(line, &v); err != nil {
+			t.Fatalf("unmarshaling %s: %s", line, err)
+		}
+		spans = append(spans, v)
+	}
+
+	if got, want := spans[0].Name, "EC2.RunInstances"; got != want {
+		t.Errorf("name = %q, want %q", got, want)
+	}
+	if got, want := spans[0].Parent.SpanID, parent.SpanContext().SpanID().String(); got != want {
+		t.Errorf("parent span ID = %q, want %q", got, want)
+	}
+	if got, want := spans[0].Status.Code, "Error"; got != want || spans[0].Status.Description != "boom" {
+		t.Errorf("status = %+v, want error", spans[0].Status)
+	}
+	if got, want := spans[1].Name, "aws_test.Create"; got != want {
+		t.Errorf("name = %q, want %q", got, want)
+	}
+}
+
+func TestNewExporterEndpoint(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var (
+		mu    sync.Mutex
+		names []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/v1/traces"; got != want {
+			t.Errorf("path = %q, want %q", got, want)
+		}
+
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("reading request: %s", err)
+		}
+
+		var request coltracepb.ExportTraceServiceRequest
+		if err := proto.Unmarshal(b, &request); err != nil {
+			t.Errorf("decoding request: %s", err)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		for _, v := range request.GetResourceSpans() {
+			for _, v := range v.GetScopeSpans() {
+				for _, v := range v.GetSpans() {
+					names = append(names, v.GetName())
+				}
+			}
+		}
+	}))
+	defer server.Close()
+
+	exporter, err := newExporter(ctx, &Config{Endpoint: server.URL + "/v1/traces"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter))
+
+	_, span := provider.Tracer("test").Start(ctx, "test")
+	EndSpan(span, nil)
+
+	if err := provider.Shutdown(ctx); err != nil {
+		t.Fatalf("shutting down: %s", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if got, want := len(names), 1; got != want {
+		t.Fatalf("spans = %d, want %d", got, want)
+	}
+	if got, want := names[0], "test"; got != want {
+		t.Errorf("name = %q, want %q", got, want)
+	}
+}
+
+func TestNewExporterInvalid(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	for name, c := range map[string]*Config{
+		"none": {},
+		"both": {Endpoint: "http://localhost:4318/v1/traces", JSONFile: "trace.jsonl"},
+	} {
+		if _, err := newExporter(ctx, c); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
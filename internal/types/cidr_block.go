@@ -5,7 +5,10 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"net"
+	"net/netip"
+	"slices"
 )
 
 // ValidateCIDRBlock validates that the specified CIDR block is valid:
@@ -52,3 +55,140 @@ func CanonicalCIDRBlock(cidr string) string {
 
 	return ipnet.String()
 }
+
+// CIDRBlocksOverlap returns whether or not two CIDR blocks overlap.
+// CIDR blocks of different address families never overlap.
+func CIDRBlocksOverlap(cidr1, cidr2 string) (bool, error) {
+	prefix1, err := parseCIDRBlock(cidr1)
+	if err != nil {
+		return false, err
+	}
+	prefix2, err := parseCIDRBlock(cidr2)
+	if err != nil {
+		return false, err
+	}
+
+	return prefix1.Overlaps(prefix2), nil
+}
+
+// AllocateCIDRBlocks carves CIDR blocks with the specified prefix lengths out of
+// the parent CIDR block, avoiding the reserved CIDR blocks.
+// Larger blocks are allocated first, each at the lowest free address aligned to
+// its size, which minimizes fragmentation of the parent block.
+// The allocated CIDR blocks are returned in the order of the requested prefix lengths.
+func AllocateCIDRBlocks(parent string, prefixLengths []int, reserved []string) ([]string, error) {
+	parentPrefix, err := parseCIDRBlock(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	bits := parentPrefix.Addr().BitLen()
+	parentStart, parentEnd := cidrBlockRange(parentPrefix)
+
+	var occupied [][2]*big.Int
+	for _, v := range reserved {
+		prefix, err := parseCIDRBlock(v)
+		if err != nil {
+			return nil, err
+		}
+
+		if prefix.Addr().BitLen() != bits {
+			return nil, fmt.Errorf("reserved CIDR block %q is not in the same address family as %q", v, parent)
+		}
+
+		if !prefix.Overlaps(parentPrefix) {
+			continue
+		}
+
+		start, end := cidrBlockRange(prefix)
+		occupied = append(occupied, [2]*big.Int{start, end})
+	}
+
+	order := make([]int, len(prefixLengths))
+	for i, prefixLength := range prefixLengths {
+		if prefixLength < parentPrefix.Bits() || prefixLength > bits {
+			return nil, fmt.Errorf("prefix length %d must be between %d and %d", prefixLength, parentPrefix.Bits(), bits)
+		}
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return prefixLengths[a] - prefixLengths[b]
+	})
+
+	one := big.NewInt(1)
+	results := make([]string, len(prefixLengths))
+
+	for _, i := range order {
+		prefixLength := prefixLengths[i]
+		size := new(big.Int).Lsh(one, uint(bits-prefixLength))
+		candidate := new(big.Int).Set(parentStart)
+		allocated := false
+
+		for {
+			end := new(big.Int).Add(candidate, size)
+			end.Sub(end, one)
+
+			if end.Cmp(parentEnd) > 0 {
+				break
+			}
+
+			var conflict *big.Int
+			for _, o := range occupied {
+				if o[0].Cmp(end) <= 0 && candidate.Cmp(o[1]) <= 0 {
+					if conflict == nil || o[1].Cmp(conflict) > 0 {
+						conflict = o[1]
+					}
+				}
+			}
+
+			if conflict == nil {
+				occupied = append(occupied, [2]*big.Int{candidate, end})
+				results[i] = netip.PrefixFrom(bigIntToAddr(candidate, bits), prefixLength).String()
+				allocated = true
+				break
+			}
+
+			// Move to the first aligned address after the conflicting block.
+			candidate = new(big.Int).Add(conflict, size)
+			candidate.Div(candidate, size)
+			candidate.Mul(candidate, size)
+		}
+
+		if !allocated {
+			return nil, fmt.Errorf("insufficient free space in %q for a /%d CIDR block", parent, prefixLength)
+		}
+	}
+
+	return results, nil
+}
+
+func parseCIDRBlock(cidr string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not a valid CIDR block: %w", cidr, err)
+	}
+
+	if masked := prefix.Masked(); prefix != masked {
+		return netip.Prefix{}, fmt.Errorf("%q is not a valid CIDR block; did you mean %q?", cidr, masked)
+	}
+
+	return prefix, nil
+}
+
+// cidrBlockRange returns the first and last addresses of a CIDR block as integers.
+func cidrBlockRange(prefix netip.Prefix) (*big.Int, *big.Int) {
+	bits := prefix.Addr().BitLen()
+	start := new(big.Int).SetBytes(prefix.Addr().AsSlice())
+	end := new(big.Int).Lsh(big.NewInt(1), uint(bits-prefix.Bits()))
+	end.Sub(end, big.NewInt(1))
+	end.Add(end, start)
+
+	return start, end
+}
+
+func bigIntToAddr(v *big.Int, bits int) netip.Addr {
+	b := v.FillBytes(make([]byte, bits/8))
+	addr, _ := netip.AddrFromSlice(b)
+
+	return addr
+}
@@ -3,7 +3,10 @@
 
 package types
 
-import "testing"
+import (
+	"slices"
+	"testing"
+)
 
 func TestValidateCIDRBlock(t *testing.T) {
 	t.Parallel()
@@ -72,3 +75,81 @@ func TestCanonicalCIDRBlock(t *testing.T) {
 		}
 	}
 }
+
+func TestCIDRBlocksOverlap(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		cidr1   string
+		cidr2   string
+		overlap bool
+		valid   bool
+	}{
+		{"10.0.0.0/16", "10.0.1.0/24", true, true},
+		{"10.0.1.0/24", "10.0.0.0/16", true, true},
+		{"10.0.0.0/24", "10.0.1.0/24", false, true},
+		{"10.0.0.0/8", "10.0.0.0/8", true, true},
+		{"10.0.0.0/8", "::/0", false, true},
+		{"2001:db8::/32", "2001:db8:1::/48", true, true},
+		{"2001:db8::/48", "2001:db8:1::/48", false, true},
+		{"10.0.0.0/8", "invalid", false, false},
+		{"10.0.0.1/16", "10.0.1.0/24", false, false},
+	} {
+		overlap, err := CIDRBlocksOverlap(ts.cidr1, ts.cidr2)
+		if !ts.valid {
+			if err == nil {
+				t.Fatalf("Input '%s', '%s' should error but didn't!", ts.cidr1, ts.cidr2)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Got unexpected error for '%s', '%s' input: %s", ts.cidr1, ts.cidr2, err)
+		}
+		if overlap != ts.overlap {
+			t.Fatalf("CIDRBlocksOverlap(%q, %q) should be: %t", ts.cidr1, ts.cidr2, ts.overlap)
+		}
+	}
+}
+
+func TestAllocateCIDRBlocks(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		parent        string
+		prefixLengths []int
+		reserved      []string
+		expected      []string
+		valid         bool
+	}{
+		{"10.0.0.0/16", []int{24, 24, 24}, nil, []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24"}, true},
+		{"10.0.0.0/16", []int{26, 24, 25}, nil, []string{"10.0.1.128/26", "10.0.0.0/24", "10.0.1.0/25"}, true},
+		{"10.0.0.0/16", []int{24, 24}, []string{"10.0.0.0/24", "10.0.1.128/25"}, []string{"10.0.2.0/24", "10.0.3.0/24"}, true},
+		{"10.0.0.0/16", []int{23}, []string{"10.0.1.0/24"}, []string{"10.0.2.0/23"}, true},
+		{"10.0.0.0/16", []int{24}, []string{"192.168.0.0/24"}, []string{"10.0.0.0/24"}, true},
+		{"10.0.0.0/16", []int{17, 17}, nil, []string{"10.0.0.0/17", "10.0.128.0/17"}, true},
+		{"10.0.0.0/16", []int{17, 17, 24}, nil, nil, false},
+		{"10.0.0.0/16", []int{24}, []string{"10.0.0.0/8"}, nil, false},
+		{"10.0.0.0/16", []int{8}, nil, nil, false},
+		{"10.0.0.0/16", []int{33}, nil, nil, false},
+		{"10.0.0.0/16", []int{24}, []string{"2001:db8::/32"}, nil, false},
+		{"2001:db8::/56", []int{64, 64}, []string{"2001:db8::/64"}, []string{"2001:db8:0:1::/64", "2001:db8:0:2::/64"}, true},
+		{"2001:db8::/32", []int{64}, []string{"2001:db8::/33"}, []string{"2001:db8:8000::/64"}, true},
+		{"invalid", []int{24}, nil, nil, false},
+		{"10.0.0.1/16", []int{24}, nil, nil, false},
+		{"10.0.0.0/16", []int{24}, []string{"10.0.0.1/24"}, nil, false},
+	} {
+		got, err := AllocateCIDRBlocks(ts.parent, ts.prefixLengths, ts.reserved)
+		if !ts.valid {
+			if err == nil {
+				t.Fatalf("Input '%s', %v, %v should error but didn't!", ts.parent, ts.prefixLengths, ts.reserved)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Got unexpected error for '%s', %v, %v input: %s", ts.parent, ts.prefixLengths, ts.reserved, err)
+		}
+		if !slices.Equal(got, ts.expected) {
+			t.Fatalf("AllocateCIDRBlocks(%q, %v, %v) = %v, should be: %v", ts.parent, ts.prefixLengths, ts.reserved, got, ts.expected)
+		}
+	}
+}
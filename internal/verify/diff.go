@@ -28,6 +28,10 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 
 	resourceTags := tftags.New(ctx, diff.Get("tags").(map[string]interface{}))
 
+	if _, err := defaultTagsConfig.NormalizeTagKeys(resourceTags); err != nil {
+		return err
+	}
+
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
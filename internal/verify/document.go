@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DocumentsEquivalent returns whether two JSON or YAML documents are semantically equivalent.
+// Comments, formatting and mapping key order are ignored, YAML aliases (including `<<` merge keys) are
+// expanded, numbers are compared numerically and local tags such as CloudFormation's `!Ref` are significant.
+// YAML documents with recursive aliases, or that exceed a fixed number of nodes once aliases are expanded, are only
+// equivalent if the strings are identical.
+// A JSON document is equivalent to the same document written as YAML.
+// Documents that aren't a mapping or sequence (or a stream of them), for example Protocol Buffers
+// schemas or plain text, are only equivalent if the strings are identical.
+func DocumentsEquivalent(s1, s2 string) bool {
+	if s1 == s2 {
+		return true
+	}
+
+	if strings.TrimSpace(s1) == "" || strings.TrimSpace(s2) == "" {
+		return strings.TrimSpace(s1) == strings.TrimSpace(s2)
+	}
+
+	v1, err := decodeDocument(s1)
+	if err != nil {
+		return false
+	}
+
+	v2, err := decodeDocument(s2)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(v1, v2)
+}
+
+// documentNumber is the canonical representation of a number, e.g. `3/2`.
+type documentNumber string
+
+// documentTagged is the canonical representation of a value with a local tag, e.g. `!Ref`.
+type documentTagged struct {
+	Tag   string
+	Value any
+}
+
+var errNotDocument = errors.New("not a JSON or YAML mapping or sequence")
+
+// decodeDocument decodes a JSON or YAML document (or stream of YAML documents) into its canonical representation.
+func decodeDocument(s string) (any, error) {
+	if json.Valid([]byte(s)) {
+		decoder := json.NewDecoder(strings.NewReader(s))
+		decoder.UseNumber()
+
+		var v any
+		if err := decoder.Decode(&v); err != nil {
+			return nil, err
+		}
+
+		if !isCollection(v) {
+			return nil, errNotDocument
+		}
+
+		return canonicalJSONValue(v)
+	}
+
+	decoder := yaml.NewDecoder(strings.NewReader(s))
+	var documents []any
+	for {
+		var node yaml.Node
+		if err := decoder.Decode(&node); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		v, err := newYAMLCanonicalizer().value(&node)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isCollection(v) {
+			return nil, errNotDocument
+		}
+
+		documents = append(documents, v)
+	}
+
+	switch len(documents) {
+	case 0:
+		return nil, errNotDocument
+	case 1:
+		return documents[0], nil
+	default:
+		return documents, nil
+	}
+}
+
+func isCollection(v any) bool {
+	switch v := v.(type) {
+	case map[string]any, []any:
+		return true
+	case documentTagged:
+		return isCollection(v.Value)
+	}
+
+	return false
+}
+
+func canonicalJSONValue(v any) (any, error) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			e, err := canonicalJSONValue(e)
+			if err != nil {
+				return nil, err
+			}
+			v[k] = e
+		}
+
+	case []any:
+		for i, e := range v {
+			e, err := canonicalJSONValue(e)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = e
+		}
+
+	case json.Number:
+		return canonicalNumber(v.String())
+	}
+
+	return v, nil
+}
+
+// maxYAMLDocumentNodes limits the number of nodes in a YAML document after expanding aliases,
+// guarding against exponential alias expansion ("billion laughs").
+const maxYAMLDocumentNodes = 1_000_000
+
+// yamlCanonicalizer converts a YAML node tree into its canonical representation, expanding aliases.
+type yamlCanonicalizer struct {
+	anchors map[*yaml.Node]bool // Anchored nodes currently being expanded.
+	nodes   int
+}
+
+func newYAMLCanonicalizer() *yamlCanonicalizer {
+	return &yamlCanonicalizer{
+		anchors: make(map[*yaml.Node]bool),
+	}
+}
+
+// alias returns the node an alias refers to, or an error if the alias refers to one of its own ancestors.
+func (c *yamlCanonicalizer) alias(node *yaml.Node) (*yaml.Node, error) {
+	if c.anchors[node.Alias] {
+		return nil, fmt.Errorf("line %d: alias *%s refers to itself", node.Line, node.Value)
+	}
+
+	return node.Alias, nil
+}
+
+func (c *yamlCanonicalizer) value(node *yaml.Node) (any, error) {
+	if c.nodes++; c.nodes > maxYAMLDocumentNodes {
+		return nil, fmt.Errorf("document exceeds %d nodes after expanding aliases", maxYAMLDocumentNodes)
+	}
+
+	if node.Anchor != "" {
+		c.anchors[node] = true
+		defer delete(c.anchors, node)
+	}
+
+	var v any
+
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+
+		return c.value(node.Content[0])
+
+	case yaml.AliasNode:
+		alias, err := c.alias(node)
+		if err != nil {
+			return nil, err
+		}
+
+		return c.value(alias)
+
+	case yaml.MappingNode:
+		m := make(map[string]any, len(node.Content)/2)
+		var merges []*yaml.Node
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+
+			if key.Kind == yaml.ScalarNode && key.ShortTag() == "!!merge" {
+				merges = append(merges, value)
+				continue
+			}
+
+			k, err := c.value(key)
+			if err != nil {
+				return nil, err
+			}
+
+			e, err := c.value(value)
+			if err != nil {
+				return nil, err
+			}
+
+			m[fmt.Sprint(k)] = e
+		}
+
+		// Explicit keys take precedence over merged keys, and earlier merged mappings over later ones.
+		for _, merge := range merges {
+			if merge.Kind == yaml.AliasNode {
+				alias, err := c.alias(merge)
+				if err != nil {
+					return nil, err
+				}
+				merge = alias
+			}
+
+			sources := []*yaml.Node{merge}
+			if merge.Kind == yaml.SequenceNode {
+				sources = merge.Content
+			}
+
+			for _, source := range sources {
+				s, err := c.value(source)
+				if err != nil {
+					return nil, err
+				}
+
+				mm, ok := s.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("line %d: merge value is not a mapping", merge.Line)
+				}
+
+				for k, e := range mm {
+					if _, ok := m[k]; !ok {
+						m[k] = e
+					}
+				}
+			}
+		}
+
+		v = m
+
+	case yaml.SequenceNode:
+		s := make([]any, len(node.Content))
+
+		for i, e := range node.Content {
+			e, err := c.value(e)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = e
+		}
+
+		v = s
+
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!int", "!!float":
+			var f any
+			if err := node.Decode(&f); err != nil {
+				return nil, err
+			}
+
+			n, err := canonicalNumber(f)
+			if err != nil {
+				return nil, err
+			}
+
+			v = n
+
+		case "!!bool", "!!null":
+			if err := node.Decode(&v); err != nil {
+				return nil, err
+			}
+
+		default:
+			v = node.Value
+		}
+	}
+
+	// Local tags, e.g. `!Ref`, are significant. Standard tags (e.g. `!!str`) and the non-specific tag (`!`) are implied by the value.
+	if tag := node.Tag; strings.HasPrefix(tag, "!") && !strings.HasPrefix(tag, "!!") && tag != "!" {
+		return documentTagged{Tag: tag, Value: v}, nil
+	}
+
+	return v, nil
+}
+
+// canonicalNumber returns the canonical representation of a number so that, for example, `1`, `1.0` and `1e0` are equal.
+func canonicalNumber(v any) (any, error) {
+	r := new(big.Rat)
+
+	switch v := v.(type) {
+	case string:
+		if _, ok := r.SetString(v); !ok {
+			return nil, fmt.Errorf("invalid number: %s", v)
+		}
+	case int:
+		r.SetInt64(int64(v))
+	case int64:
+		r.SetInt64(v)
+	case uint64:
+		r.SetUint64(v)
+	case float64:
+		// Infinity and NaN are only comparable as strings.
+		if r.SetFloat64(v) == nil {
+			return fmt.Sprint(v), nil
+		}
+		// Use the shortest decimal representation so that equal YAML and JSON numbers are equal.
+		if _, ok := r.SetString(fmt.Sprint(v)); !ok {
+			r.SetFloat64(v)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported number type: %T", v)
+	}
+
+	return documentNumber(r.RatString()), nil
+}
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"testing"
+)
+
+func TestDocumentsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		description string
+		equivalent  bool
+		s1          string
+		s2          string
+	}{
+		{
+			description: "empty",
+			equivalent:  true,
+			s1:          "",
+			s2:          " \n",
+		},
+		{
+			description: "empty and document",
+			s1:          "",
+			s2:          "{}",
+		},
+		{
+			description: "JSON key order",
+			equivalent:  true,
+			s1:          `{"a":1,"b":{"c":[1,2]}}`,
+			s2:          `{"b":{"c":[1,2]},"a":1}`,
+		},
+		{
+			description: "JSON array order",
+			s1:          `{"a":[1,2]}`,
+			s2:          `{"a":[2,1]}`,
+		},
+		{
+			description: "JSON numbers",
+			equivalent:  true,
+			s1:          `{"a":1,"b":0.5,"c":100}`,
+			s2:          `{"a":1.0,"b":5e-1,"c":1E2}`,
+		},
+		{
+			description: "JSON number and string",
+			s1:          `{"a":1}`,
+			s2:          `{"a":"1"}`,
+		},
+		{
+			description: "JSON and YAML",
+			equivalent:  true,
+			s1:          `{"schemaVersion":"2.2","mainSteps":[{"action":"aws:runShellScript","inputs":{"timeoutSeconds":60}}]}`,
+			s2: `
+schemaVersion: "2.2"
+mainSteps:
+  - action: aws:runShellScript
+    inputs:
+      timeoutSeconds: 60.0
+`,
+		},
+		{
+			description: "YAML comments and key order",
+			equivalent:  true,
+			s1: `
+# Component
+name: HelloWorld
+phases:
+  - name: build # Build phase
+    steps: []
+`,
+			s2: `
+phases:
+- steps: []
+  name: build
+name: HelloWorld
+`,
+		},
+		{
+			description: "YAML quoted number",
+			s1:          `a: 1`,
+			s2:          `a: "1"`,
+		},
+		{
+			description: "YAML hexadecimal and octal numbers",
+			equivalent:  true,
+			s1:          `{a: 0x1F, b: 0o17}`,
+			s2:          `{a: 31, b: 15}`,
+		},
+		{
+			description: "YAML anchors",
+			equivalent:  true,
+			s1: `
+defaults: &defaults
+  timeout: 60
+  retries: 3
+step1: *defaults
+step2:
+  <<: *defaults
+  retries: 5
+`,
+			s2: `
+defaults:
+  timeout: 60
+  retries: 3
+step1:
+  timeout: 60
+  retries: 3
+step2:
+  timeout: 60
+  retries: 5
+`,
+		},
+		{
+			description: "YAML merge sequence",
+			equivalent:  true,
+			s1: `
+a: &a {x: 1}
+b: &b {x: 2, y: 2}
+c:
+  <<: [*a, *b]
+`,
+			s2: `
+a: {x: 1}
+b: {x: 2, y: 2}
+c: {x: 1, y: 2}
+`,
+		},
+		{
+			description: "YAML local tags",
+			equivalent:  true,
+			s1:          "Value: !Ref TestVpc\nName: !Sub '${AWS::StackName}-vpc'\n",
+			s2:          "Name: !Sub \"${AWS::StackName}-vpc\"\nValue: !Ref   TestVpc\n",
+		},
+		{
+			description: "YAML different local tags",
+			s1:          `Value: !Ref TestVpc`,
+			s2:          `Value: !Sub TestVpc`,
+		},
+		{
+			description: "YAML local tag and plain value",
+			s1:          `Value: !Ref TestVpc`,
+			s2:          `Value: TestVpc`,
+		},
+		{
+			description: "YAML document stream",
+			equivalent:  true,
+			s1:          "a: 1\n---\nb: 2\n",
+			s2:          "---\na: 1.0\n---\nb: 2\n",
+		},
+		{
+			description: "invalid YAML",
+			s1:          "a: [1",
+			s2:          "a: [1]",
+		},
+		{
+			description: "plain text",
+			s1:          "syntax = \"proto3\";\nmessage Test {}\n",
+			s2:          "syntax = \"proto3\";\n\nmessage Test {}\n",
+		},
+		{
+			description: "plain text unchanged",
+			equivalent:  true,
+			s1:          "echo hello",
+			s2:          "echo hello",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := DocumentsEquivalent(testCase.s1, testCase.s2), testCase.equivalent; got != want {
+				t.Errorf("DocumentsEquivalent(%q, %q) = %t, want %t", testCase.s1, testCase.s2, got, want)
+			}
+		})
+	}
+}
+
+func TestDecodeDocumentAliases(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		description string
+		s           string
+		wantErr     bool
+	}{
+		{
+			description: "repeated alias",
+			s:           "a: &x [1, 2]\nb: [*x, *x]\n",
+		},
+		{
+			description: "sequence cycle",
+			s:           "a: &x [1, *x]\n",
+			wantErr:     true,
+		},
+		{
+			description: "mapping cycle",
+			s:           "a: &x {b: *x}\n",
+			wantErr:     true,
+		},
+		{
+			description: "merge cycle",
+			s:           "a: &x {b: 1, <<: *x}\n",
+			wantErr:     true,
+		},
+		{
+			description: "exponential expansion",
+			s: `
+a: &a ["lol","lol","lol","lol","lol","lol","lol","lol","lol"]
+b: &b [*a,*a,*a,*a,*a,*a,*a,*a,*a]
+c: &c [*b,*b,*b,*b,*b,*b,*b,*b,*b]
+d: &d [*c,*c,*c,*c,*c,*c,*c,*c,*c]
+e: &e [*d,*d,*d,*d,*d,*d,*d,*d,*d]
+f: &f [*e,*e,*e,*e,*e,*e,*e,*e,*e]
+g: &g [*f,*f,*f,*f,*f,*f,*f,*f,*f]
+h: &h [*g,*g,*g,*g,*g,*g,*g,*g,*g]
+i: &i [*h,*h,*h,*h,*h,*h,*h,*h,*h]
+`,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := decodeDocument(testCase.s)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("decodeDocument(%q) error = %v, want error %t", testCase.s, err, want)
+			}
+		})
+	}
+}
@@ -5,14 +5,18 @@ package verify
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
+	"os"
 	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/YakDriver/regexache"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
@@ -21,7 +25,13 @@ import (
 // SuppressEquivalentPolicyDiffs returns a difference suppression function that compares
 // two JSON strings representing IAM policies and returns `true` if they are semantically equivalent.
 func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
-	return PolicyStringsEquivalent(old, new)
+	equivalent := PolicyStringsEquivalent(old, new)
+
+	if !equivalent {
+		logJSONDiff(k, old, new, true)
+	}
+
+	return equivalent
 }
 
 func PolicyStringsEquivalent(s1, s2 string) bool {
@@ -52,7 +62,13 @@ func PolicyStringsEquivalent(s1, s2 string) bool {
 // SuppressEquivalentJSONDiffs returns a difference suppression function that compares
 // two JSON strings and returns `true` if they are semantically equivalent.
 func SuppressEquivalentJSONDiffs(k, old, new string, d *schema.ResourceData) bool {
-	return JSONStringsEqual(old, new)
+	equal := JSONStringsEqual(old, new)
+
+	if !equal {
+		logJSONDiff(k, old, new, false)
+	}
+
+	return equal
 }
 
 // SuppressEquivalentJSONWithEmptyDiffs returns a difference suppression function that compares
@@ -66,22 +82,11 @@ func SuppressEquivalentJSONWithEmptyDiffs(k, old, new string, d *schema.Resource
 	return JSONStringsEqual(old, new)
 }
 
+// SuppressEquivalentJSONOrYAMLDiffs returns a difference suppression function that compares
+// two JSON or YAML documents and returns `true` if they are semantically equivalent.
+// See DocumentsEquivalent.
 func SuppressEquivalentJSONOrYAMLDiffs(k, old, new string, d *schema.ResourceData) bool {
-	normalizedOld, err := NormalizeJSONOrYAMLString(old)
-
-	if err != nil {
-		log.Printf("[WARN] Unable to normalize Terraform state CloudFormation template body: %s", err)
-		return false
-	}
-
-	normalizedNew, err := NormalizeJSONOrYAMLString(new)
-
-	if err != nil {
-		log.Printf("[WARN] Unable to normalize Terraform configuration CloudFormation template body: %s", err)
-		return false
-	}
-
-	return normalizedOld == normalizedNew
+	return DocumentsEquivalent(old, new)
 }
 
 func NormalizeJSONOrYAMLString(templateString interface{}) (string, error) {
@@ -152,6 +157,74 @@ func SecondJSONUnlessEquivalent(old, new string) (string, error) {
 	return new, nil
 }
 
+// JSONDiffDebugEnvVar is the name of the environment variable that enables logging of
+// the structural differences between JSON documents that are found not to be equivalent.
+const JSONDiffDebugEnvVar = "TF_AWS_JSON_DIFF_DEBUG"
+
+// jsonDiffLoggingContext returns a context with a provider root logger.
+// Diff suppression functions aren't passed a context, so a logger is created that writes to the
+// same destination as the request-scoped loggers created by the Plugin SDK.
+var jsonDiffLoggingContext = sync.OnceValue(func() context.Context {
+	return tfsdklog.NewRootProviderLogger(context.Background(),
+		tfsdklog.WithLevelFromEnv("TF_LOG_PROVIDER"),
+		tfsdklog.WithLogName("json-diff"),
+		tfsdklog.WithoutLocation(),
+		tfsdklog.WithStderrFromInit(),
+	)
+})
+
+// JSONDiff returns the structural differences between two JSON documents.
+// If policy is true the documents are compared as IAM policies: no differences are returned for documents
+// that PolicyStringsEquivalent considers equivalent, and otherwise the differences between the documents'
+// canonical forms are returned, ignoring differences such as element order and single values written as arrays.
+func JSONDiff(old, new string, policy bool) ([]tfjson.Difference, error) {
+	if policy {
+		if PolicyStringsEquivalent(old, new) {
+			return nil, nil
+		}
+
+		canonicalOld, errOld := CanonicalPolicyString(old, "")
+		canonicalNew, errNew := CanonicalPolicyString(new, "")
+
+		// Documents whose canonical forms are identical aren't necessarily equivalent, so fall back to the documents as written.
+		if errOld == nil && errNew == nil {
+			if diffs, err := tfjson.Diff(canonicalOld, canonicalNew); err != nil || len(diffs) > 0 {
+				return diffs, err
+			}
+		}
+	}
+
+	return tfjson.Diff(old, new)
+}
+
+// logJSONDiff logs the structural differences between two JSON documents that are not equivalent
+// if JSON diff debugging is enabled.
+func logJSONDiff(k, old, new string, policy bool) {
+	if os.Getenv(JSONDiffDebugEnvVar) == "" {
+		return
+	}
+
+	ctx := jsonDiffLoggingContext()
+	fields := map[string]any{
+		"key": k,
+	}
+
+	diffs, err := JSONDiff(old, new, policy)
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "JSON documents are not equivalent", fields)
+		return
+	}
+
+	paths := make([]string, len(diffs))
+	for i, diff := range diffs {
+		paths[i] = diff.String()
+	}
+	fields["differences"] = paths
+
+	tflog.Debug(ctx, "JSON documents are not equivalent", fields)
+}
+
 // PolicyToSet returns the existing policy if the new policy is equivalent.
 // Otherwise, it returns the new policy. Either policy is normalized.
 func PolicyToSet(exist, new string) (string, error) {
@@ -554,7 +554,7 @@ Outputs:
 		},
 		{
 			description: `YAML whitespace`,
-			equivalent:  false,
+			equivalent:  true,
 			old: `
 Resources:
   TestVpc:
@@ -799,3 +799,48 @@ func TestLegacyPolicyNormalize(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONDiff(t *testing.T) {
+	t.Parallel()
+
+	old := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"}]}`
+	new := `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject","s3:DeleteObject"],"Resource":["*"]}}`
+
+	diffs, err := JSONDiff(old, new, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(diffs) == 0 {
+		t.Errorf("expected differences between raw documents")
+	}
+
+	// Compared as policies, only the added action differs.
+	diffs, err = JSONDiff(old, new, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := len(diffs), 1; got != want {
+		t.Fatalf("got %d differences (%v), want %d", got, diffs, want)
+	}
+	if got, want := diffs[0].Path, "$.Statement[0].Action[0]"; got != want {
+		t.Errorf("got path %q, want %q", got, want)
+	}
+
+	// Policies that are equivalent have no differences.
+	for _, v := range [][2]string{
+		{old, `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":["*"]}}`},
+		{"", "{}"},
+	} {
+		if !PolicyStringsEquivalent(v[0], v[1]) {
+			t.Fatalf("expected %q and %q to be equivalent", v[0], v[1])
+		}
+
+		diffs, err := JSONDiff(v[0], v[1], true)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(diffs) != 0 {
+			t.Errorf("got differences (%v) between equivalent policies %q and %q", diffs, v[0], v[1])
+		}
+	}
+}
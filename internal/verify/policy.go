@@ -0,0 +1,496 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+var (
+	awsAccountIDRegexp = regexache.MustCompile(`^\d{12}$`)
+
+	// policyDocumentKeyOrder is the order in which well-known top-level
+	// policy document elements are emitted by CanonicalPolicyString.
+	policyDocumentKeyOrder = []string{"Version", "Id", "Statement"}
+
+	// policyStatementKeyOrder is the order in which well-known statement
+	// elements are emitted by CanonicalPolicyString.
+	policyStatementKeyOrder = []string{"Sid", "Effect", "Principal", "NotPrincipal", "Action", "NotAction", "Resource", "NotResource", "Condition"}
+)
+
+// CanonicalPolicyString returns a canonical form of the specified IAM policy
+// document so that two policies that PolicyStringsEquivalent considers
+// equivalent produce byte-for-byte identical output. In the canonical form:
+//   - Version is the first element, followed by Id and Statement
+//   - Statement is always an array, with the statements sorted
+//   - Action, NotAction, Resource, NotResource, principal and condition values
+//     are sorted, and single-element arrays are collapsed to a single value
+//   - Effect is "Allow" or "Deny", whatever its case
+//   - If partition is not empty, AWS account ID principals are represented
+//     as the account's root user ARN in that partition
+//   - Empty Sid, Id and Version elements and empty principal value arrays are
+//     removed
+//   - Boolean and numeric values are represented as strings
+//
+// As with PolicyStringsEquivalent, duplicate statements and values are
+// significant and are kept.
+func CanonicalPolicyString(policy, partition string) (string, error) {
+	doc, err := decodePolicyDocument(policy)
+	if err != nil {
+		return "", err
+	}
+
+	doc, err = canonicalPolicyDocument(doc, partition)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := marshalPolicyJSON(orderedObject(doc, policyDocumentKeyOrder))
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// MergePolicyStrings merges the specified IAM policy documents in order, as
+// with the override_policy_documents argument of the aws_iam_policy_document
+// data source. Statements with a Sid replace any earlier statement with the
+// same Sid, statements without a Sid are appended, the last non-empty Id is
+// adopted and the latest Version is kept. A document that repeats a Sid is
+// an error. Empty documents are ignored.
+func MergePolicyStrings(policies []string) (string, error) {
+	merged := map[string]any{}
+	var statements []map[string]any
+
+	for i, policy := range policies {
+		if v := strings.TrimSpace(policy); v == "" || v == "{}" {
+			continue
+		}
+
+		doc, err := decodePolicyDocument(policy)
+		if err != nil {
+			return "", fmt.Errorf("policy %d: %w", i, err)
+		}
+
+		for k, v := range doc {
+			switch k {
+			case "Id":
+				if v, ok := v.(string); ok && v == "" {
+					continue
+				}
+				merged[k] = v
+			case "Version":
+				if v, ok := v.(string); ok {
+					if existing, ok := merged[k].(string); ok && existing >= v {
+						continue
+					}
+				}
+				merged[k] = v
+			case "Statement":
+				var elements []any
+				switch v := v.(type) {
+				case map[string]any:
+					elements = []any{v}
+				case []any:
+					elements = v
+				default:
+					return "", fmt.Errorf("policy %d: unsupported data type %T for Statement", i, v)
+				}
+
+				sids := make(map[string]struct{}, len(elements))
+				for j, element := range elements {
+					statement, ok := element.(map[string]any)
+					if !ok {
+						return "", fmt.Errorf("policy %d: unsupported data type %T for Statement element", i, element)
+					}
+
+					if sid, ok := statement["Sid"].(string); ok && sid != "" {
+						if _, ok := sids[sid]; ok {
+							return "", fmt.Errorf("policy %d: duplicate Sid (%s) in statement %d", i, sid, j)
+						}
+						sids[sid] = struct{}{}
+					}
+
+					statements = mergePolicyStatement(statements, statement)
+				}
+			default:
+				merged[k] = v
+			}
+		}
+	}
+
+	if len(statements) > 0 {
+		elements := make([]any, 0, len(statements))
+		for _, statement := range statements {
+			elements = append(elements, orderedObject(statement, policyStatementKeyOrder))
+		}
+		merged["Statement"] = elements
+	}
+
+	b, err := marshalPolicyJSON(orderedObject(merged, policyDocumentKeyOrder))
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// mergePolicyStatement adds statement to statements, replacing any existing
+// statement with the same non-empty Sid.
+func mergePolicyStatement(statements []map[string]any, statement map[string]any) []map[string]any {
+	if sid, ok := statement["Sid"].(string); ok && sid != "" {
+		for i, existing := range statements {
+			if v, ok := existing["Sid"].(string); ok && v == sid {
+				statements[i] = statement
+				return statements
+			}
+		}
+	}
+
+	return append(statements, statement)
+}
+
+// decodePolicyDocument decodes a JSON policy document, preserving numbers.
+func decodePolicyDocument(policy string) (map[string]any, error) {
+	if strings.TrimSpace(policy) == "" {
+		return nil, fmt.Errorf("policy is empty")
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(policy))
+	decoder.UseNumber()
+
+	var doc map[string]any
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+	}
+
+	if doc == nil {
+		return nil, fmt.Errorf("policy (%s) is not a JSON object", policy)
+	}
+
+	return doc, nil
+}
+
+func canonicalPolicyDocument(doc map[string]any, partition string) (map[string]any, error) {
+	out := make(map[string]any, len(doc))
+
+	for k, v := range doc {
+		if v, ok := v.(string); ok && v == "" && (k == "Id" || k == "Version") {
+			continue
+		}
+
+		if k != "Statement" {
+			out[k] = v
+			continue
+		}
+
+		var statements []any
+		switch v := v.(type) {
+		case map[string]any:
+			statements = []any{v}
+		case []any:
+			statements = v
+		default:
+			return nil, fmt.Errorf("unsupported data type %T for Statement", v)
+		}
+
+		canonical := make([]any, 0, len(statements))
+		type keyed struct {
+			key       string
+			statement any
+		}
+		var sorted []keyed
+
+		for _, s := range statements {
+			m, ok := s.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("unsupported data type %T for Statement element", s)
+			}
+
+			statement, err := canonicalPolicyStatement(m, partition)
+			if err != nil {
+				return nil, err
+			}
+
+			b, err := marshalPolicyJSON(statement)
+			if err != nil {
+				return nil, err
+			}
+
+			sorted = append(sorted, keyed{key: string(b), statement: orderedObject(statement, policyStatementKeyOrder)})
+		}
+
+		slices.SortFunc(sorted, func(a, b keyed) int {
+			return strings.Compare(a.key, b.key)
+		})
+
+		for _, s := range sorted {
+			canonical = append(canonical, s.statement)
+		}
+
+		out[k] = canonical
+	}
+
+	return out, nil
+}
+
+func canonicalPolicyStatement(statement map[string]any, partition string) (map[string]any, error) {
+	out := make(map[string]any, len(statement))
+
+	for k, v := range statement {
+		switch k {
+		case "Sid":
+			if v, ok := v.(string); ok && v == "" {
+				continue
+			}
+			out[k] = v
+		case "Effect":
+			out[k] = canonicalPolicyEffect(v)
+		case "Action", "NotAction", "Resource", "NotResource":
+			values, err := canonicalPolicyValues(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			out[k] = values
+		case "Principal", "NotPrincipal":
+			principals, err := canonicalPolicyPrincipals(v, partition)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			out[k] = principals
+		case "Condition":
+			conditions, err := canonicalPolicyConditions(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			out[k] = conditions
+		default:
+			out[k] = v
+		}
+	}
+
+	return out, nil
+}
+
+// canonicalPolicyEffect returns "Allow" or "Deny" for an Effect element in any case.
+func canonicalPolicyEffect(v any) any {
+	if v, ok := v.(string); ok {
+		for _, effect := range []string{"Allow", "Deny"} {
+			if strings.EqualFold(v, effect) {
+				return effect
+			}
+		}
+	}
+
+	return v
+}
+
+// canonicalPolicyPrincipals normalizes a Principal or NotPrincipal element.
+// An AWS account ID is equivalent to the account's root user ARN in the
+// specified partition. A "*" principal is not equivalent to {"AWS": "*"}.
+func canonicalPolicyPrincipals(v any, partition string) (any, error) {
+	switch v := v.(type) {
+	case string:
+		if partition != "" {
+			return canonicalPolicyAWSPrincipals(v, partition), nil
+		}
+		return v, nil
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, v := range v {
+			values, err := canonicalPolicyValues(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			if values, ok := values.([]string); ok && len(values) == 0 {
+				continue
+			}
+			if k == "AWS" && partition != "" {
+				values = canonicalPolicyAWSPrincipals(values, partition)
+			}
+			out[k] = values
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %T", v)
+	}
+}
+
+// canonicalPolicyAWSPrincipals replaces AWS account IDs in canonical AWS
+// principal values with the account's root user ARN in the specified partition.
+func canonicalPolicyAWSPrincipals(v any, partition string) any {
+	switch v := v.(type) {
+	case string:
+		if awsAccountIDRegexp.MatchString(v) {
+			return fmt.Sprintf("arn:%s:iam::%s:root", partition, v)
+		}
+		return v
+	case []string:
+		values := make([]string, 0, len(v))
+		for _, v := range v {
+			values = append(values, canonicalPolicyAWSPrincipals(v, partition).(string))
+		}
+		slices.Sort(values)
+		return values
+	default:
+		return v
+	}
+}
+
+func canonicalPolicyConditions(v any) (map[string]any, error) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("unsupported data type %T", v)
+	}
+
+	out := make(map[string]any, len(m))
+	for operator, v := range m {
+		keys, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s: unsupported data type %T", operator, v)
+		}
+
+		values := make(map[string]any, len(keys))
+		for key, v := range keys {
+			canonical, err := canonicalPolicyValues(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s: %w", operator, key, err)
+			}
+			values[key] = canonical
+		}
+		out[operator] = values
+	}
+
+	return out, nil
+}
+
+// canonicalPolicyValues sorts a policy element that may be either a single
+// value or an array of values. Non-string scalars are converted to strings.
+// A single value is returned as a string.
+func canonicalPolicyValues(v any) (any, error) {
+	var values []string
+
+	switch v := v.(type) {
+	case []any:
+		values = make([]string, 0, len(v))
+		for _, v := range v {
+			s, err := policyScalarString(v)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, s)
+		}
+	default:
+		s, err := policyScalarString(v)
+		if err != nil {
+			return nil, err
+		}
+		values = []string{s}
+	}
+
+	slices.Sort(values)
+
+	if len(values) == 1 {
+		return values[0], nil
+	}
+
+	return values, nil
+}
+
+func policyScalarString(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return fmt.Sprintf("%t", v), nil
+	case json.Number:
+		// Format numbers as awspolicyequivalence does, e.g. 1.0 as "1".
+		if f, err := v.Float64(); err == nil {
+			return strconv.FormatFloat(f, 'f', -1, 64), nil
+		}
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("unsupported data type %T", v)
+	}
+}
+
+// orderedJSONObject is a JSON object whose keys are marshaled in a fixed
+// order rather than the lexical order used by encoding/json for maps.
+type orderedJSONObject struct {
+	keys   []string
+	values map[string]any
+}
+
+// orderedObject returns an object that marshals the keys in order first,
+// followed by any remaining keys in lexical order.
+func orderedObject(m map[string]any, order []string) orderedJSONObject {
+	keys := make([]string, 0, len(m))
+	for _, k := range order {
+		if _, ok := m[k]; ok {
+			keys = append(keys, k)
+		}
+	}
+
+	var rest []string
+	for k := range m {
+		if !slices.Contains(order, k) {
+			rest = append(rest, k)
+		}
+	}
+	slices.Sort(rest)
+
+	return orderedJSONObject{
+		keys:   append(keys, rest...),
+		values: m,
+	}
+}
+
+func (o orderedJSONObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+	for i, k := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := marshalPolicyJSON(k)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+
+		value, err := marshalPolicyJSON(o.values[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// marshalPolicyJSON returns the JSON encoding of v without escaping the HTML
+// characters <, > and &, which may appear in policy values.
+func marshalPolicyJSON(v any) ([]byte, error) {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
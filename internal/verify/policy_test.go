@@ -0,0 +1,363 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"testing"
+)
+
+func TestCanonicalPolicyString(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		policy    string
+		partition string
+		expected  string
+		error     bool
+	}{
+		{
+			name:     "basic",
+			policy:   `{"Statement":{"Action":"*","Effect":"Allow","Resource":"*"},"Version":"2012-10-17"}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+		},
+		{
+			name: "single element arrays collapsed",
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": ["s3:GetObject"],
+      "Resource": ["arn:aws:s3:::example/*"],
+      "Principal": {"AWS": ["arn:aws:iam::444455556666:root"]}
+    }
+  ]
+}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::444455556666:root"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+		},
+		{
+			name: "values sorted",
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:PutObject", "s3:GetObject", "s3:PutObject"],
+      "Resource": "*",
+      "Principal": {"AWS": ["arn:aws:iam::444455556666:root", "arn:aws:iam::111122223333:root"]}
+    }
+  ]
+}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::111122223333:root","arn:aws:iam::444455556666:root"]},"Action":["s3:GetObject","s3:PutObject","s3:PutObject"],"Resource":"*"}]}`,
+		},
+		{
+			name: "statements sorted",
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "B", "Effect": "Allow", "Action": "s3:PutObject", "Resource": "*"},
+    {"Sid": "A", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"},
+    {"Sid": "B", "Effect": "Allow", "Action": ["s3:PutObject"], "Resource": "*"}
+  ]
+}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"B","Effect":"Allow","Action":"s3:PutObject","Resource":"*"},{"Sid":"B","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+		},
+		{
+			name: "conditions",
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Deny",
+      "Action": "*",
+      "Resource": "*",
+      "Condition": {
+        "Bool": {"aws:SecureTransport": false},
+        "NumericLessThan": {"s3:TlsVersion": [1.2]},
+        "StringEquals": {"aws:PrincipalTag/team": ["b", "a"]},
+        "Null": {}
+      }
+    }
+  ]
+}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"},"Null":{},"NumericLessThan":{"s3:TlsVersion":"1.2"},"StringEquals":{"aws:PrincipalTag/team":["a","b"]}}}]}`,
+		},
+		{
+			name:     "HTML characters",
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringLike":{"aws:Referer":"https://example.com/?a=<b>&c=d"}}}]}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringLike":{"aws:Referer":"https://example.com/?a=<b>&c=d"}}}]}`,
+		},
+		{
+			name:     "effect case",
+			policy:   `{"Id":"","Version":"2012-10-17","Statement":[{"Effect":"allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			name:     "wildcard principal",
+			policy:   `{"Id":"example","Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"sts:AssumeRole"}]}`,
+			expected: `{"Version":"2012-10-17","Id":"example","Statement":[{"Effect":"Allow","Principal":"*","Action":"sts:AssumeRole"}]}`,
+		},
+		{
+			name:      "account ID principals",
+			policy:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["123456789012","arn:aws:iam::123456789012:root","arn:aws:iam::210987654321:role/example"]},"Action":"sts:AssumeRole"}]}`,
+			partition: "aws",
+			expected:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","arn:aws:iam::123456789012:root","arn:aws:iam::210987654321:role/example"]},"Action":"sts:AssumeRole"}]}`,
+		},
+		{
+			name:      "account ID principals other partition",
+			policy:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["123456789012","arn:aws-cn:iam::123456789012:root"]},"Action":"sts:AssumeRole"}]}`,
+			partition: "aws-cn",
+			expected:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws-cn:iam::123456789012:root","arn:aws-cn:iam::123456789012:root"]},"Action":"sts:AssumeRole"}]}`,
+		},
+		{
+			name:     "account ID principals no partition",
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","123456789012"]},"Action":"sts:AssumeRole"}]}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["123456789012","arn:aws:iam::123456789012:root"]},"Action":"sts:AssumeRole"}]}`,
+		},
+		{
+			name:   "empty",
+			policy: ``,
+			error:  true,
+		},
+		{
+			name:   "invalid JSON",
+			policy: `{"Version":`,
+			error:  true,
+		},
+		{
+			name:   "not an object",
+			policy: `["Version"]`,
+			error:  true,
+		},
+		{
+			name:   "invalid action",
+			policy: `{"Statement":[{"Action":{"s3":"GetObject"}}]}`,
+			error:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := CanonicalPolicyString(testCase.policy, testCase.partition)
+
+			if testCase.error {
+				if err == nil {
+					t.Fatalf("expected error, got %s", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestCanonicalPolicyStringEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		policy1    string
+		policy2    string
+		equivalent bool
+	}{
+		{
+			name: "layout",
+			policy1: `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Principal": {"Service": ["lambda.amazonaws.com"]},
+    "Action": ["sts:AssumeRole"]
+  }
+}`,
+			policy2:    `{"Statement":[{"Action":"sts:AssumeRole","Principal":{"Service":"lambda.amazonaws.com"},"Effect":"Allow","Sid":""}],"Version":"2012-10-17"}`,
+			equivalent: true,
+		},
+		{
+			name:       "wildcard principal",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":"s3:GetObject","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		{
+			name:       "account ID principal",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"sts:AssumeRole"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root"]},"Action":"sts:AssumeRole"}]}`,
+			equivalent: true,
+		},
+		{
+			name:       "effect case",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"*","Resource":"*"}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"deny","Action":"*","Resource":"*"}]}`,
+			equivalent: true,
+		},
+		{
+			name:       "numbers",
+			policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"NumericEquals":{"aws:MultiFactorAuthAge":1.0}}}]}`,
+			policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"NumericEquals":{"aws:MultiFactorAuthAge":"1"}}}]}`,
+			equivalent: true,
+		},
+		{
+			name:    "string and wildcard principal",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			name:    "duplicate statements",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"},{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+		},
+		{
+			name:    "duplicate values",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:GetObject"],"Resource":"*"}]}`,
+		},
+		{
+			name:    "empty condition",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{}}]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got1, err := CanonicalPolicyString(testCase.policy1, "aws")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got2, err := CanonicalPolicyString(testCase.policy2, "aws")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := PolicyStringsEquivalent(testCase.policy1, testCase.policy2), testCase.equivalent; got != want {
+				t.Fatalf("PolicyStringsEquivalent(%s, %s) = %t, want %t", testCase.policy1, testCase.policy2, got, want)
+			}
+
+			if got, want := got1 == got2, testCase.equivalent; got != want {
+				t.Errorf("canonical policies %s and %s equal = %t, want %t", got1, got2, got, want)
+			}
+
+			if !PolicyStringsEquivalent(got1, testCase.policy1) {
+				t.Errorf("expected canonical policy %s to be equivalent to %s", got1, testCase.policy1)
+			}
+
+			if !PolicyStringsEquivalent(got2, testCase.policy2) {
+				t.Errorf("expected canonical policy %s to be equivalent to %s", got2, testCase.policy2)
+			}
+		})
+	}
+}
+
+func TestMergePolicyStrings(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		policies []string
+		expected string
+		error    bool
+	}{
+		{
+			name:     "none",
+			policies: []string{},
+			expected: `{}`,
+		},
+		{
+			name:     "empty ignored",
+			policies: []string{"", "{}", `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`},
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+		},
+		{
+			name: "statements without Sid appended",
+			policies: []string{
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+				`{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}}`,
+			},
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+		},
+		{
+			name: "equal statements without Sid appended",
+			policies: []string{
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":"*"}]}`,
+				`{"Version":"2012-10-17","Statement":{"Sid":"","Resource":["*"],"Action":["s3:ListBucket","s3:GetObject"],"Effect":"Allow"}}`,
+			},
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":"*"},{"Sid":"","Effect":"Allow","Action":["s3:ListBucket","s3:GetObject"],"Resource":["*"]}]}`,
+		},
+		{
+			name: "Sid overridden in place",
+			policies: []string{
+				`{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"B","Effect":"Allow","Action":"s3:ListBucket","Resource":"*"}]}`,
+				`{"Statement":[{"Sid":"C","Effect":"Deny","Action":"s3:DeleteBucket","Resource":"*"},{"Sid":"A","Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+			},
+			expected: `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Deny","Action":"s3:GetObject","Resource":"*"},{"Sid":"B","Effect":"Allow","Action":"s3:ListBucket","Resource":"*"},{"Sid":"C","Effect":"Deny","Action":"s3:DeleteBucket","Resource":"*"}]}`,
+		},
+		{
+			name: "Id adopted and Version upgraded",
+			policies: []string{
+				`{"Version":"2008-10-17","Id":"first","Statement":[]}`,
+				`{"Version":"2012-10-17","Id":"second"}`,
+				`{"Version":"2008-10-17","Id":""}`,
+			},
+			expected: `{"Version":"2012-10-17","Id":"second"}`,
+		},
+		{
+			name: "duplicate Sid",
+			policies: []string{
+				`{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"A","Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+			},
+			error: true,
+		},
+		{
+			name:     "invalid JSON",
+			policies: []string{`{"Version":"2012-10-17"}`, `{"Version":`},
+			error:    true,
+		},
+		{
+			name:     "invalid Statement",
+			policies: []string{`{"Statement":"Allow"}`},
+			error:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := MergePolicyStrings(testCase.policies)
+
+			if testCase.error {
+				if err == nil {
+					t.Fatalf("expected error, got %s", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
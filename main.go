@@ -9,7 +9,9 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 )
 
 func main() {
@@ -38,6 +40,15 @@ func main() {
 		serveOpts...,
 	)
 
+	// Export any spans that have not yet been exported before the provider process exits.
+	if err := tracing.Shutdown(context.Background()); err != nil {
+		log.Printf("[WARN] exporting traces: %s", err)
+	}
+
+	if err := conns.CloseDryRunFiles(); err != nil {
+		log.Printf("[WARN] %s", err)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -16,13 +16,14 @@ var (
 	v1            bool
 	pluginSDKV2   bool
 	includeTags   bool
+	operations    resource.SDKOperations
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return resource.Create(name, snakeName, !clearComments, force, !v1, !pluginSDKV2, includeTags)
+		return resource.Create(name, snakeName, !clearComments, force, !v1, !pluginSDKV2, includeTags, operations)
 	},
 }
 
@@ -35,4 +36,8 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVar(&operations.Create, "create-op", "", "generate from the AWS Go SDK v2 shapes of this create operation (e.g., CreateBroker); requires --read-op and --delete-op")
+	resourceCmd.Flags().StringVar(&operations.Read, "read-op", "", "AWS Go SDK v2 operation that describes the resource (e.g., DescribeBroker)")
+	resourceCmd.Flags().StringVar(&operations.Update, "update-op", "", "AWS Go SDK v2 operation that updates the resource (e.g., UpdateBroker)")
+	resourceCmd.Flags().StringVar(&operations.Delete, "delete-op", "", "AWS Go SDK v2 operation that deletes the resource (e.g., DeleteBroker)")
 }
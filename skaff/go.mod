@@ -6,6 +6,7 @@ require (
 	github.com/YakDriver/regexache v0.23.0
 	github.com/hashicorp/terraform-provider-aws v1.60.1-0.20220322001452-8f7a597d0c24
 	github.com/spf13/cobra v1.8.0
+	golang.org/x/tools v0.18.0
 )
 
 require (
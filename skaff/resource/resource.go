@@ -8,6 +8,10 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -16,6 +20,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/tools/go/ast/astutil"
 )
 
 //go:embed resource.tmpl
@@ -27,6 +32,12 @@ var resourceFrameworkTmpl string
 //go:embed resourcetest.tmpl
 var resourceTestTmpl string
 
+//go:embed resourcesdk.tmpl
+var resourceSDKTmpl string
+
+//go:embed resourcesdktest.tmpl
+var resourceSDKTestTmpl string
+
 //go:embed websitedoc.tmpl
 var websiteTmpl string
 
@@ -45,6 +56,7 @@ type TemplateData struct {
 	PluginFramework      bool
 	HumanResourceName    string
 	ProviderResourceName string
+	SDK                  *SDKModel
 }
 
 func ToSnakeCase(upper string, snakeName string) string {
@@ -71,7 +83,7 @@ func ProviderResourceName(servicePackage, snakeName string) string {
 	return fmt.Sprintf("aws_%s_%s", servicePackage, snakeName)
 }
 
-func Create(resName, snakeName string, comments, force, v2, pluginFramework, tags bool) error {
+func Create(resName, snakeName string, comments, force, v2, pluginFramework, tags bool, operations SDKOperations) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
@@ -125,17 +137,37 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 		ProviderResourceName: ProviderResourceName(servicePackage, snakeName),
 	}
 
-	tmpl := resourceTmpl
+	tmpl, testTmpl := resourceTmpl, resourceTestTmpl
 	if pluginFramework {
 		tmpl = resourceFrameworkTmpl
 	}
+
+	if operations.IsSet() {
+		if !v2 || !pluginFramework {
+			return fmt.Errorf("error checking: generating from AWS API operations requires AWS Go SDK v2 and Terraform Plugin Framework")
+		}
+
+		sdkPackage, err := names.AWSGoV2Package(servicePackage)
+		if err != nil {
+			return fmt.Errorf("error getting AWS Go SDK v2 package name: %w", err)
+		}
+
+		if templateData.SDK, err = LoadSDKModel(wd, sdkPackage, resName, operations); err != nil {
+			return fmt.Errorf("error reading AWS API operations: %w", err)
+		}
+
+		// The AWS API determines whether the resource has tags.
+		templateData.IncludeTags = templateData.SDK.Tags
+		tmpl, testTmpl = resourceSDKTmpl, resourceSDKTestTmpl
+	}
+
 	f := fmt.Sprintf("%s.go", snakeName)
 	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
 		return fmt.Errorf("writing resource template: %w", err)
 	}
 
 	tf := fmt.Sprintf("%s_test.go", snakeName)
-	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
+	if err = writeTemplate("restest", tf, testTmpl, force, templateData); err != nil {
 		return fmt.Errorf("writing resource test template: %w", err)
 	}
 
@@ -169,13 +201,17 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 		return fmt.Errorf("error executing template: %s", err)
 	}
 
-	//contents, err := format.Source(buffer.Bytes())
-	//if err != nil {
-	//	return fmt.Errorf("error formatting generated file: %s", err)
-	//}
+	contents := buffer.Bytes()
+
+	// Code generated from the AWS API is complete enough to be formatted.
+	if td.SDK != nil && filepath.Ext(filename) == ".go" {
+		contents, err = formatSource(contents)
+		if err != nil {
+			return fmt.Errorf("error formatting generated file (%s): %s", filename, err)
+		}
+	}
 
-	//if _, err := f.Write(contents); err != nil {
-	if _, err := f.Write(buffer.Bytes()); err != nil {
+	if _, err := f.Write(contents); err != nil {
 		f.Close() // ignore error; Write error takes precedence
 		return fmt.Errorf("error writing to file (%s): %s", filename, err)
 	}
@@ -186,3 +222,36 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 
 	return nil
 }
+
+// formatSource removes unused imports from Go source and formats it.
+func formatSource(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	var unused []*ast.ImportSpec
+	for _, spec := range file.Imports {
+		if !astutil.UsesImport(file, strings.Trim(spec.Path.Value, `"`)) {
+			unused = append(unused, spec)
+		}
+	}
+
+	for _, spec := range unused {
+		var name string
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+
+		astutil.DeleteNamedImport(fset, file, name, strings.Trim(spec.Path.Value, `"`))
+	}
+
+	var buffer bytes.Buffer
+	if err := format.Node(&buffer, fset, file); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,972 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+// SDKOperations names the AWS SDK for Go v2 operations that implement a resource's lifecycle.
+type SDKOperations struct {
+	Create string
+	Read   string
+	Update string
+	Delete string
+}
+
+// IsSet returns whether any operation has been named.
+func (o SDKOperations) IsSet() bool {
+	return o.Create != "" || o.Read != "" || o.Update != "" || o.Delete != ""
+}
+
+// SDKModel is a resource scaffold derived from the input and output shapes of AWS SDK for Go v2 operations.
+type SDKModel struct {
+	PackageName string
+	PackagePath string
+	Operations  SDKOperations
+
+	// Top-level attributes and blocks, ordered by Terraform name.
+	Attributes []*SDKAttribute
+	Blocks     []*SDKAttribute
+	// Nested object models, ordered by name.
+	NestedModels []*SDKNestedModel
+
+	// The type returned by the finder and the Read operation output field that contains it, if any.
+	ReadOutputType  string
+	ReadOutputField string
+
+	// The attribute that is used as the resource ID and the operation input fields that take the ID.
+	IDAttribute        *SDKAttribute
+	IDInputField       string
+	UpdateIDInputField string
+	DeleteIDInputField string
+	// The path of the resource ID in the Create operation output, e.g. "ThingId" or "Thing.ThingId".
+	CreateOutputIDField string
+
+	ClientTokenField  string
+	Tags              bool
+	TagsIdentifier    string
+	NotFoundException string
+	Status            *SDKStatus
+
+	// Attributes that can be changed by the Update operation.
+	UpdatableAttributes []*SDKAttribute
+
+	// Fields that could not be mapped to Terraform attributes.
+	Unsupported []string
+}
+
+// HasWaiters returns whether any status waiters are generated.
+func (m *SDKModel) HasWaiters() bool {
+	return m.Status != nil && (m.Status.CreateWaiter() || (m.HasUpdate() && m.Status.UpdateWaiter()) || m.Status.DeleteWaiter())
+}
+
+// HasUpdate returns whether an Update method is generated.
+func (m *SDKModel) HasUpdate() bool {
+	return m.Operations.Update != "" && len(m.UpdatableAttributes) > 0
+}
+
+// SDKAttribute is a Terraform attribute or block mapped from an AWS API structure field.
+type SDKAttribute struct {
+	FieldName string // AWS API field name, e.g. "KeyArn".
+	GoName    string // Model struct field name, e.g. "KeyARN".
+	TFName    string // Terraform attribute name, e.g. "key_arn".
+
+	ModelType   string // e.g. "types.String".
+	SchemaType  string // e.g. "String" for schema.StringAttribute.
+	CustomType  string
+	ElementType string
+
+	Required           bool
+	Optional           bool
+	Computed           bool
+	ComputedOnlyARN    bool
+	RequiresReplace    bool
+	UseStateForUnknown bool
+
+	// Nested object attributes and blocks.
+	Nested      *SDKNestedModel
+	MaxItemsOne bool
+}
+
+// PlanModifierPackage returns the name of the package containing the attribute's plan modifiers.
+func (a *SDKAttribute) PlanModifierPackage() string {
+	return strings.ToLower(a.SchemaType) + "planmodifier"
+}
+
+// HasPlanModifiers returns whether the attribute has any plan modifiers.
+func (a *SDKAttribute) HasPlanModifiers() bool {
+	return a.RequiresReplace || a.UseStateForUnknown
+}
+
+// SDKNestedModel is a nested object model mapped from an AWS API structure.
+type SDKNestedModel struct {
+	Name       string
+	Attributes []*SDKAttribute
+	Blocks     []*SDKAttribute
+	Computed   bool
+}
+
+// SDKStatus describes the status field of a resource and the values used by its waiters.
+type SDKStatus struct {
+	Field         string
+	CreatePending []string
+	CreateTarget  []string
+	UpdatePending []string
+	UpdateTarget  []string
+	DeletePending []string
+}
+
+func (s *SDKStatus) CreateWaiter() bool {
+	return len(s.CreatePending) > 0 && len(s.CreateTarget) > 0
+}
+
+func (s *SDKStatus) UpdateWaiter() bool {
+	return len(s.UpdatePending) > 0 && len(s.UpdateTarget) > 0
+}
+
+func (s *SDKStatus) DeleteWaiter() bool {
+	return len(s.DeletePending) > 0
+}
+
+// requiredFunc reports whether a field of an AWS API structure is required.
+type requiredFunc func(typeName *types.TypeName, fieldName string) bool
+
+// LoadSDKModel loads an AWS SDK for Go v2 service package from the module containing dir and
+// derives a resource scaffold from the named operations.
+func LoadSDKModel(dir, sdkPackage, resName string, operations SDKOperations) (*SDKModel, error) {
+	servicePath := "github.com/aws/aws-sdk-go-v2/service/" + sdkPackage
+	typesPath := servicePath + "/types"
+
+	cmd := exec.Command("go", "list", "-f", "{{.Dir}}", servicePath, typesPath)
+	cmd.Dir = dir
+	cmd.Stderr = os.Stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("locating AWS SDK for Go v2 package (%s): %w", servicePath, err)
+	}
+
+	dirs := strings.Fields(string(out))
+	if len(dirs) != 2 {
+		return nil, fmt.Errorf("AWS SDK for Go v2 package (%s) not found", servicePath)
+	}
+
+	fset := token.NewFileSet()
+	required := make(map[string]bool)
+
+	typesFiles, err := parsePackage(fset, dirs[1])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Go package (%s): %w", typesPath, err)
+	}
+
+	serviceFiles, err := parsePackage(fset, dirs[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Go package (%s): %w", servicePath, err)
+	}
+
+	awsTypes := checkPackage(fset, typesPath, typesFiles, nil, required)
+	service := checkPackage(fset, servicePath, serviceFiles, awsTypes, required)
+
+	return newSDKModel(service, awsTypes, func(typeName *types.TypeName, fieldName string) bool {
+		return required[typeName.Pkg().Path()+"."+typeName.Name()+"."+fieldName]
+	}, resName, operations)
+}
+
+func parsePackage(fset *token.FileSet, dir string) ([]*ast.File, error) {
+	pkgs, err := parser.ParseDir(fset, dir, func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*ast.File
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			files = append(files, file)
+		}
+	}
+
+	return files, nil
+}
+
+// checkPackage type checks a Go package and records its required structure fields.
+// Only the standard library and the AWS types package are imported, so references to other
+// packages have invalid types. Those fields are reported as unsupported.
+func checkPackage(fset *token.FileSet, path string, files []*ast.File, awsTypes *types.Package, required map[string]bool) *types.Package {
+	for k := range requiredFields(files) {
+		required[path+"."+k] = true
+	}
+
+	config := &types.Config{
+		Importer: &sdkImporter{
+			awsTypes: awsTypes,
+			source:   importer.ForCompiler(fset, "source", nil),
+		},
+		Error: func(error) {},
+	}
+
+	pkg, _ := config.Check(path, fset, files, nil)
+
+	return pkg
+}
+
+type sdkImporter struct {
+	awsTypes *types.Package
+	source   types.Importer
+}
+
+func (i *sdkImporter) Import(path string) (*types.Package, error) {
+	if i.awsTypes != nil && path == i.awsTypes.Path() {
+		return i.awsTypes, nil
+	}
+
+	if path == "time" {
+		return i.source.Import(path)
+	}
+
+	pkg := types.NewPackage(path, filepath.Base(path))
+	pkg.MarkComplete()
+
+	return pkg, nil
+}
+
+// requiredFields returns the "Type.Field" names of structure fields documented as required.
+func requiredFields(files []*ast.File) map[string]bool {
+	required := make(map[string]bool)
+
+	for _, file := range files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			spec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			st, ok := spec.Type.(*ast.StructType)
+			if !ok {
+				return false
+			}
+
+			for _, field := range st.Fields.List {
+				if field.Doc == nil || !strings.Contains(field.Doc.Text(), "This member is required.") {
+					continue
+				}
+
+				for _, name := range field.Names {
+					required[spec.Name.Name+"."+name.Name] = true
+				}
+			}
+
+			return false
+		})
+	}
+
+	return required
+}
+
+// skippedFields are operation fields that are never mapped to Terraform attributes.
+var skippedFields = []string{
+	"ClientRequestToken",
+	"ClientToken",
+	"DryRun",
+	"MaxResults",
+	"NextToken",
+	"ResultMetadata",
+}
+
+// notFoundExceptions are the preferred names of the error returned when a resource does not exist.
+var notFoundExceptions = []string{
+	"ResourceNotFoundException",
+	"NotFoundException",
+}
+
+type sdkModelBuilder struct {
+	service  *types.Package
+	awsTypes *types.Package
+	required requiredFunc
+	model    *SDKModel
+	nested   map[string]*SDKNestedModel
+}
+
+func newSDKModel(service, awsTypes *types.Package, required requiredFunc, resName string, operations SDKOperations) (*SDKModel, error) {
+	if operations.Create == "" || operations.Read == "" || operations.Delete == "" {
+		return nil, fmt.Errorf("create, read and delete operations are required")
+	}
+
+	b := &sdkModelBuilder{
+		service:  service,
+		awsTypes: awsTypes,
+		required: required,
+		model: &SDKModel{
+			PackageName: service.Name(),
+			PackagePath: service.Path(),
+			Operations:  operations,
+		},
+		nested: make(map[string]*SDKNestedModel),
+	}
+
+	if err := b.build(resName); err != nil {
+		return nil, err
+	}
+
+	return b.model, nil
+}
+
+func (b *sdkModelBuilder) build(resName string) error {
+	m := b.model
+	ops := m.Operations
+
+	createInput, err := b.operationStruct(ops.Create + "Input")
+	if err != nil {
+		return err
+	}
+	createOutput, err := b.operationStruct(ops.Create + "Output")
+	if err != nil {
+		return err
+	}
+	readInput, err := b.operationStruct(ops.Read + "Input")
+	if err != nil {
+		return err
+	}
+	readOutput, err := b.operationStruct(ops.Read + "Output")
+	if err != nil {
+		return err
+	}
+	deleteInput, err := b.operationStruct(ops.Delete + "Input")
+	if err != nil {
+		return err
+	}
+	var updateInput *types.Named
+	if ops.Update != "" {
+		if updateInput, err = b.operationStruct(ops.Update + "Input"); err != nil {
+			return err
+		}
+	}
+
+	// The resource's state is the single structure returned by the Read operation, if there is one.
+	state := readOutput
+	m.ReadOutputType = m.PackageName + "." + readOutput.Obj().Name()
+	if fields := structFields(readOutput); len(fields) == 1 {
+		if named, ok := b.awsTypesStruct(fields[0].Type()); ok {
+			state = named
+			m.ReadOutputType = "awstypes." + named.Obj().Name()
+			m.ReadOutputField = fields[0].Name()
+		}
+	}
+
+	// The resource ID is the first required string field of the Read operation input.
+	for _, field := range structFields(readInput) {
+		if !isString(field.Type()) {
+			continue
+		}
+		if m.IDInputField == "" || b.required(readInput.Obj(), field.Name()) {
+			m.IDInputField = field.Name()
+		}
+		if b.required(readInput.Obj(), field.Name()) {
+			break
+		}
+	}
+	if m.IDInputField == "" {
+		return fmt.Errorf("%s input has no string field to use as the resource ID", ops.Read)
+	}
+	if fieldByName(deleteInput, m.IDInputField) != nil {
+		m.DeleteIDInputField = m.IDInputField
+	}
+	if updateInput != nil && fieldByName(updateInput, m.IDInputField) != nil {
+		m.UpdateIDInputField = m.IDInputField
+	}
+
+	for _, name := range []string{"ClientToken", "ClientRequestToken"} {
+		if v, ok := createInput.Underlying().(*types.Struct); ok {
+			for i := 0; i < v.NumFields(); i++ {
+				if v.Field(i).Name() == name {
+					m.ClientTokenField = name
+				}
+			}
+		}
+	}
+
+	// Attributes are the union of the Create operation input and the resource's state.
+	var fieldNames []string
+	for _, st := range []*types.Named{createInput, state} {
+		for _, field := range structFields(st) {
+			if !slices.Contains(fieldNames, field.Name()) {
+				fieldNames = append(fieldNames, field.Name())
+			}
+		}
+	}
+
+	for _, name := range fieldNames {
+		inputField, stateField := fieldByName(createInput, name), fieldByName(state, name)
+
+		// Tags are handled by transparent tagging.
+		if name == "Tags" {
+			m.Tags = m.Tags || inputField != nil
+			continue
+		}
+
+		field := inputField
+		if field == nil {
+			field = stateField
+		}
+
+		attr, ok := b.attribute(field, inputField == nil)
+		if !ok {
+			continue
+		}
+
+		updatable := updateInput != nil && fieldByName(updateInput, name) != nil && name != m.UpdateIDInputField
+
+		if inputField != nil {
+			attr.Required = b.required(createInput.Obj(), name)
+			attr.Optional = !attr.Required
+			attr.Computed = attr.Optional && stateField != nil
+			attr.RequiresReplace = !updatable
+			attr.UseStateForUnknown = attr.Computed && !updatable
+		} else {
+			attr.Computed = true
+			attr.UseStateForUnknown = isIdentifierName(name)
+		}
+
+		if attr.Nested != nil {
+			// Blocks cannot be Computed.
+			attr.Computed = attr.Nested.Computed
+			attr.UseStateForUnknown = false
+		} else if attr.Computed && !attr.Optional && attr.SchemaType == "String" && strings.HasSuffix(name, "Arn") {
+			attr.ComputedOnlyARN = true
+			attr.ModelType, attr.CustomType = "types.String", ""
+		}
+
+		if attr.TFName == "id" && name != m.IDInputField {
+			m.Unsupported = append(m.Unsupported, fmt.Sprintf("%s (conflicts with id)", name))
+			continue
+		}
+
+		if updatable {
+			m.UpdatableAttributes = append(m.UpdatableAttributes, attr)
+		}
+
+		if attr.Nested != nil && !attr.Nested.Computed {
+			m.Blocks = append(m.Blocks, attr)
+		} else {
+			m.Attributes = append(m.Attributes, attr)
+		}
+	}
+
+	m.IDAttribute = idAttribute(m.Attributes, m.IDInputField)
+	if m.IDAttribute != nil {
+		// The resource ID is always a plain string.
+		m.IDAttribute.ModelType, m.IDAttribute.CustomType = "types.String", ""
+	}
+
+	sortAttributes(m.Attributes)
+	sortAttributes(m.Blocks)
+	sortAttributes(m.UpdatableAttributes)
+
+	for _, v := range b.nested {
+		m.NestedModels = append(m.NestedModels, v)
+	}
+	slices.SortFunc(m.NestedModels, func(a, b *SDKNestedModel) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	if m.IDAttribute != nil {
+		if fieldByName(createOutput, m.IDAttribute.FieldName) != nil {
+			m.CreateOutputIDField = m.IDAttribute.FieldName
+		} else {
+			for _, field := range structFields(createOutput) {
+				if named, ok := b.awsTypesStruct(field.Type()); ok && fieldByName(named, m.IDAttribute.FieldName) != nil {
+					m.CreateOutputIDField = field.Name() + "." + m.IDAttribute.FieldName
+					break
+				}
+			}
+		}
+	}
+
+	// Tags are usually listed and updated by ARN.
+	if m.Tags {
+		m.TagsIdentifier = "id"
+		if m.IDAttribute != nil && strings.HasSuffix(m.IDAttribute.FieldName, "Arn") {
+			m.TagsIdentifier = m.IDAttribute.TFName
+		}
+		for _, attr := range m.Attributes {
+			if attr.GoName == "ARN" || attr.GoName == resName+"ARN" {
+				m.TagsIdentifier = attr.TFName
+				break
+			}
+		}
+	}
+
+	m.NotFoundException = b.notFoundException()
+	m.Status = b.status(state, ops.Update != "")
+
+	return nil
+}
+
+func (b *sdkModelBuilder) operationStruct(name string) (*types.Named, error) {
+	obj, ok := b.service.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("%s.%s not found", b.service.Name(), name)
+	}
+
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return nil, fmt.Errorf("%s.%s is not a structure", b.service.Name(), name)
+	}
+
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		return nil, fmt.Errorf("%s.%s is not a structure", b.service.Name(), name)
+	}
+
+	return named, nil
+}
+
+// awsTypesStruct returns the named structure type from the AWS types package that t refers to.
+func (b *sdkModelBuilder) awsTypesStruct(t types.Type) (*types.Named, bool) {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() != b.awsTypes {
+		return nil, false
+	}
+
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		return nil, false
+	}
+
+	return named, true
+}
+
+func (b *sdkModelBuilder) awsTypesEnum(t types.Type) (*types.Named, bool) {
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() != b.awsTypes {
+		return nil, false
+	}
+
+	return named, isString(named.Underlying())
+}
+
+// attribute maps a structure field to a Terraform attribute.
+// The attribute's optionality is set by the caller.
+func (b *sdkModelBuilder) attribute(field *types.Var, computed bool) (*SDKAttribute, bool) {
+	name := field.Name()
+	attr := &SDKAttribute{
+		FieldName: name,
+		GoName:    goName(name),
+		TFName:    ToSnakeCase(name, ""),
+	}
+
+	t := field.Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	if enum, ok := b.awsTypesEnum(t); ok {
+		attr.SchemaType = "String"
+		attr.ModelType = fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", enum.Obj().Name())
+		attr.CustomType = fmt.Sprintf("fwtypes.StringEnumType[awstypes.%s]()", enum.Obj().Name())
+
+		return attr, true
+	}
+
+	if named, ok := b.awsTypesStruct(t); ok {
+		nested, ok := b.nestedModel(named, computed)
+		if !ok {
+			b.unsupported(field)
+			return nil, false
+		}
+
+		b.setNested(attr, nested, true)
+
+		return attr, true
+	}
+
+	switch t := t.(type) {
+	case *types.Basic:
+		switch info := t.Info(); {
+		case info&types.IsString != 0:
+			attr.SchemaType, attr.ModelType = "String", "types.String"
+			if strings.HasSuffix(name, "Arn") {
+				attr.CustomType, attr.ModelType = "fwtypes.ARNType", "fwtypes.ARN"
+			}
+		case info&types.IsBoolean != 0:
+			attr.SchemaType, attr.ModelType = "Bool", "types.Bool"
+		case info&types.IsInteger != 0:
+			attr.SchemaType, attr.ModelType = "Int64", "types.Int64"
+		case info&types.IsFloat != 0:
+			attr.SchemaType, attr.ModelType = "Float64", "types.Float64"
+		default:
+			b.unsupported(field)
+			return nil, false
+		}
+
+	case *types.Named:
+		if obj := t.Obj(); obj.Pkg() == nil || obj.Pkg().Path() != "time" || obj.Name() != "Time" {
+			b.unsupported(field)
+			return nil, false
+		}
+
+		attr.SchemaType = "String"
+		attr.ModelType = "timetypes.RFC3339"
+		attr.CustomType = "timetypes.RFC3339Type{}"
+
+	case *types.Slice:
+		elem := t.Elem()
+
+		if _, ok := b.awsTypesEnum(elem); ok || isString(elem) {
+			attr.SchemaType = "List"
+			attr.ModelType = "fwtypes.ListValueOf[types.String]"
+			attr.CustomType = "fwtypes.ListOfStringType"
+			attr.ElementType = "types.StringType"
+
+			return attr, true
+		}
+
+		named, ok := b.awsTypesStruct(elem)
+		if !ok {
+			b.unsupported(field)
+			return nil, false
+		}
+
+		nested, ok := b.nestedModel(named, computed)
+		if !ok {
+			b.unsupported(field)
+			return nil, false
+		}
+
+		b.setNested(attr, nested, false)
+
+	case *types.Map:
+		if !isStringMap(t) {
+			b.unsupported(field)
+			return nil, false
+		}
+
+		attr.SchemaType = "Map"
+		attr.ModelType = "fwtypes.MapValueOf[types.String]"
+		attr.CustomType = "fwtypes.MapOfStringType"
+		attr.ElementType = "types.StringType"
+
+	default:
+		b.unsupported(field)
+		return nil, false
+	}
+
+	return attr, true
+}
+
+func (b *sdkModelBuilder) setNested(attr *SDKAttribute, nested *SDKNestedModel, maxItemsOne bool) {
+	attr.SchemaType = "List"
+	attr.ModelType = fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", nested.Name)
+	attr.CustomType = fmt.Sprintf("fwtypes.NewListNestedObjectTypeOf[%s](ctx)", nested.Name)
+	attr.Nested = nested
+	attr.MaxItemsOne = maxItemsOne
+
+	if nested.Computed {
+		attr.ElementType = fmt.Sprintf("fwtypes.NewObjectTypeOf[%s](ctx)", nested.Name)
+	}
+}
+
+// nestedModel returns the nested object model for an AWS API structure.
+// Recursive structures are not supported.
+func (b *sdkModelBuilder) nestedModel(named *types.Named, computed bool) (*SDKNestedModel, bool) {
+	typeName := named.Obj().Name()
+	modelName := strings.ToLower(typeName[:1]) + typeName[1:] + "Data"
+
+	if nested, ok := b.nested[typeName]; ok {
+		// A nil entry means that the structure is being built.
+		return nested, nested != nil
+	}
+
+	b.nested[typeName] = nil
+
+	nested := &SDKNestedModel{
+		Name:     modelName,
+		Computed: computed,
+	}
+
+	for _, field := range structFields(named) {
+		attr, ok := b.attribute(field, computed)
+		if !ok {
+			continue
+		}
+
+		if computed {
+			attr.Computed = true
+		} else {
+			attr.Required = b.required(named.Obj(), field.Name())
+			attr.Optional = !attr.Required
+		}
+
+		if attr.Nested != nil && !attr.Nested.Computed {
+			nested.Blocks = append(nested.Blocks, attr)
+		} else {
+			nested.Attributes = append(nested.Attributes, attr)
+		}
+	}
+
+	sortAttributes(nested.Attributes)
+	sortAttributes(nested.Blocks)
+
+	b.nested[typeName] = nested
+
+	return nested, true
+}
+
+func (b *sdkModelBuilder) unsupported(field *types.Var) {
+	b.model.Unsupported = append(b.model.Unsupported, fmt.Sprintf("%s (%s)", field.Name(), b.typeString(field.Type())))
+}
+
+func (b *sdkModelBuilder) typeString(t types.Type) string {
+	return types.TypeString(t, func(pkg *types.Package) string {
+		if pkg == b.awsTypes {
+			return "awstypes"
+		}
+		return pkg.Name()
+	})
+}
+
+func (b *sdkModelBuilder) notFoundException() string {
+	for _, name := range notFoundExceptions {
+		if _, ok := b.awsTypes.Scope().Lookup(name).(*types.TypeName); ok {
+			return name
+		}
+	}
+
+	for _, name := range b.awsTypes.Scope().Names() {
+		if strings.HasSuffix(name, "NotFoundException") {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// status finds the resource's status field and classifies its values for use by waiters.
+func (b *sdkModelBuilder) status(state *types.Named, update bool) *SDKStatus {
+	var field *types.Var
+	var enum *types.Named
+
+	for _, v := range structFields(state) {
+		name := v.Name()
+		if name != "Status" && name != "State" && !strings.HasSuffix(name, "Status") && !strings.HasSuffix(name, "State") {
+			continue
+		}
+
+		e, ok := b.awsTypesEnum(v.Type())
+		if !ok {
+			continue
+		}
+
+		if field == nil || name == "Status" || name == "State" {
+			field, enum = v, e
+		}
+	}
+
+	if field == nil {
+		return nil
+	}
+
+	status := &SDKStatus{
+		Field: field.Name(),
+	}
+
+	for _, name := range b.awsTypes.Scope().Names() {
+		c, ok := b.awsTypes.Scope().Lookup(name).(*types.Const)
+		if !ok || c.Type() != enum || c.Val().Kind() != constant.String {
+			continue
+		}
+
+		v := strings.ToLower(regexache.MustCompile(`[^A-Za-z]`).ReplaceAllString(constant.StringVal(c.Val()), ""))
+		name = "awstypes." + name
+
+		switch {
+		case strings.Contains(v, "fail"):
+		case strings.Contains(v, "creat") && v != "created", v == "pending", v == "provisioning", strings.HasPrefix(v, "initializ"):
+			status.CreatePending = append(status.CreatePending, name)
+		case strings.Contains(v, "updat") && v != "updated", v == "modifying":
+			if update {
+				status.UpdatePending = append(status.UpdatePending, name)
+			}
+		case strings.Contains(v, "delet") && v != "deleted":
+			status.DeletePending = append(status.DeletePending, name)
+		case slices.Contains([]string{"active", "available", "created", "enabled", "inservice", "ready", "running", "succeeded"}, v):
+			status.CreateTarget = append(status.CreateTarget, name)
+			if update {
+				status.UpdateTarget = append(status.UpdateTarget, name)
+			}
+		}
+	}
+
+	return status
+}
+
+// structFields returns the exported, mappable fields of a structure type.
+func structFields(named *types.Named) []*types.Var {
+	st := named.Underlying().(*types.Struct)
+
+	var fields []*types.Var
+	for i := 0; i < st.NumFields(); i++ {
+		if field := st.Field(i); field.Exported() && !field.Embedded() && !slices.Contains(skippedFields, field.Name()) {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+func fieldByName(named *types.Named, name string) *types.Var {
+	for _, field := range structFields(named) {
+		if field.Name() == name {
+			return field
+		}
+	}
+
+	return nil
+}
+
+func isString(t types.Type) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	basic, ok := t.Underlying().(*types.Basic)
+
+	return ok && basic.Info()&types.IsString != 0
+}
+
+func isStringMap(t types.Type) bool {
+	m, ok := t.Underlying().(*types.Map)
+
+	return ok && isString(m.Key()) && isString(m.Elem())
+}
+
+func isIdentifierName(name string) bool {
+	return name == "Arn" || name == "Id" || strings.HasSuffix(name, "Arn") || strings.HasSuffix(name, "Id")
+}
+
+// idAttribute returns the attribute that holds the ID passed in the named Read operation input field.
+// Otherwise an attribute for the same resource is used, e.g. "ThingArn" for "ThingIdentifier".
+func idAttribute(attrs []*SDKAttribute, inputField string) *SDKAttribute {
+	prefix := strings.TrimSuffix(strings.TrimSuffix(inputField, "Identifier"), "Id")
+
+	for _, name := range []string{inputField, prefix + "Id", prefix + "Arn", prefix + "Name"} {
+		for _, attr := range attrs {
+			if attr.FieldName == name && attr.SchemaType == "String" {
+				return attr
+			}
+		}
+	}
+
+	return nil
+}
+
+// initialisms are the words in AWS API field names that are written in upper case in Go.
+var initialisms = map[string]string{
+	"Acl":   "ACL",
+	"Api":   "API",
+	"Arn":   "ARN",
+	"Dns":   "DNS",
+	"Http":  "HTTP",
+	"Https": "HTTPS",
+	"Iam":   "IAM",
+	"Id":    "ID",
+	"Ip":    "IP",
+	"Json":  "JSON",
+	"Kms":   "KMS",
+	"Sns":   "SNS",
+	"Sql":   "SQL",
+	"Ssl":   "SSL",
+	"Uri":   "URI",
+	"Url":   "URL",
+	"Vpc":   "VPC",
+}
+
+// goName returns the Go name of a model field for an AWS API field, e.g. "KeyARN" for "KeyArn".
+func goName(name string) string {
+	return regexache.MustCompile(`[A-Z][a-z0-9]*`).ReplaceAllStringFunc(name, func(word string) string {
+		if v, ok := initialisms[word]; ok {
+			return v
+		}
+		return word
+	})
+}
+
+func sortAttributes(attrs []*SDKAttribute) {
+	slices.SortFunc(attrs, func(a, b *SDKAttribute) int {
+		return strings.Compare(a.TFName, b.TFName)
+	})
+}
+
+// ExampleConfig returns the body of a Terraform configuration that sets each required argument to a placeholder value.
+// The configuration is a fmt format string whose first argument is a unique name.
+func (m *SDKModel) ExampleConfig() string {
+	var attrs []*SDKAttribute
+	width := 0
+	for _, attr := range m.Attributes {
+		if attr.Required {
+			attrs = append(attrs, attr)
+			width = max(width, len(attr.TFName))
+		}
+	}
+
+	var sb strings.Builder
+	rName := false
+	for _, attr := range attrs {
+		value := `"TODO"`
+		switch attr.SchemaType {
+		case "Bool":
+			value = "false"
+		case "Float64", "Int64":
+			value = "1"
+		case "List":
+			value = `["TODO"]`
+		case "Map":
+			value = `{ key = "value" }`
+		case "String":
+			if strings.HasSuffix(attr.TFName, "name") && attr.CustomType == "" {
+				value = "%[1]q"
+				rName = true
+			}
+		}
+
+		fmt.Fprintf(&sb, "  %-*s = %s\n", width, attr.TFName, value)
+	}
+
+	for _, attr := range m.Blocks {
+		if attr.Required {
+			if sb.Len() > 0 {
+				sb.WriteString("\n")
+			}
+			fmt.Fprintf(&sb, "  %s {\n    # TODO\n  }\n", attr.TFName)
+		}
+	}
+
+	if !rName {
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString("  # TODO: Use %[1]q as a unique name.\n")
+	}
+
+	return sb.String()
+}
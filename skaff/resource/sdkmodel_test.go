@@ -0,0 +1,390 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+const testSDKTypesSource = `
+package types
+
+import (
+	"time"
+
+	"github.com/aws/smithy-go/document"
+)
+
+type ThingStatus string
+
+const (
+	ThingStatusActive   ThingStatus = "ACTIVE"
+	ThingStatusCreating ThingStatus = "CREATING"
+	ThingStatusDeleting ThingStatus = "DELETING"
+	ThingStatusFailed   ThingStatus = "CREATE_FAILED"
+	ThingStatusUpdating ThingStatus = "UPDATING"
+)
+
+type Thing struct {
+	Config      *Config
+	CreatedAt   *time.Time
+	Description *string
+	Name        *string
+	Status      ThingStatus
+	ThingArn    *string
+	ThingId     *string
+}
+
+type Config struct {
+	Enabled *bool
+
+	// The size.
+	//
+	// This member is required.
+	Size *int32
+}
+
+type ResourceNotFoundException struct {
+	Message *string
+}
+
+type Document = document.Interface
+`
+
+const testSDKServiceSource = `
+package thing
+
+import (
+	"example.com/service/thing/types"
+	"github.com/aws/smithy-go/middleware"
+)
+
+type CreateThingInput struct {
+	ClientToken *string
+	Config      *types.Config
+	Description *string
+	Metadata    types.Document
+
+	// This member is required.
+	Name *string
+
+	Tags map[string]string
+}
+
+type CreateThingOutput struct {
+	ThingId        *string
+	ResultMetadata middleware.Metadata
+}
+
+type GetThingInput struct {
+	// This member is required.
+	ThingId *string
+}
+
+type GetThingOutput struct {
+	Thing          *types.Thing
+	ResultMetadata middleware.Metadata
+}
+
+type UpdateThingInput struct {
+	Description *string
+
+	// This member is required.
+	ThingId *string
+}
+
+type UpdateThingOutput struct{}
+
+type DeleteThingInput struct {
+	// This member is required.
+	ThingId *string
+}
+
+type DeleteThingOutput struct{}
+`
+
+func testSDKModel(t *testing.T) *SDKModel {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	required := make(map[string]bool)
+
+	parse := func(src string) []*ast.File {
+		file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return []*ast.File{file}
+	}
+
+	awsTypes := checkPackage(fset, "example.com/service/thing/types", parse(testSDKTypesSource), nil, required)
+	service := checkPackage(fset, "example.com/service/thing", parse(testSDKServiceSource), awsTypes, required)
+
+	model, err := newSDKModel(service, awsTypes, func(typeName *types.TypeName, fieldName string) bool {
+		return required[typeName.Pkg().Path()+"."+typeName.Name()+"."+fieldName]
+	}, "Thing", SDKOperations{
+		Create: "CreateThing",
+		Read:   "GetThing",
+		Update: "UpdateThing",
+		Delete: "DeleteThing",
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return model
+}
+
+func TestNewSDKModel(t *testing.T) {
+	model := testSDKModel(t)
+
+	var attrs []string
+	for _, attr := range model.Attributes {
+		attrs = append(attrs, attr.TFName)
+	}
+	if got, expected := attrs, []string{"created_at", "description", "name", "status", "thing_arn", "thing_id"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("attributes: got %v, expected %v", got, expected)
+	}
+
+	if got, expected := len(model.Blocks), 1; got != expected {
+		t.Fatalf("blocks: got %d, expected %d", got, expected)
+	}
+	if got, expected := model.Blocks[0].ModelType, "fwtypes.ListNestedObjectValueOf[configData]"; got != expected {
+		t.Errorf("config model type: got %s, expected %s", got, expected)
+	}
+
+	if got, expected := model.Unsupported, []string{"Metadata (awstypes.Document)"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("unsupported: got %v, expected %v", got, expected)
+	}
+
+	for _, testCase := range []struct {
+		TestName string
+		Got      any
+		Expected any
+	}{
+		{"read output type", model.ReadOutputType, "awstypes.Thing"},
+		{"read output field", model.ReadOutputField, "Thing"},
+		{"ID attribute", model.IDAttribute.GoName, "ThingID"},
+		{"ID input field", model.IDInputField, "ThingId"},
+		{"update ID input field", model.UpdateIDInputField, "ThingId"},
+		{"delete ID input field", model.DeleteIDInputField, "ThingId"},
+		{"create output ID field", model.CreateOutputIDField, "ThingId"},
+		{"client token field", model.ClientTokenField, "ClientToken"},
+		{"tags", model.Tags, true},
+		{"tags identifier", model.TagsIdentifier, "thing_arn"},
+		{"not found exception", model.NotFoundException, "ResourceNotFoundException"},
+		{"has update", model.HasUpdate(), true},
+		{"status field", model.Status.Field, "Status"},
+		{"create pending", model.Status.CreatePending, []string{"awstypes.ThingStatusCreating"}},
+		{"create target", model.Status.CreateTarget, []string{"awstypes.ThingStatusActive"}},
+		{"update pending", model.Status.UpdatePending, []string{"awstypes.ThingStatusUpdating"}},
+		{"delete pending", model.Status.DeletePending, []string{"awstypes.ThingStatusDeleting"}},
+	} {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if !reflect.DeepEqual(testCase.Got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", testCase.Got, testCase.Expected)
+			}
+		})
+	}
+
+	attrs = nil
+	for _, attr := range model.UpdatableAttributes {
+		attrs = append(attrs, attr.TFName)
+	}
+	if got, expected := attrs, []string{"description"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("updatable attributes: got %v, expected %v", got, expected)
+	}
+}
+
+func TestSDKModelAttributes(t *testing.T) {
+	model := testSDKModel(t)
+
+	attrs := make(map[string]*SDKAttribute)
+	for _, attr := range append(model.Attributes, model.Blocks...) {
+		attrs[attr.TFName] = attr
+	}
+	for _, attr := range model.NestedModels[0].Attributes {
+		attrs["config."+attr.TFName] = attr
+	}
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected SDKAttribute
+	}{
+		{
+			TestName: "required",
+			Input:    "name",
+			Expected: SDKAttribute{FieldName: "Name", GoName: "Name", TFName: "name", ModelType: "types.String", SchemaType: "String", Required: true, RequiresReplace: true},
+		},
+		{
+			TestName: "updatable",
+			Input:    "description",
+			Expected: SDKAttribute{FieldName: "Description", GoName: "Description", TFName: "description", ModelType: "types.String", SchemaType: "String", Optional: true, Computed: true},
+		},
+		{
+			TestName: "computed",
+			Input:    "created_at",
+			Expected: SDKAttribute{FieldName: "CreatedAt", GoName: "CreatedAt", TFName: "created_at", ModelType: "timetypes.RFC3339", SchemaType: "String", CustomType: "timetypes.RFC3339Type{}", Computed: true},
+		},
+		{
+			TestName: "enum",
+			Input:    "status",
+			Expected: SDKAttribute{FieldName: "Status", GoName: "Status", TFName: "status", ModelType: "fwtypes.StringEnum[awstypes.ThingStatus]", SchemaType: "String", CustomType: "fwtypes.StringEnumType[awstypes.ThingStatus]()", Computed: true},
+		},
+		{
+			TestName: "computed ARN",
+			Input:    "thing_arn",
+			Expected: SDKAttribute{FieldName: "ThingArn", GoName: "ThingARN", TFName: "thing_arn", ModelType: "types.String", SchemaType: "String", Computed: true, ComputedOnlyARN: true, UseStateForUnknown: true},
+		},
+		{
+			TestName: "nested required",
+			Input:    "config.size",
+			Expected: SDKAttribute{FieldName: "Size", GoName: "Size", TFName: "size", ModelType: "types.Int64", SchemaType: "Int64", Required: true},
+		},
+		{
+			TestName: "nested optional",
+			Input:    "config.enabled",
+			Expected: SDKAttribute{FieldName: "Enabled", GoName: "Enabled", TFName: "enabled", ModelType: "types.Bool", SchemaType: "Bool", Optional: true},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, ok := attrs[testCase.Input]
+			if !ok {
+				t.Fatalf("attribute %s not found", testCase.Input)
+			}
+
+			if !reflect.DeepEqual(*got, testCase.Expected) {
+				t.Errorf("got %+v, expected %+v", *got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestGoName(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+	}{
+		{
+			TestName: "simple",
+			Input:    "Name",
+			Expected: "Name",
+		},
+		{
+			TestName: "initialism",
+			Input:    "KeyArn",
+			Expected: "KeyARN",
+		},
+		{
+			TestName: "initialisms",
+			Input:    "VpcEndpointId",
+			Expected: "VPCEndpointID",
+		},
+		{
+			TestName: "upper case",
+			Input:    "DBInstanceIdentifier",
+			Expected: "DBInstanceIdentifier",
+		},
+		{
+			TestName: "prefix",
+			Input:    "Identity",
+			Expected: "Identity",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := goName(testCase.Input)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestSDKTemplates(t *testing.T) {
+	td := TemplateData{
+		Resource:             "Thing",
+		ResourceLower:        "thing",
+		ResourceSnake:        "thing",
+		HumanFriendlyService: "Example",
+		IncludeComments:      true,
+		ServicePackage:       "thing",
+		Service:              "Thing",
+		AWSGoSDKV2:           true,
+		PluginFramework:      true,
+		HumanResourceName:    "Thing",
+		ProviderResourceName: "aws_thing_thing",
+		SDK:                  testSDKModel(t),
+	}
+
+	testCases := []struct {
+		TestName string
+		Template string
+		Expected []string
+	}{
+		{
+			TestName: "resource",
+			Template: resourceSDKTmpl,
+			Expected: []string{
+				"// TODO: Metadata (awstypes.Document) is not supported by the generator and must be added by hand.",
+				`// @Tags(identifierAttribute="thing_arn")`,
+				"data.ThingID = fwflex.StringToFramework(ctx, output.ThingId)",
+				"if !new.Description.Equal(old.Description) {",
+				"func findThingByID(ctx context.Context, conn *thing.Client, id string) (*awstypes.Thing, error) {",
+				"Pending: enum.Slice(awstypes.ThingStatusCreating),",
+				"	Config      fwtypes.ListNestedObjectValueOf[configData] `tfsdk:\"config\"`",
+				"type configData struct {",
+			},
+		},
+		{
+			TestName: "test",
+			Template: resourceSDKTestTmpl,
+			Expected: []string{
+				"var v awstypes.Thing",
+				`resource.TestCheckResourceAttrSet(resourceName, "thing_arn"),`,
+				"resource \"aws_thing_thing\" \"test\" {\n  name = %[1]q\n}\n",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			tmpl, err := template.New(testCase.TestName).Parse(testCase.Template)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buffer bytes.Buffer
+			if err := tmpl.Execute(&buffer, td); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := formatSource(buffer.Bytes())
+			if err != nil {
+				t.Fatalf("formatting generated code: %s\n%s", err, buffer.String())
+			}
+
+			for _, expected := range testCase.Expected {
+				if !strings.Contains(string(got), expected) {
+					t.Errorf("expected generated code to contain %q\n%s", expected, got)
+				}
+			}
+		})
+	}
+}
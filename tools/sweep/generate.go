@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:generate go run -C ../.. internal/generate/servicepackages/main.go -servicedir internal/service -- tools/sweep/service_packages_gen.go
+//go:generate go run -C ../.. internal/generate/sweeperregistration/main.go -servicedir internal/service -- tools/sweep/register_gen.go
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package main
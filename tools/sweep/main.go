@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+var (
+	concurrency   int
+	dryRun        bool
+	maxAge        time.Duration
+	namePrefixes  string
+	regions       string
+	reportFile    string
+	resourceTypes string
+	stateFile     string
+	tagFilters    []sweep.Filter
+)
+
+func main() {
+	// The acceptance test framework registers its own flags on flag.CommandLine.
+	flags := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+
+	// slightly better usage output
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), "Delete AWS resources using the provider's test sweepers.\n\n")
+		fmt.Fprintf(flags.Output(), "Usage: %s [flags]\n\nFlags:\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	flags.IntVar(&concurrency, "concurrency", 0, "maximum number of concurrent deletions per service (optional, defaults to no limit)")
+	flags.BoolVar(&dryRun, "dry-run", false, "report the resources that would be deleted without deleting them (requires -resource-types)")
+	flags.DurationVar(&maxAge, "max-age", 0, "only delete resources created more than this long ago, e.g. 24h (optional, requires -resource-types)")
+	flags.StringVar(&namePrefixes, "name-prefix", "", "comma-separated list of prefixes; only delete resources whose name starts with one of them (optional, requires -resource-types)")
+	flags.StringVar(&regions, "regions", "us-west-2,us-east-1", "comma-separated list of regions to sweep")
+	flags.StringVar(&reportFile, "report", "", "file to write the JSON report to (optional, defaults to stdout)")
+	flags.StringVar(&resourceTypes, "resource-types", "", "comma-separated list of resource types to sweep, along with the resource types they depend on (optional, defaults to all)")
+	flags.StringVar(&stateFile, "state-file", "", "file used to checkpoint progress so that an interrupted sweep can be resumed (optional)")
+	flags.Func("tag", "only delete resources with the tag `key[=value]`; may be repeated (optional, requires -resource-types)", func(s string) error {
+		key, value, _ := strings.Cut(s, "=")
+		if key == "" {
+			return fmt.Errorf("invalid tag filter %q", s)
+		}
+
+		tagFilters = append(tagFilters, sweep.TagFilter(key, value))
+
+		return nil
+	})
+	_ = flags.Parse(os.Args[1:]) // Exits on error.
+
+	log.SetPrefix("sweep: ")
+	log.SetFlags(0)
+
+	if err := run(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context) error {
+	sweep.ServicePackages = servicePackages(ctx)
+
+	registerSweepers()
+
+	var filters []sweep.Filter
+	if namePrefixes != "" {
+		filters = append(filters, sweep.NamePrefixFilter(splitList(namePrefixes)...))
+	}
+	filters = append(filters, tagFilters...)
+	if maxAge > 0 {
+		filters = append(filters, sweep.MaxAgeFilter(maxAge, time.Now()))
+	}
+
+	report := &sweep.Report{
+		DryRun: dryRun,
+	}
+	engine := &sweep.Engine{
+		Concurrency: concurrency,
+		DryRun:      dryRun,
+		Filters:     filters,
+		Report:      report,
+		StateFile:   stateFile,
+		Sweepers:    splitList(resourceTypes),
+	}
+
+	sweepErr := engine.Run(sweep.Context(""), splitList(regions)...)
+
+	for _, v := range report.Sweepers {
+		if v.Status == sweep.SweeperStatusSkipped {
+			log.Printf("skipped %s in %s: %s", v.Sweeper, v.Region, v.Reason)
+		}
+	}
+
+	if err := writeReport(report); err != nil {
+		return err
+	}
+
+	return sweepErr
+}
+
+func writeReport(report *sweep.Report) error {
+	var w io.Writer = os.Stdout
+
+	if reportFile != "" {
+		f, err := os.Create(reportFile)
+		if err != nil {
+			return fmt.Errorf("writing report: %w", err)
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	if err := report.WriteJSON(w); err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+
+	return nil
+}
+
+func splitList(s string) []string {
+	var result []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/translate"
+	"golang.org/x/exp/slices"
+)
+
+// templateImports returns the import specs already emitted by resource.tmpl.
+func templateImports(templateData *templateData) []string {
+	imports := []string{
+		`"context"`,
+		`"github.com/hashicorp/terraform-plugin-framework/resource"`,
+		`"github.com/hashicorp/terraform-plugin-framework/types"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/framework"`,
+	}
+
+	if templateData.HasTimeouts {
+		imports = append(imports, `"time"`)
+	}
+	if templateData.DeleteBody == "" {
+		imports = append(imports, `"github.com/hashicorp/terraform-plugin-log/tflog"`)
+	}
+	if templateData.ImportProviderFrameworkTypes {
+		imports = append(imports, `fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`)
+	}
+
+	return imports
+}
+
+// translateCRUD translates the Plugin SDK resource's CRUD functions into Plugin Framework method bodies.
+// Functions whose source can't be found are left for manual migration.
+func (m *migrator) translateCRUD(templateData *templateData, attributes map[string]translate.Attribute) error {
+	translator := &translate.Translator{
+		Attributes: attributes,
+		Receiver:   "r",
+		TFTypeName: m.TFTypeName,
+	}
+
+	var imports []string
+
+	for _, f := range []struct {
+		operation translate.Operation
+		fn        any
+		body      *string
+	}{
+		{translate.Create, firstNonNil(m.Resource.CreateWithoutTimeout, m.Resource.CreateContext, m.Resource.Create), &templateData.CreateBody},
+		{translate.Read, firstNonNil(m.Resource.ReadWithoutTimeout, m.Resource.ReadContext, m.Resource.Read), &templateData.ReadBody},
+		{translate.Update, firstNonNil(m.Resource.UpdateWithoutTimeout, m.Resource.UpdateContext, m.Resource.Update), &templateData.UpdateBody},
+		{translate.Delete, firstNonNil(m.Resource.DeleteWithoutTimeout, m.Resource.DeleteContext, m.Resource.Delete), &templateData.DeleteBody},
+	} {
+		if f.fn == nil {
+			continue
+		}
+
+		fset, file, decl, err := translate.FuncDecl(f.fn)
+
+		if err != nil {
+			m.Generator.Warnf("%s function not translated: %s", f.operation, err)
+			continue
+		}
+
+		result, err := translator.Translate(fset, file, decl, f.operation)
+
+		if err != nil {
+			return err
+		}
+
+		*f.body = result.Body
+
+		for _, v := range result.Imports {
+			if !slices.Contains(imports, v) {
+				imports = append(imports, v)
+			}
+		}
+
+		for _, v := range result.Unresolved {
+			templateData.Unresolved = append(templateData.Unresolved, unresolvedConstruct{
+				Function:  decl.Name.Name,
+				Operation: f.operation.String(),
+				Construct: v,
+			})
+		}
+
+		if n := len(result.Unresolved); n > 0 {
+			m.Generator.Warnf("%s function %s: %d construct(s) require manual migration: %s", f.operation, decl.Name.Name, n, strings.Join(result.Unresolved, "; "))
+		}
+	}
+
+	for _, v := range imports {
+		if slices.Contains(templateImports(templateData), v) {
+			continue
+		}
+
+		// Standard library import paths have no dot in their first element.
+		if path := strings.Trim(v[strings.Index(v, `"`):], `"`); !strings.Contains(strings.Split(path, "/")[0], ".") {
+			templateData.StdlibImports = append(templateData.StdlibImports, v)
+		} else {
+			templateData.Imports = append(templateData.Imports, v)
+		}
+	}
+
+	return nil
+}
+
+// firstNonNil returns the first non-nil CRUD function.
+func firstNonNil(fns ...any) any {
+	for _, fn := range fns {
+		// Typed nil functions are non-nil interface values.
+		if v := reflect.ValueOf(fn); v.Kind() == reflect.Func && !v.IsNil() {
+			return fn
+		}
+	}
+
+	return nil
+}
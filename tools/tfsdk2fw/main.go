@@ -18,17 +18,19 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/translate"
 	"golang.org/x/exp/slices"
 )
 
 var (
 	dataSourceType = flag.String("data-source", "", "Data Source type")
+	reportFile     = flag.String("report", "", "Semantic changes report file")
 	resourceType   = flag.String("resource", "", "Resource type")
 )
 
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n")
-	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] <package-name> <name> <generated-file>\n\n")
+	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] [-report <report-file>] <package-name> <name> <generated-file>\n\n")
 }
 
 func main() {
@@ -56,6 +58,7 @@ func main() {
 		Generator:   g,
 		Name:        name,
 		PackageName: packageName,
+		ReportFile:  *reportFile,
 	}
 
 	p, err := provider.New(context.Background())
@@ -97,6 +100,7 @@ type migrator struct {
 	IsDataSource bool
 	Name         string
 	PackageName  string
+	ReportFile   string
 	Resource     *schema.Resource
 	Template     string
 	TFTypeName   string
@@ -126,6 +130,22 @@ func (m *migrator) migrate(outputFilename string) error {
 		return err
 	}
 
+	if err := d.Write(); err != nil {
+		return err
+	}
+
+	if m.ReportFile == "" {
+		return nil
+	}
+
+	m.infof("writing semantic changes report into %[1]q", m.ReportFile)
+
+	d = m.Generator.NewUnformattedFileDestination(m.ReportFile)
+
+	if err := d.WriteTemplate("report", reportImpl, templateData); err != nil {
+		return err
+	}
+
 	return d.Write()
 }
 
@@ -145,6 +165,12 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		return nil, fmt.Errorf("emitting schema code: %w", err)
 	}
 
+	stateUpgraders, err := emitter.emitStateUpgraders(m.Resource)
+
+	if err != nil {
+		return nil, fmt.Errorf("emitting state upgrader code: %w", err)
+	}
+
 	templateData := &templateData{
 		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
 		DefaultReadTimeout:           emitter.DefaultReadTimeout,
@@ -159,10 +185,18 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		Name:                         m.Name,
 		PackageName:                  m.PackageName,
 		Schema:                       sbSchema.String(),
+		SemanticChanges:              emitter.SemanticChanges,
+		StateUpgraders:               stateUpgraders,
 		Struct:                       sbStruct.String(),
 		TFTypeName:                   m.TFTypeName,
 	}
 
+	if !m.IsDataSource {
+		if err := m.translateCRUD(templateData, emitter.TopLevelAttributes); err != nil {
+			return nil, fmt.Errorf("translating CRUD functions: %w", err)
+		}
+	}
+
 	for _, v := range emitter.FrameworkPlanModifierPackages {
 		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
 			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
@@ -202,7 +236,10 @@ type emitter struct {
 	IsDataSource                  bool
 	ProviderPlanModifierPackages  []string // Package names for any provider plan modifiers. May contain duplicates.
 	SchemaWriter                  io.Writer
+	SemanticChanges               []semanticChange
 	StructWriter                  io.Writer
+	TopLevelAttributes            map[string]translate.Attribute // Keyed by attribute name.
+	TopLevelFieldTypes            map[string]string              // Model struct field types, keyed by attribute name.
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
@@ -328,6 +365,12 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
+		if isTopLevelAttribute {
+			fieldType := blockFieldType(property)
+			fprintf(e.StructWriter, "%s %s `tfsdk:%q`\n", naming.ToCamelCase(name), fieldType, name)
+			e.addTopLevelField(name, fieldType, translate.KindAggregate)
+		}
+
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -356,6 +399,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 		if isTopLevelAttribute {
 			fprintf(e.StructWriter, "types.Bool")
+			e.addTopLevelField(attributeName, "types.Bool", translate.KindBool)
 		}
 
 		fwPlanModifierPackage = "boolplanmodifier"
@@ -366,6 +410,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 		if isTopLevelAttribute {
 			fprintf(e.StructWriter, "types.Float64")
+			e.addTopLevelField(attributeName, "types.Float64", translate.KindFloat64)
 		}
 
 		fwPlanModifierPackage = "float64planmodifier"
@@ -376,6 +421,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 		if isTopLevelAttribute {
 			fprintf(e.StructWriter, "types.Int64")
+			e.addTopLevelField(attributeName, "types.Int64", translate.KindInt64)
 		}
 
 		fwPlanModifierPackage = "int64planmodifier"
@@ -391,6 +437,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			if isTopLevelAttribute {
 				fprintf(e.StructWriter, "fwtypes.ARN")
+				e.addTopLevelField(attributeName, "fwtypes.ARN", translate.KindARN)
 			}
 		} else {
 			if isTopLevelAttribute && attributeName == "id" {
@@ -401,6 +448,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			if isTopLevelAttribute {
 				fprintf(e.StructWriter, "types.String")
+				e.addTopLevelField(attributeName, "types.String", translate.KindString)
 			}
 		}
 
@@ -420,6 +468,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			if isTopLevelAttribute {
 				fprintf(e.StructWriter, "types.List")
+				e.addTopLevelField(attributeName, "types.List", translate.KindAggregate)
 			}
 
 			fwPlanModifierPackage = "listplanmodifier"
@@ -433,6 +482,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			if isTopLevelAttribute {
 				fprintf(e.StructWriter, "types.Map")
+				e.addTopLevelField(attributeName, "types.Map", translate.KindAggregate)
 			}
 
 			fwPlanModifierPackage = "mapplanmodifier"
@@ -446,6 +496,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			if isTopLevelAttribute {
 				fprintf(e.StructWriter, "types.Set")
+				e.addTopLevelField(attributeName, "types.Set", translate.KindAggregate)
 			}
 
 			fwPlanModifierPackage = "setplanmodifier"
@@ -494,6 +545,8 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 		case *schema.Resource:
 			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
+			e.semanticChangef(path, changeComputed, "Nested block is migrated to a %s attribute of objects", typeName)
+
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
 			fprintf(e.SchemaWriter, "ElementType:")
 
@@ -562,20 +615,35 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		switch v := def.(type) {
 		case bool:
 			fprintf(e.SchemaWriter, "// TODO Default:%#v,\n", def)
+			e.semanticChangef(path, changeDefault, "Default %#v is not migrated. Attributes with a default value must also be Computed", def)
 		case int:
 			fprintf(e.SchemaWriter, "// TODO Default:%#v,\n", def)
+			e.semanticChangef(path, changeDefault, "Default %#v is not migrated. Attributes with a default value must also be Computed", def)
 		case float64:
 			fprintf(e.SchemaWriter, "// TODO Default:%#v,\n", def)
+			e.semanticChangef(path, changeDefault, "Default %#v is not migrated. Attributes with a default value must also be Computed", def)
 		case string:
 			providerPlanModifierPackage = "stringplanmodifier"
 			// Alias the provider plan modifier package name with an "fw" prefix. See also resource.tmpl.
 			planModifiers = append(planModifiers, fmt.Sprintf("fw%s.DefaultValue(%q)", providerPlanModifierPackage, v))
 			e.ProviderPlanModifierPackages = append(e.ProviderPlanModifierPackages, providerPlanModifierPackage)
+			if !property.Computed {
+				e.semanticChangef(path, changeDefault, "Default %q is applied by a plan modifier, which requires the attribute to be Computed", v)
+			}
 		default:
 			fprintf(e.SchemaWriter, "// TODO Default:%#v,\n", def)
+			e.semanticChangef(path, changeDefault, "Default %#v is not migrated", def)
 		}
 	}
 
+	if property.Optional && property.Computed && !(isTopLevelAttribute && attributeName == "id") {
+		e.semanticChangef(path, changeComputed, "Optional+Computed values are unknown during planning unless configured. Add a UseStateForUnknown plan modifier to keep the prior state value")
+	}
+
+	if property.DiffSuppressFunc != nil || property.StateFunc != nil {
+		e.semanticChangef(path, changeComputed, "DiffSuppressFunc and StateFunc are not migrated. Use a custom type with semantic equality")
+	}
+
 	if len(planModifiers) > 0 {
 		fprintf(e.SchemaWriter, "PlanModifiers:[]planmodifier.%s{\n", fwPlanModifierType)
 		for _, planModifier := range planModifiers {
@@ -669,6 +737,14 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 		property.MinItems = 0
 	}
 
+	if property.Optional && property.Computed {
+		e.semanticChangef(path, changeComputed, "Blocks can't be Computed. The block is empty in state unless configured")
+	}
+
+	if property.Sensitive {
+		e.semanticChangef(path, changeSensitive, "Blocks can't be Sensitive. Mark the nested attributes Sensitive instead")
+	}
+
 	if description := property.Description; description != "" {
 		fprintf(e.SchemaWriter, "Description:%q,\n", description)
 	}
@@ -705,6 +781,7 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 
 	if def := property.Default; def != nil {
 		e.warnf("Block %s has non-nil Default: %v", strings.Join(path, "/"), def)
+		e.semanticChangef(path, changeDefault, "Blocks can't have a default value (%v)", def)
 	}
 
 	fprintf(e.SchemaWriter, "}")
@@ -756,6 +833,10 @@ func (e *emitter) emitComputedOnlyBlock(path []string, schema map[string]*schema
 // and emits the generated code to the emitter's Writer.
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
 func (e *emitter) emitComputedOnlyBlockProperty(path []string, property *schema.Schema) error {
+	if property.Sensitive {
+		e.semanticChangef(path, changeSensitive, "Object attribute types can't be Sensitive. Mark the enclosing attribute Sensitive instead")
+	}
+
 	// At this point we are emitting code for the values of a types.ObjectType's AttrMap (map[string]attr.Type).
 	switch v := property.Type; v {
 	//
@@ -835,6 +916,20 @@ func (e *emitter) emitComputedOnlyBlockProperty(path []string, property *schema.
 	return nil
 }
 
+// addTopLevelField records the model struct field emitted for a top-level attribute or block.
+func (e *emitter) addTopLevelField(name, fieldType string, kind translate.Kind) {
+	if e.TopLevelAttributes == nil {
+		e.TopLevelAttributes = make(map[string]translate.Attribute)
+		e.TopLevelFieldTypes = make(map[string]string)
+	}
+
+	e.TopLevelAttributes[name] = translate.Attribute{
+		FieldName: naming.ToCamelCase(name),
+		Kind:      kind,
+	}
+	e.TopLevelFieldTypes[name] = fieldType
+}
+
 // warnf emits a formatted warning message to the UI.
 func (e *emitter) warnf(format string, a ...interface{}) {
 	e.Generator.Warnf(format, a...)
@@ -878,6 +973,15 @@ func isAttribute(property *schema.Schema) bool {
 	return false
 }
 
+// blockFieldType returns the model struct field type for a top-level block.
+func blockFieldType(property *schema.Schema) string {
+	if property.Type == schema.TypeSet {
+		return "types.Set"
+	}
+
+	return "types.List"
+}
+
 func unsupportedTypeError(path []string, typ string) error {
 	return fmt.Errorf("%s is of unsupported type: %s", strings.Join(path, "/"), typ)
 }
@@ -899,8 +1003,19 @@ type templateData struct {
 	PackageName                   string // e.g. ec2
 	ProviderPlanModifierPackages  []string
 	Schema                        string
+	SemanticChanges               []semanticChange
+	StateUpgraders                []stateUpgrader
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
+
+	// Translated Plugin SDK CRUD function bodies. Empty if the function could not be translated.
+	CreateBody    string
+	ReadBody      string
+	UpdateBody    string
+	DeleteBody    string
+	Imports       []string // Additional import specs used by the translated function bodies.
+	StdlibImports []string // Additional standard library import specs used by the translated function bodies.
+	Unresolved    []unresolvedConstruct
 }
 
 //go:embed datasource.tmpl
@@ -908,3 +1023,6 @@ var datasourceImpl string
 
 //go:embed resource.tmpl
 var resourceImpl string
+
+//go:embed report.tmpl
+var reportImpl string
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kinds of semantic change.
+const (
+	changeComputed  = "computed"
+	changeDefault   = "default"
+	changeSensitive = "sensitive"
+)
+
+// semanticChange describes an attribute whose behavior differs between the Plugin SDK and generated Plugin Framework schemas.
+type semanticChange struct {
+	Path   string // e.g. rule/action.
+	Kind   string
+	Detail string
+}
+
+// unresolvedConstruct describes Plugin SDK code that could not be translated automatically.
+type unresolvedConstruct struct {
+	Function  string // e.g. resourceVPCCreate.
+	Operation string // e.g. Create.
+	Construct string
+}
+
+// semanticChangef records a semantic change for the attribute or block at the specified path.
+func (e *emitter) semanticChangef(path []string, kind, format string, a ...interface{}) {
+	e.SemanticChanges = append(e.SemanticChanges, semanticChange{
+		Path:   strings.Join(path, "/"),
+		Kind:   kind,
+		Detail: fmt.Sprintf(format, a...),
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package translate
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// FuncDecl returns the parsed declaration of the specified top-level function value
+// together with its source file.
+// The function's source must be available at the location recorded in the binary.
+func FuncDecl(fn any) (*token.FileSet, *ast.File, *ast.FuncDecl, error) {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return nil, nil, nil, fmt.Errorf("%T is not a function", fn)
+	}
+
+	f := runtime.FuncForPC(v.Pointer())
+	if f == nil {
+		return nil, nil, nil, fmt.Errorf("function %T not found", fn)
+	}
+
+	// e.g. github.com/hashicorp/terraform-provider-aws/internal/service/ec2.resourceVPCCreate.
+	fullName := f.Name()
+	name := fullName[strings.LastIndex(fullName, ".")+1:]
+	if strings.Contains(fullName[strings.LastIndex(fullName, "/")+1:], ".func") {
+		return nil, nil, nil, fmt.Errorf("function %s is a function literal", fullName)
+	}
+
+	filename, _ := f.FileLine(f.Entry())
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("parsing %s: %w", filename, err)
+	}
+
+	for _, decl := range file.Decls {
+		if decl, ok := decl.(*ast.FuncDecl); ok && decl.Recv == nil && decl.Name.Name == name {
+			return fset, file, decl, nil
+		}
+	}
+
+	return nil, nil, nil, fmt.Errorf("function %s not found in %s", fullName, filename)
+}
@@ -0,0 +1,986 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package translate rewrites the bodies of Plugin SDK v2 CRUD functions into
+// the bodies of the equivalent Plugin Framework resource methods.
+//
+// Only the common patterns are translated:
+//
+//   - d.Get, d.GetOk, d.Id, d.HasChange(s) and d.Timeout become model struct access
+//   - d.Set and d.SetId become model struct assignments or fwflex.Flatten calls
+//   - expand* calls on aggregate attributes become fwflex.Expand calls
+//   - sdkdiag/diag errors become response.Diagnostics errors
+//
+// Anything else is left as-is and reported so that it can be migrated by hand.
+package translate
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// Operation is a resource CRUD operation.
+type Operation int
+
+const (
+	Create Operation = iota
+	Read
+	Update
+	Delete
+)
+
+func (o Operation) String() string {
+	switch o {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	}
+
+	return fmt.Sprintf("Operation(%d)", int(o))
+}
+
+// gerund returns the verb used in error summaries, e.g. "creating".
+func (o Operation) gerund() string {
+	switch o {
+	case Create:
+		return "creating"
+	case Update:
+		return "updating"
+	case Delete:
+		return "deleting"
+	}
+
+	return "reading"
+}
+
+// Kind is the kind of a top-level attribute's model struct field.
+type Kind int
+
+const (
+	KindUnknown Kind = iota
+	KindBool
+	KindFloat64
+	KindInt64
+	KindString
+	KindARN       // fwtypes.ARN.
+	KindAggregate // List, Set, Map or nested block.
+)
+
+// Attribute describes a top-level attribute of the migrated resource.
+type Attribute struct {
+	FieldName string // Model struct field name, e.g. VPCID.
+	Kind      Kind
+}
+
+// Translator translates Plugin SDK v2 CRUD functions.
+type Translator struct {
+	Attributes map[string]Attribute // Keyed by Terraform attribute name.
+	Receiver   string               // Name of the Plugin Framework method receiver, e.g. r.
+	TFTypeName string               // e.g. aws_instance.
+}
+
+// Result is the result of translating a single function.
+type Result struct {
+	Body       string   // Translated statements, without enclosing braces.
+	Imports    []string // Import specs used by the translated statements.
+	Unresolved []string // Constructs that must be migrated by hand.
+}
+
+const (
+	fmtImportPath       = "fmt"
+	fwdiagImportPath    = "github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	fwflexImportPath    = "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypesImportPath   = "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	sdkdiagImportPath   = "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkschemaImportPath = "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	todoMarker = "_TODO_"
+)
+
+// Translate translates the body of the specified function declaration.
+// file is the parsed source file containing the declaration and is used to resolve imports.
+func (t *Translator) Translate(fset *token.FileSet, file *ast.File, decl *ast.FuncDecl, op Operation) (*Result, error) {
+	if decl.Body == nil {
+		return nil, fmt.Errorf("function %s has no body", decl.Name.Name)
+	}
+
+	rw := &rewriter{
+		Translator:   t,
+		getOkVars:    make(map[string]string),
+		getOkIfs:     make(map[*ast.IfStmt]string),
+		imports:      make(map[string]string),
+		model:        "data",
+		notFoundIfs:  make(map[*ast.IfStmt]bool),
+		op:           op,
+		resourceData: "d",
+	}
+	if op == Update {
+		rw.model = "new"
+	}
+
+	for _, field := range decl.Type.Params.List {
+		for _, name := range field.Names {
+			if star, ok := field.Type.(*ast.StarExpr); ok {
+				if sel, ok := star.X.(*ast.SelectorExpr); ok && sel.Sel.Name == "ResourceData" {
+					rw.resourceData = name.Name
+					rw.resourceDataOK = true
+					continue
+				}
+			}
+			switch typ := field.Type.(type) {
+			case *ast.InterfaceType:
+				rw.meta = name.Name
+			case *ast.Ident:
+				if typ.Name == "any" {
+					rw.meta = name.Name
+				}
+			}
+		}
+	}
+
+	if !rw.resourceDataOK {
+		return nil, fmt.Errorf("function %s has no *schema.ResourceData parameter", decl.Name.Name)
+	}
+
+	body := &ast.BlockStmt{List: append([]ast.Stmt(nil), decl.Body.List...)}
+	if n := len(body.List); n > 0 {
+		rw.last = body.List[n-1]
+	}
+
+	astutil.Apply(body, rw.pre, rw.post)
+
+	// Anything still referring to the ResourceData or diagnostics can't be translated automatically.
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			if isIdent(n.X, rw.resourceData) {
+				rw.unresolvedf("%s.%s", rw.resourceData, n.Sel.Name)
+				return false
+			}
+		case *ast.Ident:
+			if n.Name == rw.resourceData {
+				rw.unresolvedf("reference to %s", rw.resourceData)
+			} else if n.Name == "diags" {
+				rw.unresolvedf("reference to diags")
+			}
+		}
+		return true
+	})
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, body); err != nil {
+		return nil, fmt.Errorf("formatting %s: %w", decl.Name.Name, err)
+	}
+
+	result := &Result{
+		Body:       strings.TrimSpace(trimBlankLines(replaceTODOMarkers(strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(buf.String()), "{"), "}")))),
+		Unresolved: rw.unresolved,
+	}
+
+	for path, name := range usedImports(file, body) {
+		rw.imports[path] = name
+	}
+	for path, name := range rw.imports {
+		if name == "" {
+			result.Imports = append(result.Imports, strconv.Quote(path))
+		} else {
+			result.Imports = append(result.Imports, name+" "+strconv.Quote(path))
+		}
+	}
+	sort.Strings(result.Imports)
+
+	return result, nil
+}
+
+type rewriter struct {
+	*Translator
+	funcLitDepth   int
+	getOkIfs       map[*ast.IfStmt]string // if statements that declared a GetOk variable.
+	getOkVars      map[string]string      // GetOk variable name to attribute name.
+	imports        map[string]string      // Import path to name.
+	last           ast.Stmt               // Last top-level statement.
+	meta           string                 // Name of the provider meta parameter.
+	model          string                 // Name of the model variable.
+	notFoundErrs   []ast.Expr             // Errors tested by enclosing tfresource.NotFound calls.
+	notFoundIfs    map[*ast.IfStmt]bool
+	op             Operation
+	resourceData   string // Name of the *schema.ResourceData parameter.
+	resourceDataOK bool
+	unresolved     []string
+}
+
+func (rw *rewriter) pre(c *astutil.Cursor) bool {
+	switch n := c.Node().(type) {
+	case *ast.FuncLit:
+		rw.funcLitDepth++
+
+	case *ast.IfStmt:
+		if err := notFoundErr(n.Cond); err != nil {
+			rw.notFoundErrs = append(rw.notFoundErrs, err)
+			rw.notFoundIfs[n] = true
+		}
+
+		// if v, ok := d.GetOk("name"); ok {
+		assign, ok := n.Init.(*ast.AssignStmt)
+		if !ok || assign.Tok != token.DEFINE || len(assign.Lhs) != 2 || len(assign.Rhs) != 1 {
+			break
+		}
+		name, ok := rw.attributeCall(assign.Rhs[0], "GetOk")
+		if !ok {
+			break
+		}
+		v, okVar := identName(assign.Lhs[0]), identName(assign.Lhs[1])
+		var cond *ast.Expr
+		if isIdent(n.Cond, okVar) {
+			cond = &n.Cond
+		} else if b, ok := n.Cond.(*ast.BinaryExpr); ok && b.Op == token.LAND && isIdent(b.X, okVar) {
+			cond = &b.X
+		}
+		if cond == nil {
+			break
+		}
+
+		n.Init = nil
+		*cond = &ast.UnaryExpr{Op: token.NOT, X: call(rw.field(name), "IsNull")}
+		if v != "_" {
+			rw.getOkVars[v] = name
+			rw.getOkIfs[n] = v
+		}
+	}
+
+	return true
+}
+
+func (rw *rewriter) post(c *astutil.Cursor) bool {
+	switch n := c.Node().(type) {
+	case *ast.FuncLit:
+		rw.funcLitDepth--
+
+	case *ast.CallExpr:
+		rw.postCallExpr(c, n)
+
+	case *ast.TypeAssertExpr:
+		rw.postTypeAssertExpr(c, n)
+
+	case *ast.BinaryExpr:
+		// !d.IsNewResource() && ... => ...
+		if n.Op == token.LAND {
+			if u, ok := n.X.(*ast.UnaryExpr); ok && u.Op == token.NOT && isIdent(u.X, "false") {
+				c.Replace(n.Y)
+			}
+		}
+
+	case *ast.Ident:
+		if parent, ok := c.Parent().(*ast.SelectorExpr); ok && parent.Sel == n {
+			break
+		}
+		if parent, ok := c.Parent().(*ast.KeyValueExpr); ok && parent.Key == n {
+			break
+		}
+		if _, ok := c.Parent().(*ast.TypeAssertExpr); ok {
+			break
+		}
+		if rw.meta != "" && n.Name == rw.meta {
+			c.Replace(rw.providerMeta())
+		} else if name, ok := rw.getOkVars[n.Name]; ok {
+			c.Replace(rw.field(name))
+		}
+
+	case *ast.DeclStmt:
+		// var diags diag.Diagnostics
+		if gen, ok := n.Decl.(*ast.GenDecl); ok && gen.Tok == token.VAR && len(gen.Specs) == 1 {
+			if spec, ok := gen.Specs[0].(*ast.ValueSpec); ok && len(spec.Names) == 1 && spec.Names[0].Name == "diags" {
+				replaceStmt(c, nil)
+			}
+		}
+
+	case *ast.ExprStmt:
+		if call, ok := n.X.(*ast.CallExpr); ok {
+			if stmts, ok := rw.setStmts(call); ok {
+				replaceStmt(c, stmts)
+			}
+		}
+
+	case *ast.IfStmt:
+		if v, ok := rw.getOkIfs[n]; ok {
+			delete(rw.getOkVars, v)
+		}
+		if rw.notFoundIfs[n] {
+			rw.notFoundErrs = rw.notFoundErrs[:len(rw.notFoundErrs)-1]
+		}
+
+		// if err := d.Set("name", v); err != nil {
+		if assign, ok := n.Init.(*ast.AssignStmt); ok && len(assign.Rhs) == 1 {
+			if call, ok := assign.Rhs[0].(*ast.CallExpr); ok {
+				if stmts, ok := rw.setStmts(call); ok {
+					replaceStmt(c, stmts)
+				}
+			}
+		}
+
+	case *ast.AssignStmt:
+		rw.postAssignStmt(c, n)
+
+	case *ast.ReturnStmt:
+		if rw.funcLitDepth == 0 {
+			replaceStmt(c, rw.returnStmts(n))
+		}
+	}
+
+	return true
+}
+
+func (rw *rewriter) postCallExpr(c *astutil.Cursor, n *ast.CallExpr) {
+	sel, ok := n.Fun.(*ast.SelectorExpr)
+	if !ok || !isIdent(sel.X, rw.resourceData) {
+		return
+	}
+
+	switch sel.Sel.Name {
+	case "Id":
+		c.Replace(call(rw.field("id"), "ValueString"))
+
+	case "IsNewResource":
+		c.Replace(ast.NewIdent(strconv.FormatBool(rw.op == Create)))
+
+	case "HasChange", "HasChanges":
+		var expr ast.Expr
+		for _, arg := range n.Args {
+			name, ok := stringLit(arg)
+			if !ok {
+				rw.unresolvedf("%s.%s with non-literal argument", rw.resourceData, sel.Sel.Name)
+				return
+			}
+			attr, ok := rw.Attributes[name]
+			if !ok {
+				rw.unresolvedf("%s.%s(%q)", rw.resourceData, sel.Sel.Name, name)
+				return
+			}
+			// !new.X.Equal(old.X)
+			changed := &ast.UnaryExpr{Op: token.NOT, X: &ast.CallExpr{
+				Fun:  selector(selector(ast.NewIdent("new"), attr.FieldName), "Equal"),
+				Args: []ast.Expr{selector(ast.NewIdent("old"), attr.FieldName)},
+			}}
+			if expr == nil {
+				expr = changed
+			} else {
+				expr = &ast.BinaryExpr{X: expr, Op: token.LOR, Y: changed}
+			}
+		}
+		if expr != nil {
+			c.Replace(expr)
+		}
+
+	case "Timeout":
+		if len(n.Args) != 1 {
+			return
+		}
+		arg, ok := n.Args[0].(*ast.SelectorExpr)
+		if !ok || !strings.HasPrefix(arg.Sel.Name, "Timeout") {
+			return
+		}
+		// d.Timeout(schema.TimeoutCreate) => r.CreateTimeout(ctx, data.Timeouts)
+		c.Replace(&ast.CallExpr{
+			Fun:  selector(ast.NewIdent(rw.Receiver), strings.TrimPrefix(arg.Sel.Name, "Timeout")+"Timeout"),
+			Args: []ast.Expr{ast.NewIdent("ctx"), rw.field("timeouts")},
+		})
+
+	case "Get":
+		// Type asserted values are handled by the enclosing TypeAssertExpr.
+		if parent, ok := c.Parent().(*ast.TypeAssertExpr); ok && parent.X == n {
+			return
+		}
+		if name, ok := rw.attributeCall(n, "Get"); ok {
+			c.Replace(rw.field(name))
+		}
+	}
+}
+
+func (rw *rewriter) postTypeAssertExpr(c *astutil.Cursor, n *ast.TypeAssertExpr) {
+	// meta.(*conns.AWSClient) => r.Meta()
+	if rw.meta != "" && isIdent(n.X, rw.meta) {
+		c.Replace(rw.providerMeta())
+		return
+	}
+
+	var name string
+	if v, ok := rw.attributeCall(n.X, "Get"); ok {
+		name = v
+	} else if id, ok := n.X.(*ast.Ident); ok {
+		if v, ok := rw.getOkVars[id.Name]; ok {
+			name = v
+		}
+	}
+	if name == "" {
+		return
+	}
+
+	attr := rw.Attributes[name]
+	field := rw.field(name)
+
+	switch typ := exprString(n.Type); {
+	case typ == "string" && (attr.Kind == KindString || attr.Kind == KindARN):
+		c.Replace(call(field, "ValueString"))
+	case typ == "bool" && attr.Kind == KindBool:
+		c.Replace(call(field, "ValueBool"))
+	case typ == "float64" && attr.Kind == KindFloat64:
+		c.Replace(call(field, "ValueFloat64"))
+	case typ == "int" && attr.Kind == KindInt64:
+		c.Replace(&ast.CallExpr{Fun: ast.NewIdent("int"), Args: []ast.Expr{call(field, "ValueInt64")}})
+	case attr.Kind == KindAggregate:
+		c.Replace(field)
+	default:
+		rw.unresolvedf("%q asserted as %s", name, typ)
+	}
+}
+
+func (rw *rewriter) postAssignStmt(c *astutil.Cursor, n *ast.AssignStmt) {
+	if len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+		return
+	}
+
+	// input.X = expandX(data.X) => response.Diagnostics.Append(fwflex.Expand(ctx, data.X, &input.X)...)
+	expand, ok := n.Rhs[0].(*ast.CallExpr)
+	if !ok || len(expand.Args) != 1 || !strings.Contains(strings.ToLower(funcName(expand.Fun)), "expand") {
+		return
+	}
+	name, ok := rw.aggregateField(expand.Args[0])
+	if !ok {
+		return
+	}
+	if n.Tok != token.ASSIGN {
+		rw.unresolvedf("%s := %s", exprString(n.Lhs[0]), exprString(expand))
+		return
+	}
+
+	rw.imports[fwflexImportPath] = "fwflex"
+	replaceStmt(c, rw.appendDiagnostics(&ast.CallExpr{
+		Fun:  selector(ast.NewIdent("fwflex"), "Expand"),
+		Args: []ast.Expr{ast.NewIdent("ctx"), rw.field(name), &ast.UnaryExpr{Op: token.AND, X: n.Lhs[0]}},
+	}))
+}
+
+// setStmts returns the statements replacing a d.Set or d.SetId call.
+func (rw *rewriter) setStmts(n *ast.CallExpr) ([]ast.Stmt, bool) {
+	sel, ok := n.Fun.(*ast.SelectorExpr)
+	if !ok || !isIdent(sel.X, rw.resourceData) {
+		return nil, false
+	}
+
+	switch sel.Sel.Name {
+	case "SetId":
+		if len(n.Args) != 1 {
+			return nil, false
+		}
+		if v, ok := stringLit(n.Args[0]); ok && v == "" {
+			var stmts []ast.Stmt
+			if rw.op == Read && len(rw.notFoundErrs) > 0 {
+				rw.imports[fwdiagImportPath] = ""
+				stmts = append(stmts, &ast.ExprStmt{X: &ast.CallExpr{
+					Fun: selector(selector(ast.NewIdent("response"), "Diagnostics"), "Append"),
+					Args: []ast.Expr{&ast.CallExpr{
+						Fun:  selector(ast.NewIdent("fwdiag"), "NewResourceNotFoundWarningDiagnostic"),
+						Args: []ast.Expr{rw.notFoundErrs[len(rw.notFoundErrs)-1]},
+					}},
+				}})
+			}
+			return append(stmts, &ast.ExprStmt{X: &ast.CallExpr{
+				Fun:  selector(selector(ast.NewIdent("response"), "State"), "RemoveResource"),
+				Args: []ast.Expr{ast.NewIdent("ctx")},
+			}}), true
+		}
+		return rw.assignStmts("id", n.Args[0]), true
+
+	case "Set":
+		if len(n.Args) != 2 {
+			return nil, false
+		}
+		name, ok := stringLit(n.Args[0])
+		if !ok {
+			return []ast.Stmt{todo(fmt.Sprintf("%s.Set(%s, %s)", rw.resourceData, exprString(n.Args[0]), exprString(n.Args[1])))}, true
+		}
+		if _, ok := rw.Attributes[name]; !ok {
+			rw.unresolvedf("%s.Set(%q)", rw.resourceData, name)
+			return []ast.Stmt{todo(fmt.Sprintf("%s.Set(%q, %s)", rw.resourceData, name, exprString(n.Args[1])))}, true
+		}
+		return rw.assignStmts(name, n.Args[1]), true
+
+	case "Partial":
+		return nil, true
+	}
+
+	return nil, false
+}
+
+// assignStmts returns the statements that assign a Go value to the model field for the specified attribute.
+func (rw *rewriter) assignStmts(name string, value ast.Expr) []ast.Stmt {
+	attr := rw.Attributes[name]
+	field := rw.field(name)
+
+	toFramework := func(fn string, v ast.Expr) ast.Expr {
+		rw.imports[fwflexImportPath] = "fwflex"
+		return &ast.CallExpr{Fun: selector(ast.NewIdent("fwflex"), fn), Args: []ast.Expr{ast.NewIdent("ctx"), v}}
+	}
+	typesValue := func(fn string, v ast.Expr) ast.Expr {
+		return &ast.CallExpr{Fun: selector(ast.NewIdent("types"), fn), Args: []ast.Expr{v}}
+	}
+	// Unwrap aws.ToX(v) and assume that AWS API structure fields are pointers.
+	pointer := func(v ast.Expr, fns ...string) (ast.Expr, bool) {
+		if c, ok := v.(*ast.CallExpr); ok && len(c.Args) == 1 {
+			for _, fn := range fns {
+				if funcName(c.Fun) == "aws."+fn {
+					return c.Args[0], true
+				}
+			}
+		}
+		if sel, ok := v.(*ast.SelectorExpr); ok && token.IsExported(sel.Sel.Name) {
+			return v, true
+		}
+		return nil, false
+	}
+	assign := func(v ast.Expr) []ast.Stmt {
+		return []ast.Stmt{&ast.AssignStmt{Lhs: []ast.Expr{field}, Tok: token.ASSIGN, Rhs: []ast.Expr{v}}}
+	}
+
+	switch attr.Kind {
+	case KindString:
+		if v, ok := pointer(value, "ToString", "StringValue"); ok {
+			return assign(toFramework("StringToFramework", v))
+		}
+		if c, ok := value.(*ast.CallExpr); ok && isIdent(c.Fun, "string") && len(c.Args) == 1 {
+			return assign(toFramework("StringValueToFramework", c.Args[0]))
+		}
+		return assign(typesValue("StringValue", value))
+
+	case KindARN:
+		if v, ok := pointer(value, "ToString", "StringValue"); ok {
+			return assign(toFramework("StringToFrameworkARN", v))
+		}
+		rw.imports[fwtypesImportPath] = "fwtypes"
+		return assign(&ast.CallExpr{Fun: selector(ast.NewIdent("fwtypes"), "ARNValueMust"), Args: []ast.Expr{value}})
+
+	case KindBool:
+		if v, ok := pointer(value, "ToBool", "BoolValue"); ok {
+			return assign(toFramework("BoolToFramework", v))
+		}
+		return assign(typesValue("BoolValue", value))
+
+	case KindFloat64:
+		if v, ok := pointer(value, "ToFloat64", "Float64Value"); ok {
+			return assign(toFramework("Float64ToFramework", v))
+		}
+		return assign(typesValue("Float64Value", value))
+
+	case KindInt64:
+		if v, ok := pointer(value, "ToInt32", "Int32Value"); ok && !isSelector(value) {
+			return assign(toFramework("Int32ToFramework", v))
+		}
+		if v, ok := pointer(value, "ToInt64", "Int64Value"); ok {
+			return assign(toFramework("Int64ToFramework", v))
+		}
+		return assign(typesValue("Int64Value", &ast.CallExpr{Fun: ast.NewIdent("int64"), Args: []ast.Expr{value}}))
+
+	case KindAggregate:
+		// d.Set("x", flattenX(v)) => response.Diagnostics.Append(fwflex.Flatten(ctx, v, &data.X)...)
+		if c, ok := value.(*ast.CallExpr); ok && len(c.Args) == 1 && strings.Contains(strings.ToLower(funcName(c.Fun)), "flatten") {
+			value = c.Args[0]
+		}
+		rw.imports[fwflexImportPath] = "fwflex"
+		return rw.appendDiagnostics(&ast.CallExpr{
+			Fun:  selector(ast.NewIdent("fwflex"), "Flatten"),
+			Args: []ast.Expr{ast.NewIdent("ctx"), value, &ast.UnaryExpr{Op: token.AND, X: field}},
+		})
+	}
+
+	rw.unresolvedf("%s.Set(%q)", rw.resourceData, name)
+	return []ast.Stmt{todo(fmt.Sprintf("%s.Set(%q, %s)", rw.resourceData, name, exprString(value)))}
+}
+
+// returnStmts returns the statements replacing a top-level return statement.
+func (rw *rewriter) returnStmts(n *ast.ReturnStmt) []ast.Stmt {
+	if len(n.Results) != 1 {
+		return []ast.Stmt{n}
+	}
+
+	success := func() []ast.Stmt {
+		if n != rw.last {
+			return []ast.Stmt{&ast.ReturnStmt{Return: n.Return}}
+		}
+		if rw.op == Delete {
+			return nil
+		}
+		// response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+		return []ast.Stmt{&ast.ExprStmt{X: &ast.CallExpr{
+			Fun: selector(selector(ast.NewIdent("response"), "Diagnostics"), "Append"),
+			Args: []ast.Expr{&ast.CallExpr{
+				Fun:  selector(selector(ast.NewIdent("response"), "State"), "Set"),
+				Args: []ast.Expr{ast.NewIdent("ctx"), &ast.UnaryExpr{Op: token.AND, X: ast.NewIdent(rw.model)}},
+			}},
+			Ellipsis: 1,
+		}}}
+	}
+
+	result := n.Results[0]
+	switch {
+	case isIdent(result, "nil"), isIdent(result, "diags"):
+		return success()
+	}
+
+	c, ok := result.(*ast.CallExpr)
+	if !ok {
+		rw.unresolvedf("return %s", exprString(result))
+		return []ast.Stmt{todo("return " + exprString(result)), &ast.ReturnStmt{}}
+	}
+
+	switch fn := funcName(c.Fun); fn {
+	case "append":
+		// return append(diags, resourceXRead(ctx, d, meta)...)
+		if len(c.Args) == 2 && isIdent(c.Args[0], "diags") {
+			if read, ok := c.Args[1].(*ast.CallExpr); ok && strings.HasSuffix(funcName(read.Fun), "Read") {
+				stmts := []ast.Stmt{todo(fmt.Sprintf("Refresh any Computed attributes; the Plugin SDK implementation called %s.", funcName(read.Fun)))}
+				return append(stmts, success()...)
+			}
+		}
+
+	case "sdkdiag.AppendErrorf", "diag.Errorf", "fmt.Errorf":
+		args := c.Args
+		if fn == "sdkdiag.AppendErrorf" && len(args) > 0 {
+			args = args[1:]
+		}
+		if stmts, ok := rw.addErrorStmts(args); ok {
+			return stmts
+		}
+
+	case "sdkdiag.AppendFromErr", "diag.FromErr":
+		err := c.Args[len(c.Args)-1]
+		return []ast.Stmt{rw.addError(&ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(rw.op.gerund() + " " + rw.TFTypeName)}, errorDetail(err)), &ast.ReturnStmt{}}
+
+	default:
+		// return resourceXRead(d, meta)
+		if strings.HasSuffix(fn, "Read") {
+			stmts := []ast.Stmt{todo(fmt.Sprintf("Refresh any Computed attributes; the Plugin SDK implementation called %s.", fn))}
+			return append(stmts, success()...)
+		}
+	}
+
+	rw.unresolvedf("return %s", exprString(result))
+	return []ast.Stmt{todo("return " + exprString(result)), &ast.ReturnStmt{}}
+}
+
+// addErrorStmts returns the statements that add an error diagnostic from a format string and its arguments.
+// A trailing ": %s" (or ": %w") verb becomes the diagnostic's detail.
+func (rw *rewriter) addErrorStmts(args []ast.Expr) ([]ast.Stmt, bool) {
+	if len(args) == 0 {
+		return nil, false
+	}
+	format, ok := stringLit(args[0])
+	if !ok {
+		return nil, false
+	}
+	args = args[1:]
+
+	detail := ast.Expr(&ast.BasicLit{Kind: token.STRING, Value: `""`})
+	for _, suffix := range []string{": %s", ": %w", ": %v"} {
+		if strings.HasSuffix(format, suffix) && len(args) > 0 && strings.Count(format, "%") == len(args) {
+			format = strings.TrimSuffix(format, suffix)
+			detail = errorDetail(args[len(args)-1])
+			args = args[:len(args)-1]
+			break
+		}
+	}
+
+	summary := ast.Expr(&ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(format)})
+	if len(args) > 0 {
+		rw.imports[fmtImportPath] = ""
+		summary = &ast.CallExpr{Fun: selector(ast.NewIdent("fmt"), "Sprintf"), Args: append([]ast.Expr{summary}, args...)}
+	}
+
+	return []ast.Stmt{rw.addError(summary, detail), &ast.ReturnStmt{}}, true
+}
+
+func (rw *rewriter) addError(summary, detail ast.Expr) ast.Stmt {
+	return &ast.ExprStmt{X: &ast.CallExpr{
+		Fun:  selector(selector(ast.NewIdent("response"), "Diagnostics"), "AddError"),
+		Args: []ast.Expr{summary, detail},
+	}}
+}
+
+// appendDiagnostics returns the statements that append the diagnostics returned by the specified call
+// and return early on error.
+func (rw *rewriter) appendDiagnostics(c *ast.CallExpr) []ast.Stmt {
+	return []ast.Stmt{
+		&ast.ExprStmt{X: &ast.CallExpr{
+			Fun:      selector(selector(ast.NewIdent("response"), "Diagnostics"), "Append"),
+			Args:     []ast.Expr{c},
+			Ellipsis: 1,
+		}},
+		&ast.IfStmt{
+			Cond: call(selector(ast.NewIdent("response"), "Diagnostics"), "HasError"),
+			Body: &ast.BlockStmt{List: []ast.Stmt{&ast.ReturnStmt{}}},
+		},
+	}
+}
+
+// attributeCall returns the attribute name if the expression is d.<method>("name") for a known top-level attribute.
+func (rw *rewriter) attributeCall(expr ast.Expr, method string) (string, bool) {
+	c, ok := expr.(*ast.CallExpr)
+	if !ok || len(c.Args) != 1 || !rw.isResourceDataCall(c, method) {
+		return "", false
+	}
+	name, ok := stringLit(c.Args[0])
+	if !ok {
+		return "", false
+	}
+	if _, ok := rw.Attributes[name]; !ok {
+		rw.unresolvedf("%s.%s(%q)", rw.resourceData, method, name)
+		return "", false
+	}
+	return name, true
+}
+
+// aggregateField returns the attribute name if the expression is the model field of an aggregate attribute.
+func (rw *rewriter) aggregateField(expr ast.Expr) (string, bool) {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || !isIdent(sel.X, rw.model) {
+		return "", false
+	}
+	for name, attr := range rw.Attributes {
+		if attr.FieldName == sel.Sel.Name && attr.Kind == KindAggregate {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func (rw *rewriter) isResourceDataCall(expr ast.Expr, method string) bool {
+	c, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := c.Fun.(*ast.SelectorExpr)
+	return ok && isIdent(sel.X, rw.resourceData) && sel.Sel.Name == method
+}
+
+// field returns the model struct field expression for the specified attribute.
+func (rw *rewriter) field(name string) ast.Expr {
+	fieldName := "Timeouts"
+	if attr, ok := rw.Attributes[name]; ok {
+		fieldName = attr.FieldName
+	}
+	return selector(ast.NewIdent(rw.model), fieldName)
+}
+
+// providerMeta returns the r.Meta() expression.
+func (rw *rewriter) providerMeta() ast.Expr {
+	return &ast.CallExpr{Fun: selector(ast.NewIdent(rw.Receiver), "Meta")}
+}
+
+func (rw *rewriter) unresolvedf(format string, a ...any) {
+	s := fmt.Sprintf(format, a...)
+	for _, v := range rw.unresolved {
+		if v == s {
+			return
+		}
+	}
+	rw.unresolved = append(rw.unresolved, s)
+}
+
+// notFoundErr returns the error argument of a tfresource.NotFound call in the condition.
+func notFoundErr(cond ast.Expr) ast.Expr {
+	var err ast.Expr
+	ast.Inspect(cond, func(n ast.Node) bool {
+		if c, ok := n.(*ast.CallExpr); ok && funcName(c.Fun) == "tfresource.NotFound" && len(c.Args) == 1 {
+			err = c.Args[0]
+			return false
+		}
+		return err == nil
+	})
+	return err
+}
+
+// errorDetail returns the diagnostic detail expression for an error format argument.
+func errorDetail(err ast.Expr) ast.Expr {
+	if id, ok := err.(*ast.Ident); ok && strings.HasSuffix(strings.ToLower(id.Name), "err") {
+		return call(err, "Error")
+	}
+	return &ast.CallExpr{Fun: selector(ast.NewIdent("fmt"), "Sprint"), Args: []ast.Expr{err}}
+}
+
+// replaceStmt replaces the statement at the cursor with zero or more statements.
+func replaceStmt(c *astutil.Cursor, stmts []ast.Stmt) {
+	if c.Index() < 0 {
+		switch len(stmts) {
+		case 0:
+			c.Replace(&ast.EmptyStmt{Implicit: true})
+		case 1:
+			c.Replace(stmts[0])
+		default:
+			c.Replace(&ast.BlockStmt{List: stmts})
+		}
+		return
+	}
+
+	if len(stmts) == 0 {
+		c.Delete()
+		return
+	}
+	for _, stmt := range stmts[:len(stmts)-1] {
+		c.InsertBefore(stmt)
+	}
+	c.Replace(stmts[len(stmts)-1])
+}
+
+// todo returns a placeholder statement that is rendered as a TODO comment.
+func todo(s string) ast.Stmt {
+	return &ast.ExprStmt{X: &ast.CallExpr{
+		Fun:  ast.NewIdent(todoMarker),
+		Args: []ast.Expr{&ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(s)}},
+	}}
+}
+
+// replaceTODOMarkers replaces placeholder statements with TODO comments.
+func replaceTODOMarkers(body string) string {
+	lines := strings.Split(body, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, todoMarker+"(") || !strings.HasSuffix(trimmed, ")") {
+			continue
+		}
+		s, err := strconv.Unquote(strings.TrimSuffix(strings.TrimPrefix(trimmed, todoMarker+"("), ")"))
+		if err != nil {
+			continue
+		}
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		lines[i] = indent + "// TODO " + strings.ReplaceAll(s, "\n", " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// trimBlankLines removes blank lines preceding closing braces.
+// Statements synthesized without source positions can otherwise leave them behind.
+func trimBlankLines(body string) string {
+	lines := strings.Split(body, "\n")
+	result := make([]string, 0, len(lines))
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" && i+1 < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i+1]), "}") {
+			continue
+		}
+		result = append(result, line)
+	}
+	return strings.Join(result, "\n")
+}
+
+// usedImports returns the source file's imports that are referenced by the node.
+// Plugin SDK schema and diag imports are never returned as they clash with the Plugin Framework's.
+func usedImports(file *ast.File, node ast.Node) map[string]string {
+	used := make(map[string]bool)
+	ast.Inspect(node, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok {
+				used[id.Name] = true
+			}
+		}
+		return true
+	})
+
+	imports := make(map[string]string)
+	if file == nil {
+		return imports
+	}
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || path == sdkdiagImportPath || path == sdkschemaImportPath {
+			continue
+		}
+		name := ""
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if used[importName(path, name)] {
+			imports[path] = name
+		}
+	}
+	return imports
+}
+
+// importName returns the name by which an import is referenced.
+func importName(path, name string) string {
+	if name != "" {
+		return name
+	}
+	parts := strings.Split(path, "/")
+	name = parts[len(parts)-1]
+	// Major version suffixes, e.g. github.com/hashicorp/terraform-plugin-sdk/v2.
+	if len(parts) > 1 && len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = parts[len(parts)-2]
+	}
+	return strings.TrimPrefix(name, "go-")
+}
+
+func call(x ast.Expr, method string) ast.Expr {
+	return &ast.CallExpr{Fun: selector(x, method)}
+}
+
+func selector(x ast.Expr, sel string) *ast.SelectorExpr {
+	return &ast.SelectorExpr{X: x, Sel: ast.NewIdent(sel)}
+}
+
+func funcName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return expr.Name
+	case *ast.SelectorExpr:
+		if x := funcName(expr.X); x != "" {
+			return x + "." + expr.Sel.Name
+		}
+		return expr.Sel.Name
+	}
+	return ""
+}
+
+func identName(expr ast.Expr) string {
+	if id, ok := expr.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+func isIdent(expr ast.Expr, name string) bool {
+	id, ok := expr.(*ast.Ident)
+	return ok && id.Name == name
+}
+
+func isSelector(expr ast.Expr) bool {
+	_, ok := expr.(*ast.SelectorExpr)
+	return ok
+}
+
+func stringLit(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
+
+func exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, token.NewFileSet(), expr); err != nil {
+		return fmt.Sprintf("%T", expr)
+	}
+	return buf.String()
+}
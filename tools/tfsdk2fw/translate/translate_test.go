@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package translate
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+const testSource = `
+package example
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/example"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func resourceThingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &example.CreateThingInput{
+		Name: aws.String(name),
+		Size: aws.Int32(int32(d.Get("size").(int))),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	input.Rules = expandRules(d.Get("rule").([]interface{}))
+
+	output, err := conn.CreateThing(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Thing (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ThingId))
+
+	if _, err := waitThingCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Thing (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceThingRead(ctx, d, meta)...)
+}
+
+func resourceThingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	thing, err := findThingByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Thing (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Thing (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", thing.ThingArn)
+	d.Set("enabled", aws.ToBool(thing.Enabled))
+	d.Set("name", thing.Name)
+	if err := d.Set("rule", flattenRules(thing.Rules)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
+	}
+	d.Set("settings.0.mode", thing.Mode)
+
+	return diags
+}
+
+func resourceThingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	if d.HasChanges("description", "size") {
+		input := &example.UpdateThingInput{
+			Description: aws.String(d.Get("description").(string)),
+			ThingId:     aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateThing(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Thing (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceThingRead(ctx, d, meta)...)
+}
+
+func resourceThingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Thing: %s", d.Id())
+	_, err := conn.DeleteThing(ctx, &example.DeleteThingInput{
+		ThingId: aws.String(d.Id()),
+	})
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+`
+
+func testTranslate(t *testing.T, funcName string, op Operation) *Result {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", testSource, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decl *ast.FuncDecl
+	for _, v := range file.Decls {
+		if v, ok := v.(*ast.FuncDecl); ok && v.Name.Name == funcName {
+			decl = v
+		}
+	}
+	if decl == nil {
+		t.Fatalf("function %s not found", funcName)
+	}
+
+	translator := &Translator{
+		Attributes: map[string]Attribute{
+			"arn":         {FieldName: "ARN", Kind: KindString},
+			"description": {FieldName: "Description", Kind: KindString},
+			"enabled":     {FieldName: "Enabled", Kind: KindBool},
+			"id":          {FieldName: "ID", Kind: KindString},
+			"name":        {FieldName: "Name", Kind: KindString},
+			"rule":        {FieldName: "Rule", Kind: KindAggregate},
+			"size":        {FieldName: "Size", Kind: KindInt64},
+		},
+		Receiver:   "r",
+		TFTypeName: "aws_example_thing",
+	}
+
+	result, err := translator.Translate(fset, file, decl, op)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return result
+}
+
+func TestTranslate(t *testing.T) {
+	testCases := []struct {
+		TestName     string
+		FuncName     string
+		Operation    Operation
+		Expected     []string
+		NotExpected  []string
+		Imports      []string
+		Unresolved   []string
+		NoUnresolved bool
+	}{
+		{
+			TestName:  "create",
+			FuncName:  "resourceThingCreate",
+			Operation: Create,
+			Expected: []string{
+				"conn := r.Meta().ExampleClient(ctx)",
+				"name := data.Name.ValueString()",
+				"Size: aws.Int32(int32(int(data.Size.ValueInt64()))),",
+				"if !data.Description.IsNull() {",
+				"input.Description = aws.String(data.Description.ValueString())",
+				"response.Diagnostics.Append(fwflex.Expand(ctx, data.Rule, &input.Rules)...)",
+				`response.Diagnostics.AddError(fmt.Sprintf("creating Thing (%s)", name), err.Error())`,
+				"data.ID = fwflex.StringToFramework(ctx, output.ThingId)",
+				"waitThingCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))",
+				"// TODO Refresh any Computed attributes; the Plugin SDK implementation called resourceThingRead.",
+				"response.Diagnostics.Append(response.State.Set(ctx, &data)...)",
+			},
+			NotExpected: []string{"diags", "var "},
+			Imports: []string{
+				`"fmt"`,
+				`"github.com/aws/aws-sdk-go-v2/aws"`,
+				`"github.com/aws/aws-sdk-go-v2/service/example"`,
+				`fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"`,
+			},
+			NoUnresolved: true,
+		},
+		{
+			TestName:  "read",
+			FuncName:  "resourceThingRead",
+			Operation: Read,
+			Expected: []string{
+				"if tfresource.NotFound(err) {",
+				"response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))",
+				"response.State.RemoveResource(ctx)",
+				"data.ARN = fwflex.StringToFramework(ctx, thing.ThingArn)",
+				"data.Enabled = fwflex.BoolToFramework(ctx, thing.Enabled)",
+				"response.Diagnostics.Append(fwflex.Flatten(ctx, thing.Rules, &data.Rule)...)",
+				`// TODO d.Set("settings.0.mode", thing.Mode)`,
+			},
+			NotExpected: []string{"d.Id", "d.SetId", "IsNewResource", "setting rule"},
+			Imports: []string{
+				`"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"`,
+				`"github.com/hashicorp/terraform-provider-aws/internal/tfresource"`,
+				`"log"`,
+			},
+			Unresolved: []string{`d.Set("settings.0.mode")`},
+		},
+		{
+			TestName:  "update",
+			FuncName:  "resourceThingUpdate",
+			Operation: Update,
+			Expected: []string{
+				"if !new.Description.Equal(old.Description) || !new.Size.Equal(old.Size) {",
+				"Description: aws.String(new.Description.ValueString()),",
+				"ThingId:     aws.String(new.ID.ValueString()),",
+				"response.Diagnostics.Append(response.State.Set(ctx, &new)...)",
+			},
+			NoUnresolved: true,
+		},
+		{
+			TestName:  "delete",
+			FuncName:  "resourceThingDelete",
+			Operation: Delete,
+			Expected: []string{
+				"if tfresource.NotFound(err) {\n\t\treturn\n\t}",
+				`response.Diagnostics.AddError("deleting aws_example_thing", err.Error())`,
+			},
+			NotExpected:  []string{"State.Set", "RemoveResource"},
+			NoUnresolved: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			result := testTranslate(t, testCase.FuncName, testCase.Operation)
+
+			for _, expected := range testCase.Expected {
+				if !strings.Contains(result.Body, expected) {
+					t.Errorf("expected body to contain %q\n%s", expected, result.Body)
+				}
+			}
+			for _, notExpected := range testCase.NotExpected {
+				if strings.Contains(result.Body, notExpected) {
+					t.Errorf("expected body not to contain %q\n%s", notExpected, result.Body)
+				}
+			}
+
+			imports := strings.Join(result.Imports, "\n")
+			for _, expected := range testCase.Imports {
+				if !strings.Contains(imports, expected) {
+					t.Errorf("expected imports to contain %s\n%s", expected, imports)
+				}
+			}
+			for _, notExpected := range []string{"terraform-plugin-sdk", "conns"} {
+				if strings.Contains(imports, notExpected) {
+					t.Errorf("expected imports not to contain %s\n%s", notExpected, imports)
+				}
+			}
+
+			if testCase.NoUnresolved && len(result.Unresolved) > 0 {
+				t.Errorf("unexpected unresolved constructs: %v", result.Unresolved)
+			}
+			for _, expected := range testCase.Unresolved {
+				if !strings.Contains(strings.Join(result.Unresolved, "\n"), expected) {
+					t.Errorf("expected unresolved constructs to contain %s: %v", expected, result.Unresolved)
+				}
+			}
+		})
+	}
+}
+
+func testFuncDeclTarget() {}
+
+func TestFuncDecl(t *testing.T) {
+	_, file, decl, err := FuncDecl(testFuncDeclTarget)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, expected := decl.Name.Name, "testFuncDeclTarget"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+	if got, expected := file.Name.Name, "translate"; got != expected {
+		t.Errorf("package: got %s, expected %s", got, expected)
+	}
+
+	if _, _, _, err := FuncDecl(func() {}); err == nil {
+		t.Error("expected error for function literal")
+	}
+}
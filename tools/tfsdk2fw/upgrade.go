@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
+)
+
+// stateUpgrader is the generated Plugin Framework code upgrading state from a prior schema version.
+type stateUpgrader struct {
+	Functions   []string // Plugin SDK upgrade functions, in the order that they were applied.
+	PriorSchema string   // schema.Schema literal.
+	PriorStruct string   // Prior model struct fields.
+	Fields      string   // Current model struct field values.
+	TODOs       []string
+	Version     int
+}
+
+// emitStateUpgraders generates the Plugin Framework code for a Plugin SDK Resource's StateUpgraders.
+// The Plugin SDK applies upgraders in sequence, one version at a time, whereas each Plugin Framework
+// upgrader must upgrade directly to the current version.
+func (e *emitter) emitStateUpgraders(resource *schema.Resource) ([]stateUpgrader, error) {
+	if len(resource.StateUpgraders) == 0 {
+		return nil, nil
+	}
+
+	upgraders := make([]schema.StateUpgrader, len(resource.StateUpgraders))
+	copy(upgraders, resource.StateUpgraders)
+	sort.Slice(upgraders, func(i, j int) bool {
+		return upgraders[i].Version < upgraders[j].Version
+	})
+
+	current := resource.CoreConfigSchema().ImpliedType()
+	var results []stateUpgrader
+
+	for i, upgrader := range upgraders {
+		if !upgrader.Type.IsObjectType() {
+			return nil, fmt.Errorf("state upgrader for version %d: unsupported type %s", upgrader.Version, upgrader.Type.FriendlyName())
+		}
+
+		result := stateUpgrader{
+			Version: upgrader.Version,
+		}
+
+		for _, v := range upgraders[i:] {
+			result.Functions = append(result.Functions, funcName(v.Upgrade))
+		}
+
+		sbSchema := strings.Builder{}
+		sbStruct := strings.Builder{}
+		sbFields := strings.Builder{}
+
+		attributeTypes := upgrader.Type.AttributeTypes()
+		names := make([]string, 0, len(attributeTypes))
+		for name := range attributeTypes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		fprintf(&sbSchema, "schema.Schema{\n")
+		fprintf(&sbSchema, "Attributes: map[string]schema.Attribute{\n")
+
+		for _, name := range names {
+			typ := attributeTypes[name]
+			property := resource.SchemaMap()[name]
+
+			schemaType, fieldType, err := e.priorAttribute(typ, property)
+
+			if err != nil {
+				return nil, fmt.Errorf("state upgrader for version %d: %s: %w", upgrader.Version, name, err)
+			}
+
+			fprintf(&sbSchema, "%q:%s,\n", name, schemaType)
+			fprintf(&sbStruct, "%s %s `tfsdk:%q`\n", naming.ToCamelCase(name), fieldType, name)
+
+			// Copy values that are unchanged between the prior and current schemas.
+			fieldName := naming.ToCamelCase(name)
+
+			switch currentFieldType, ok := e.TopLevelFieldTypes[name]; {
+			case name == "timeouts" && e.HasTimeouts:
+				fprintf(&sbFields, "Timeouts: timeouts.Value{Object: old.Timeouts},\n")
+			case !ok || !current.HasAttribute(name):
+				result.TODOs = append(result.TODOs, fmt.Sprintf("%s was removed", name))
+			case !current.AttributeType(name).Equals(typ):
+				result.TODOs = append(result.TODOs, fmt.Sprintf("%s changed type from %s to %s", name, typ.FriendlyName(), current.AttributeType(name).FriendlyName()))
+			case currentFieldType != fieldType:
+				result.TODOs = append(result.TODOs, fmt.Sprintf("%s: convert %s to %s", name, fieldType, currentFieldType))
+			default:
+				fprintf(&sbFields, "%s: old.%s,\n", fieldName, fieldName)
+			}
+		}
+
+		currentNames := make([]string, 0, len(e.TopLevelFieldTypes))
+		for name := range e.TopLevelFieldTypes {
+			currentNames = append(currentNames, name)
+		}
+		sort.Strings(currentNames)
+
+		for _, name := range currentNames {
+			if _, ok := attributeTypes[name]; !ok {
+				result.TODOs = append(result.TODOs, fmt.Sprintf("%s was added", name))
+			}
+		}
+
+		fprintf(&sbSchema, "},\n")
+		fprintf(&sbSchema, "}")
+
+		result.PriorSchema = sbSchema.String()
+		result.PriorStruct = sbStruct.String()
+		result.Fields = sbFields.String()
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// priorAttribute returns the Plugin Framework schema attribute and model struct field type for a prior schema's attribute type.
+// The current schema's property, if any, is used to distinguish integer from floating point numbers.
+func (e *emitter) priorAttribute(typ cty.Type, property *schema.Schema) (string, string, error) {
+	switch {
+	case typ.IsPrimitiveType():
+		primitive, err := primitiveType(typ, property)
+
+		if err != nil {
+			return "", "", err
+		}
+
+		return fmt.Sprintf("schema.%sAttribute{Optional:true}", primitive), "types." + primitive, nil
+
+	case typ.IsListType(), typ.IsSetType(), typ.IsMapType():
+		var aggregate string
+		switch {
+		case typ.IsListType():
+			aggregate = "List"
+		case typ.IsSetType():
+			aggregate = "Set"
+		default:
+			aggregate = "Map"
+		}
+
+		var elemProperty *schema.Schema
+		if property != nil {
+			switch v := property.Elem.(type) {
+			case *schema.Schema:
+				elemProperty = v
+			case *schema.Resource:
+				elemProperty = &schema.Schema{Elem: v}
+			}
+		}
+
+		elementType, err := e.priorAttrType(typ.ElementType(), elemProperty)
+
+		if err != nil {
+			return "", "", err
+		}
+
+		return fmt.Sprintf("schema.%sAttribute{ElementType:%s,Optional:true}", aggregate, elementType), "types." + aggregate, nil
+
+	case typ.IsObjectType():
+		attrTypes, err := e.priorAttrTypes(typ, property)
+
+		if err != nil {
+			return "", "", err
+		}
+
+		return fmt.Sprintf("schema.ObjectAttribute{AttributeTypes:%s,Optional:true}", attrTypes), "types.Object", nil
+	}
+
+	return "", "", fmt.Errorf("unsupported type %s", typ.FriendlyName())
+}
+
+// priorAttrType returns the Plugin Framework attr.Type for a prior schema's nested type.
+func (e *emitter) priorAttrType(typ cty.Type, property *schema.Schema) (string, error) {
+	switch {
+	case typ.IsPrimitiveType():
+		primitive, err := primitiveType(typ, property)
+
+		if err != nil {
+			return "", err
+		}
+
+		return "types." + primitive + "Type", nil
+
+	case typ.IsListType(), typ.IsSetType(), typ.IsMapType():
+		var aggregate string
+		switch {
+		case typ.IsListType():
+			aggregate = "types.ListType"
+		case typ.IsSetType():
+			aggregate = "types.SetType"
+		default:
+			aggregate = "types.MapType"
+		}
+
+		var elemProperty *schema.Schema
+		if property != nil {
+			if v, ok := property.Elem.(*schema.Schema); ok {
+				elemProperty = v
+			} else if v, ok := property.Elem.(*schema.Resource); ok {
+				elemProperty = &schema.Schema{Elem: v}
+			}
+		}
+
+		elementType, err := e.priorAttrType(typ.ElementType(), elemProperty)
+
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s{ElemType:%s}", aggregate, elementType), nil
+
+	case typ.IsObjectType():
+		attrTypes, err := e.priorAttrTypes(typ, property)
+
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("types.ObjectType{AttrTypes:%s}", attrTypes), nil
+	}
+
+	return "", fmt.Errorf("unsupported type %s", typ.FriendlyName())
+}
+
+// priorAttrTypes returns the Plugin Framework attribute types map for a prior schema's object type.
+func (e *emitter) priorAttrTypes(typ cty.Type, property *schema.Schema) (string, error) {
+	e.ImportFrameworkAttr = true
+
+	var nested map[string]*schema.Schema
+	if property != nil {
+		if v, ok := property.Elem.(*schema.Resource); ok {
+			nested = v.SchemaMap()
+		}
+	}
+
+	attributeTypes := typ.AttributeTypes()
+	names := make([]string, 0, len(attributeTypes))
+	for name := range attributeTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sb := strings.Builder{}
+	fprintf(&sb, "map[string]attr.Type{\n")
+
+	for _, name := range names {
+		attrType, err := e.priorAttrType(attributeTypes[name], nested[name])
+
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", name, err)
+		}
+
+		fprintf(&sb, "%q:%s,\n", name, attrType)
+	}
+
+	fprintf(&sb, "}")
+
+	return sb.String(), nil
+}
+
+// primitiveType returns the Plugin Framework type name for a primitive type, e.g. String.
+func primitiveType(typ cty.Type, property *schema.Schema) (string, error) {
+	switch typ {
+	case cty.Bool:
+		return "Bool", nil
+	case cty.String:
+		return "String", nil
+	case cty.Number:
+		if property != nil {
+			switch property.Type {
+			case schema.TypeInt:
+				return "Int64", nil
+			case schema.TypeFloat:
+				return "Float64", nil
+			}
+		}
+
+		return "Number", nil
+	}
+
+	return "", fmt.Errorf("unsupported primitive type %s", typ.FriendlyName())
+}
+
+// funcName returns the unqualified name of the specified function, e.g. resourceVPCMigrateState.
+func funcName(fn any) string {
+	v := reflect.ValueOf(fn)
+
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+
+	f := runtime.FuncForPC(v.Pointer())
+
+	if f == nil {
+		return ""
+	}
+
+	name := f.Name()
+
+	return name[strings.LastIndex(name, ".")+1:]
+}